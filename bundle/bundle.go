@@ -0,0 +1,571 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+// Package bundle packages exercises together with their docker
+// images and OVA files, such that a daemon can run the exercises
+// on a host without access to the registries or exercise service.
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	manifestFile   = "manifest.json"
+	signatureFile  = "manifest.sig"
+	exercisesFile  = "exercises.json"
+	categoriesFile = "categories.json"
+	imagesDir      = "images"
+	ovasDir        = "ovas"
+)
+
+var (
+	ErrNoKey            = errors.New("a key is required to sign and verify bundles")
+	ErrInvalidKey       = errors.New("key of bundles is invalid")
+	ErrInvalidSignature = errors.New("signature of the bundle is invalid")
+	ErrNoManifest       = errors.New("bundle has no signed manifest")
+)
+
+type UnexpectedFileErr struct {
+	Name string
+}
+
+func (err UnexpectedFileErr) Error() string {
+	return fmt.Sprintf("unexpected file in bundle: %s", err.Name)
+}
+
+type ChecksumErr struct {
+	Name string
+}
+
+func (err ChecksumErr) Error() string {
+	return fmt.Sprintf("checksum of %s does not match the manifest", err.Name)
+}
+
+// Manifest describes the content of a bundle, it is signed
+// and lists the checksum of every other file in the bundle
+type Manifest struct {
+	CreatedAt time.Time         `json:"createdAt"`
+	Exercises []string          `json:"exercises"`
+	Images    []Image           `json:"images"`
+	Ovas      []Ova             `json:"ovas"`
+	Files     map[string]string `json:"files"`
+}
+
+// Image is a docker image of a bundle, the id is authoritative
+// for the image once it is imported
+type Image struct {
+	Name   string `json:"name"`
+	ID     string `json:"id"`
+	Digest string `json:"digest,omitempty"`
+	File   string `json:"file"`
+}
+
+type Ova struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+}
+
+// Source is the content of a bundle to export, the images must be
+// available locally and the OVAs are given as paths by name
+type Source struct {
+	Exercises  []*eproto.Exercise
+	Categories []*eproto.GetCategoriesResponse_Category
+	Images     []string
+	Ovas       map[string]string
+}
+
+// GenerateKeys returns a new pair of keys encoded as base64, bundles
+// are signed with the private key which is only kept by the exporting
+// host, while importing hosts verify bundles with the public key
+func GenerateKeys() (public, private string, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(pub), base64.StdEncoding.EncodeToString(priv), nil
+}
+
+// ParsePrivateKey decodes a private key from GenerateKeys
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	if s == "" {
+		return nil, ErrNoKey
+	}
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != ed25519.PrivateKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	return ed25519.PrivateKey(key), nil
+}
+
+// ParsePublicKey decodes a public key from GenerateKeys
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	if s == "" {
+		return nil, ErrNoKey
+	}
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	return ed25519.PublicKey(key), nil
+}
+
+func sign(key ed25519.PrivateKey, data []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)))
+}
+
+type writer struct {
+	tw      *tar.Writer
+	sums    map[string]string
+	modTime time.Time
+}
+
+func (w *writer) add(name string, size int64, r io.Reader) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: w.modTime,
+	}); err != nil {
+		return err
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w.tw, h), r); err != nil {
+		return err
+	}
+	if w.sums != nil {
+		w.sums[name] = hex.EncodeToString(h.Sum(nil))
+	}
+
+	return nil
+}
+
+func (w *writer) addBytes(name string, data []byte) error {
+	return w.add(name, int64(len(data)), bytes.NewReader(data))
+}
+
+func (w *writer) addFile(name, p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	return w.add(name, fi.Size(), f)
+}
+
+// Export writes a gzipped tarball of the source signed with the private key
+func Export(ctx context.Context, out io.Writer, src Source, key ed25519.PrivateKey) (*Manifest, error) {
+	if len(key) == 0 {
+		return nil, ErrNoKey
+	}
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	staging, err := ioutil.TempDir("", "hkn-bundle")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	gw, err := gzip.NewWriterLevel(out, gzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	w := &writer{
+		tw:      tar.NewWriter(gw),
+		sums:    map[string]string{},
+		modTime: time.Now(),
+	}
+
+	m := &Manifest{CreatedAt: w.modTime}
+
+	exercises, err := marshalExercises(src.Exercises)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.addBytes(exercisesFile, exercises); err != nil {
+		return nil, err
+	}
+	for _, e := range src.Exercises {
+		m.Exercises = append(m.Exercises, e.Tag)
+	}
+
+	categories, err := marshalCategories(src.Categories)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.addBytes(categoriesFile, categories); err != nil {
+		return nil, err
+	}
+
+	for i, name := range src.Images {
+		img, err := exportImage(ctx, w, staging, name, i)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export image %s: %v", name, err)
+		}
+		m.Images = append(m.Images, img)
+	}
+
+	for name, p := range src.Ovas {
+		file := path.Join(ovasDir, filepath.Base(p))
+		if err := w.addFile(file, p); err != nil {
+			return nil, fmt.Errorf("unable to export ova %s: %v", name, err)
+		}
+		m.Ovas = append(m.Ovas, Ova{Name: name, File: file})
+	}
+
+	m.Files = w.sums
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	w.sums = nil
+	if err := w.addBytes(manifestFile, manifest); err != nil {
+		return nil, err
+	}
+	if err := w.addBytes(signatureFile, sign(key, manifest)); err != nil {
+		return nil, err
+	}
+
+	if err := w.tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func exportImage(ctx context.Context, w *writer, staging, name string, i int) (Image, error) {
+	id, err := docker.ImageID(name)
+	if err != nil {
+		return Image{}, err
+	}
+
+	// the size of the image is needed before it is added
+	p := filepath.Join(staging, fmt.Sprintf("%d.tar", i))
+	f, err := os.Create(p)
+	if err != nil {
+		return Image{}, err
+	}
+	err = docker.SaveImage(ctx, name, f)
+	f.Close()
+	if err != nil {
+		return Image{}, err
+	}
+	defer os.Remove(p)
+
+	img := Image{
+		Name: name,
+		ID:   id,
+		File: path.Join(imagesDir, fmt.Sprintf("%d.tar", i)),
+	}
+	// images built locally have no digest
+	if digest, err := docker.ImageDigest(name); err == nil {
+		img.Digest = digest
+	}
+
+	return img, w.addFile(img.File, p)
+}
+
+// allowedFile reports whether a file of the given name can be part of a bundle
+func allowedFile(name string) bool {
+	switch name {
+	case manifestFile, signatureFile, exercisesFile, categoriesFile:
+		return true
+	}
+
+	dir, file := path.Split(name)
+	return file != "" && (dir == imagesDir+"/" || dir == ovasDir+"/")
+}
+
+// Import verifies the bundle with the public key, loads its images into
+// docker, moves its OVAs to the OVA directory and adds its exercises to
+// the bundles in dir. Nothing is imported when the bundle is invalid, and
+// the loaded images and moved OVAs are rolled back when a step fails.
+func Import(ctx context.Context, in io.Reader, dir, ovaDir string, key ed25519.PublicKey) (*Manifest, error) {
+	if len(key) == 0 {
+		return nil, ErrNoKey
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	staging, err := ioutil.TempDir(dir, ".import")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	gr, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	sums := map[string]string{}
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || !allowedFile(name) {
+			return nil, UnexpectedFileErr{hdr.Name}
+		}
+		if _, ok := sums[name]; ok {
+			return nil, UnexpectedFileErr{hdr.Name}
+		}
+
+		sum, err := extract(tr, filepath.Join(staging, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, err
+		}
+		sums[name] = sum
+	}
+
+	m, err := verify(staging, sums, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var r rollback
+	for _, img := range m.Images {
+		// images loaded with a wrong id are removed as well
+		if _, err := docker.ImageID(img.Name); err != nil {
+			r.images = append(r.images, img.Name)
+		}
+		if err := loadImage(ctx, staging, img); err != nil {
+			r.undo()
+			return nil, fmt.Errorf("unable to load image %s: %v", img.Name, err)
+		}
+	}
+
+	if err := os.MkdirAll(ovaDir, 0755); err != nil {
+		r.undo()
+		return nil, err
+	}
+	for _, ova := range m.Ovas {
+		src := filepath.Join(staging, filepath.FromSlash(ova.File))
+		dst := filepath.Join(ovaDir, path.Base(ova.File))
+		if err := r.move(src, dst); err != nil {
+			r.undo()
+			return nil, fmt.Errorf("unable to import ova %s: %v", ova.Name, err)
+		}
+	}
+
+	if err := addToCatalog(dir, staging, m); err != nil {
+		r.undo()
+		return nil, err
+	}
+	r.done()
+
+	log.Info().
+		Int("exercises", len(m.Exercises)).
+		Int("images", len(m.Images)).
+		Int("ovas", len(m.Ovas)).
+		Msg("Imported bundle")
+
+	return m, nil
+}
+
+func extract(r io.Reader, p string) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verify checks the signature of the manifest and
+// the checksums of the extracted files against it
+func verify(staging string, sums map[string]string, key ed25519.PublicKey) (*Manifest, error) {
+	manifest, err := ioutil.ReadFile(filepath.Join(staging, manifestFile))
+	if err != nil {
+		return nil, ErrNoManifest
+	}
+	signature, err := ioutil.ReadFile(filepath.Join(staging, signatureFile))
+	if err != nil {
+		return nil, ErrNoManifest
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil || !ed25519.Verify(key, manifest, sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, err
+	}
+
+	for name, sum := range sums {
+		if name == manifestFile || name == signatureFile {
+			continue
+		}
+		if m.Files[name] != sum {
+			return nil, ChecksumErr{name}
+		}
+	}
+	for name := range m.Files {
+		if _, ok := sums[name]; !ok {
+			return nil, ChecksumErr{name}
+		}
+	}
+
+	return &m, nil
+}
+
+func loadImage(ctx context.Context, staging string, img Image) error {
+	f, err := os.Open(filepath.Join(staging, filepath.FromSlash(img.File)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := docker.LoadImage(ctx, f); err != nil {
+		return err
+	}
+
+	id, err := docker.ImageID(img.Name)
+	if err != nil {
+		return err
+	}
+	if id != img.ID {
+		return fmt.Errorf("loaded image has id %s instead of %s", id, img.ID)
+	}
+
+	return nil
+}
+
+// rollback undoes the changes of a failed import, OVAs which
+// are replaced by the import are kept until it succeeds
+type rollback struct {
+	images   []string
+	moved    []string
+	replaced []string
+}
+
+const replacedSuffix = ".replaced"
+
+func (r *rollback) move(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		if err := os.Rename(dst, dst+replacedSuffix); err != nil {
+			return err
+		}
+		r.replaced = append(r.replaced, dst)
+	}
+
+	if err := move(src, dst); err != nil {
+		return err
+	}
+	r.moved = append(r.moved, dst)
+
+	return nil
+}
+
+func (r *rollback) undo() {
+	for _, dst := range r.moved {
+		os.Remove(dst)
+	}
+	for _, dst := range r.replaced {
+		if err := os.Rename(dst+replacedSuffix, dst); err != nil {
+			log.Warn().Msgf("Unable to restore %s: %v", dst, err)
+		}
+	}
+	for _, name := range r.images {
+		if err := docker.RemoveImage(name); err != nil {
+			log.Warn().Str("image", name).Msgf("Unable to remove image of failed import: %v", err)
+		}
+	}
+}
+
+func (r *rollback) done() {
+	for _, dst := range r.replaced {
+		os.RemoveAll(dst + replacedSuffix)
+	}
+}
+
+func move(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	// the directories might be on different file systems
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
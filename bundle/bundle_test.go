@@ -0,0 +1,188 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+)
+
+func generateKeys(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	public, private, err := GenerateKeys()
+	if err != nil {
+		t.Fatalf("unable to generate keys: %v", err)
+	}
+	pub, err := ParsePublicKey(public)
+	if err != nil {
+		t.Fatalf("unable to parse public key: %v", err)
+	}
+	priv, err := ParsePrivateKey(private)
+	if err != nil {
+		t.Fatalf("unable to parse private key: %v", err)
+	}
+
+	return pub, priv
+}
+
+func TestExportImport(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "bundle-test")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	ova := filepath.Join(tmp, "kali.ova")
+	if err := ioutil.WriteFile(ova, []byte("not really an ova"), 0644); err != nil {
+		t.Fatalf("unable to write ova: %v", err)
+	}
+
+	src := Source{
+		Exercises: []*eproto.Exercise{
+			{Tag: "sql", Name: "SQL Injection", Category: "web"},
+			{Tag: "xss", Name: "Cross-site Scripting", Category: "web"},
+		},
+		Categories: []*eproto.GetCategoriesResponse_Category{
+			{Tag: "web", Name: "Web Exploitation"},
+		},
+		Ovas: map[string]string{"kali": ova},
+	}
+	public, private := generateKeys(t)
+	other, _ := generateKeys(t)
+
+	var buf bytes.Buffer
+	if _, err := Export(context.Background(), &buf, src, private); err != nil {
+		t.Fatalf("unexpected error when exporting: %v", err)
+	}
+	raw := buf.Bytes()
+
+	tt := []struct {
+		name string
+		key  ed25519.PublicKey
+		err  error
+	}{
+		{name: "Normal", key: public},
+		{name: "Invalid key", key: other, err: ErrInvalidSignature},
+		{name: "No key", err: ErrNoKey},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := filepath.Join(tmp, tc.name, "bundles")
+			ovaDir := filepath.Join(tmp, tc.name, "vbox")
+
+			_, err := Import(context.Background(), bytes.NewReader(raw), dir, ovaDir, tc.key)
+			if err != tc.err {
+				t.Fatalf("expected error %v, got: %v", tc.err, err)
+			}
+			if tc.err != nil {
+				if _, err := os.Stat(filepath.Join(ovaDir, "kali.ova")); !os.IsNotExist(err) {
+					t.Fatalf("expected ova not to be imported")
+				}
+				return
+			}
+
+			content, err := ioutil.ReadFile(filepath.Join(ovaDir, "kali.ova"))
+			if err != nil {
+				t.Fatalf("expected ova to be imported: %v", err)
+			}
+			if string(content) != "not really an ova" {
+				t.Fatalf("unexpected content of imported ova: %s", content)
+			}
+
+			ec, err := NewExerciseClient(dir)
+			if err != nil {
+				t.Fatalf("unable to create exercise client: %v", err)
+			}
+
+			resp, err := ec.GetExerciseByTags(context.Background(), &eproto.GetExerciseByTagsRequest{Tag: []string{"xss"}})
+			if err != nil {
+				t.Fatalf("unexpected error when getting exercises: %v", err)
+			}
+			if len(resp.Exercises) != 1 || resp.Exercises[0].Name != "Cross-site Scripting" {
+				t.Fatalf("unexpected exercises: %v", resp.Exercises)
+			}
+
+			if _, err := ec.GetExerciseByTags(context.Background(), &eproto.GetExerciseByTagsRequest{Tag: []string{"unknown"}}); err == nil {
+				t.Fatalf("expected error for unknown exercise")
+			}
+
+			cats, err := ec.GetCategories(context.Background(), &eproto.Empty{})
+			if err != nil {
+				t.Fatalf("unexpected error when getting categories: %v", err)
+			}
+			if len(cats.Categories) != 1 {
+				t.Fatalf("expected one category, got: %d", len(cats.Categories))
+			}
+		})
+	}
+}
+
+func TestImportRollback(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "bundle-test")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	kali := filepath.Join(tmp, "kali.ova")
+	router := filepath.Join(tmp, "router.ova")
+	for _, f := range []string{kali, router} {
+		if err := ioutil.WriteFile(f, []byte("new ova"), 0644); err != nil {
+			t.Fatalf("unable to write ova: %v", err)
+		}
+	}
+
+	src := Source{
+		Exercises: []*eproto.Exercise{{Tag: "sql", Name: "SQL Injection"}},
+		Ovas:      map[string]string{"kali": kali, "router": router},
+	}
+	public, private := generateKeys(t)
+
+	var buf bytes.Buffer
+	if _, err := Export(context.Background(), &buf, src, private); err != nil {
+		t.Fatalf("unexpected error when exporting: %v", err)
+	}
+
+	dir := filepath.Join(tmp, "bundles")
+	ovaDir := filepath.Join(tmp, "vbox")
+	for _, d := range []string{dir, ovaDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatalf("unable to create directory: %v", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(ovaDir, "kali.ova"), []byte("old ova"), 0644); err != nil {
+		t.Fatalf("unable to write ova: %v", err)
+	}
+	// the catalog cannot be read, hence the import fails after the ovas are moved
+	if err := ioutil.WriteFile(filepath.Join(dir, exercisesFile), []byte("invalid"), 0644); err != nil {
+		t.Fatalf("unable to write catalog: %v", err)
+	}
+
+	if _, err := Import(context.Background(), &buf, dir, ovaDir, public); err == nil {
+		t.Fatalf("expected import to fail")
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(ovaDir, "kali.ova"))
+	if err != nil {
+		t.Fatalf("expected existing ova to be restored: %v", err)
+	}
+	if string(content) != "old ova" {
+		t.Fatalf("expected existing ova to be restored, got: %s", content)
+	}
+	files, err := ioutil.ReadDir(ovaDir)
+	if err != nil {
+		t.Fatalf("unable to read ova directory: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected only the existing ova to be left, got %d files", len(files))
+	}
+}
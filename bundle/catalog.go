@@ -0,0 +1,282 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+const (
+	imagesFile = "images.json"
+)
+
+var (
+	ErrReadOnly = errors.New("exercises of offline bundles are read only")
+)
+
+type UnknownExerciseErr struct {
+	Tag string
+}
+
+func (err UnknownExerciseErr) Error() string {
+	return fmt.Sprintf("unknown exercise in offline bundles: %s", err.Tag)
+}
+
+var marshaler = jsonpb.Marshaler{Indent: "  "}
+
+func marshalExercises(exercises []*eproto.Exercise) ([]byte, error) {
+	var buf bytes.Buffer
+	err := marshaler.Marshal(&buf, &eproto.GetExercisesResponse{Exercises: exercises})
+	return buf.Bytes(), err
+}
+
+func marshalCategories(categories []*eproto.GetCategoriesResponse_Category) ([]byte, error) {
+	var buf bytes.Buffer
+	err := marshaler.Marshal(&buf, &eproto.GetCategoriesResponse{Categories: categories})
+	return buf.Bytes(), err
+}
+
+// catalog is the content of all bundles imported into a directory
+type catalog struct {
+	exercises  []*eproto.Exercise
+	categories []*eproto.GetCategoriesResponse_Category
+	images     map[string]string
+}
+
+// readCatalog reads the catalog of dir, missing files are treated as empty
+func readCatalog(dir string) (*catalog, error) {
+	c := &catalog{images: map[string]string{}}
+
+	var exercises eproto.GetExercisesResponse
+	if err := readProto(filepath.Join(dir, exercisesFile), &exercises); err != nil {
+		return nil, err
+	}
+	c.exercises = exercises.Exercises
+
+	var categories eproto.GetCategoriesResponse
+	if err := readProto(filepath.Join(dir, categoriesFile), &categories); err != nil {
+		return nil, err
+	}
+	c.categories = categories.Categories
+
+	raw, err := ioutil.ReadFile(filepath.Join(dir, imagesFile))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(raw, &c.images); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func readProto(path string, pb proto.Message) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := jsonpb.Unmarshal(f, pb); err != nil {
+		return fmt.Errorf("unable to read %s: %v", path, err)
+	}
+
+	return nil
+}
+
+func (c *catalog) write(dir string) error {
+	exercises, err := marshalExercises(c.exercises)
+	if err != nil {
+		return err
+	}
+	categories, err := marshalCategories(c.categories)
+	if err != nil {
+		return err
+	}
+	images, err := json.MarshalIndent(c.images, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	for name, data := range map[string][]byte{
+		exercisesFile:  exercises,
+		categoriesFile: categories,
+		imagesFile:     images,
+	} {
+		p := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(p+".tmp", data, 0644); err != nil {
+			return err
+		}
+		if err := os.Rename(p+".tmp", p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// merge adds the content of o, replacing exercises and categories with the same tag
+func (c *catalog) merge(o *catalog) {
+	exercises := map[string]int{}
+	for i, e := range c.exercises {
+		exercises[e.Tag] = i
+	}
+	for _, e := range o.exercises {
+		if i, ok := exercises[e.Tag]; ok {
+			c.exercises[i] = e
+			continue
+		}
+		exercises[e.Tag] = len(c.exercises)
+		c.exercises = append(c.exercises, e)
+	}
+
+	categories := map[string]int{}
+	for i, cat := range c.categories {
+		categories[cat.Tag] = i
+	}
+	for _, cat := range o.categories {
+		if i, ok := categories[cat.Tag]; ok {
+			c.categories[i] = cat
+			continue
+		}
+		categories[cat.Tag] = len(c.categories)
+		c.categories = append(c.categories, cat)
+	}
+
+	for name, id := range o.images {
+		c.images[name] = id
+	}
+}
+
+func addToCatalog(dir, staging string, m *Manifest) error {
+	imported, err := readCatalog(staging)
+	if err != nil {
+		return err
+	}
+	for _, img := range m.Images {
+		imported.images[img.Name] = img.ID
+	}
+
+	c, err := readCatalog(dir)
+	if err != nil {
+		return err
+	}
+	c.merge(imported)
+
+	return c.write(dir)
+}
+
+// LoadImages returns the ids of the docker images imported into dir by name
+func LoadImages(dir string) (map[string]string, error) {
+	c, err := readCatalog(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.images, nil
+}
+
+// ExerciseClient serves the exercises imported into a directory
+// in place of the exercise service
+type ExerciseClient struct {
+	dir string
+
+	m sync.Mutex
+	c *catalog
+}
+
+func NewExerciseClient(dir string) (*ExerciseClient, error) {
+	c, err := readCatalog(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExerciseClient{dir: dir, c: c}, nil
+}
+
+func (ec *ExerciseClient) catalog() *catalog {
+	ec.m.Lock()
+	defer ec.m.Unlock()
+	return ec.c
+}
+
+func (ec *ExerciseClient) GetExercises(ctx context.Context, in *eproto.Empty, opts ...grpc.CallOption) (*eproto.GetExercisesResponse, error) {
+	return &eproto.GetExercisesResponse{Exercises: ec.catalog().exercises}, nil
+}
+
+func (ec *ExerciseClient) GetExerciseByTags(ctx context.Context, in *eproto.GetExerciseByTagsRequest, opts ...grpc.CallOption) (*eproto.GetExercisesResponse, error) {
+	exercises := map[string]*eproto.Exercise{}
+	for _, e := range ec.catalog().exercises {
+		exercises[e.Tag] = e
+	}
+
+	var res []*eproto.Exercise
+	for _, t := range in.Tag {
+		e, ok := exercises[t]
+		if !ok {
+			return nil, UnknownExerciseErr{t}
+		}
+		res = append(res, e)
+	}
+
+	return &eproto.GetExercisesResponse{Exercises: res}, nil
+}
+
+func (ec *ExerciseClient) GetExerciseByCategory(ctx context.Context, in *eproto.GetExerciseByCategoryRequest, opts ...grpc.CallOption) (*eproto.GetExercisesResponse, error) {
+	var res []*eproto.Exercise
+	for _, e := range ec.catalog().exercises {
+		if e.Category == in.Category {
+			res = append(res, e)
+		}
+	}
+
+	return &eproto.GetExercisesResponse{Exercises: res}, nil
+}
+
+func (ec *ExerciseClient) GetCategories(ctx context.Context, in *eproto.Empty, opts ...grpc.CallOption) (*eproto.GetCategoriesResponse, error) {
+	return &eproto.GetCategoriesResponse{Categories: ec.catalog().categories}, nil
+}
+
+// UpdateStatus reloads the exercises, such that bundles
+// imported after the daemon started become available
+func (ec *ExerciseClient) UpdateStatus(ctx context.Context, in *eproto.Empty, opts ...grpc.CallOption) (*eproto.ResponseStatus, error) {
+	c, err := readCatalog(ec.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ec.m.Lock()
+	ec.c = c
+	ec.m.Unlock()
+
+	return &eproto.ResponseStatus{}, nil
+}
+
+func (ec *ExerciseClient) AddExercise(ctx context.Context, in *eproto.AddExerciseRequest, opts ...grpc.CallOption) (*eproto.ResponseStatus, error) {
+	return nil, ErrReadOnly
+}
+
+func (ec *ExerciseClient) AddCategory(ctx context.Context, in *eproto.AddCategoryRequest, opts ...grpc.CallOption) (*eproto.ResponseStatus, error) {
+	return nil, ErrReadOnly
+}
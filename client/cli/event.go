@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
 	"time"
 
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
@@ -17,6 +19,8 @@ import (
 
 var (
 	UnableCreateEListErr = errors.New("Failed to create event list")
+	NoEventTagErr        = errors.New("Event tag is required, either as argument or with --tag")
+	NoEventNameErr       = errors.New("Event name is required when no template is given")
 )
 
 func (c *Client) CmdEvent() *cobra.Command {
@@ -33,8 +37,14 @@ func (c *Client) CmdEvent() *cobra.Command {
 		c.CmdEventResume(),
 		c.CmdEventList(),
 		c.CmdEventTeams(),
-		c.CmdEventLoadTest(),
 		c.CmdEventTeamRestart(),
+		c.CmdEventIdlePolicy(),
+		c.CmdEventDNSRecords(),
+		c.CmdEventSnapshots(),
+		c.CmdEventCapture(),
+		c.CmdEventRecordings(),
+		c.CmdEventReplay(),
+		c.CmdEventPrepare(),
 		c.CmdAddNotification())
 
 	return cmd
@@ -43,6 +53,8 @@ func (c *Client) CmdEvent() *cobra.Command {
 func (c *Client) CmdEventCreate() *cobra.Command {
 	var (
 		name              string
+		tag               string
+		template          string
 		available         int
 		capacity          int
 		frontends         []string
@@ -50,18 +62,67 @@ func (c *Client) CmdEventCreate() *cobra.Command {
 		disabledExercises []string
 		startTime         uint64
 		finishTime        uint64
+		start             string
+		finish            string
 		onlyVPN           bool
 		secretKey         string
+		idleThreshold     time.Duration
+		idleInterval      time.Duration
+		idleAction        string
+		backend           string
+		vpnProfiles       int
+		blockInternet     bool
+		allowEgress       []string
+		isolateLabs       bool
+		ipv6              bool
+		portForward       bool
+		terminalExercises []string
+		recordSessions    bool
+		recordRetention   time.Duration
+		captureTraffic    bool
 	)
 
 	cmd := &cobra.Command{
-		Use:     "create [event tag]",
-		Short:   "Create event",
-		Example: `hkn event create esboot -name "ES Bootcamp" -a 5 -c 30 -e scan,sql,hb -f kali -d 2020-02-15 -k secretKey`,
-		Args:    cobra.MinimumNArgs(1),
+		Use:   "create [event tag]",
+		Short: "Create event",
+		Example: `hkn event create esboot -name "ES Bootcamp" -a 5 -c 30 -e scan,sql,hb -f kali -d 2020-02-15 -k secretKey
+hkn event create --template course-intro --tag xyz --start 2020-02-15 --finish "2020-02-20 16:00"`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 0 {
+				tag = args[0]
+			}
+			if tag == "" {
+				PrintError(NoEventTagErr)
+				return
+			}
+			if name == "" && template == "" {
+				PrintError(NoEventNameErr)
+				return
+			}
+
+			startsAt, err := eventTime(start, time.Now().AddDate(0, 0, int(startTime)))
+			if err != nil {
+				PrintError(err)
+				return
+			}
+			finishesAt, err := eventTime(finish, time.Now().AddDate(0, 0, int(finishTime)))
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			// values of the template are used for flags which are not given
+			if template != "" {
+				if !cmd.Flags().Changed("available") {
+					available = 0
+				}
+				if !cmd.Flags().Changed("capacity") {
+					capacity = 0
+				}
+			}
+
 			ctx := context.Background()
-			tag := args[0]
 			stream, err := c.rpcClient.CreateEvent(ctx, &pb.CreateEventRequest{
 				Name:             name,
 				Tag:              tag,
@@ -71,9 +132,30 @@ func (c *Client) CmdEventCreate() *cobra.Command {
 				Available:        int32(available),
 				Capacity:         int32(capacity),
 				OnlyVPN:          0,
-				StartTime:        time.Now().AddDate(0, 0, int(startTime)).Format("2006-01-02 15:04:05"),
-				FinishTime:       time.Now().AddDate(0, 0, int(finishTime)).Format("2006-01-02 15:04:05"),
+				StartTime:        startsAt.Format("2006-01-02 15:04:05"),
+				FinishTime:       finishesAt.Format("2006-01-02 15:04:05"),
 				SecretEvent:      secretKey,
+				IdlePolicy: &pb.IdlePolicy{
+					ThresholdMinutes:     int32(idleThreshold / time.Minute),
+					CheckIntervalMinutes: int32(idleInterval / time.Minute),
+					Action:               idleAction,
+				},
+				Template:    template,
+				Backend:     backend,
+				VpnProfiles: int32(vpnProfiles),
+				EgressPolicy: &pb.EgressPolicy{
+					BlockInternet: blockInternet,
+					Allow:         allowEgress,
+					Isolate:       isolateLabs,
+				},
+				Ipv6:              ipv6,
+				PortForward:       portForward,
+				TerminalExercises: terminalExercises,
+				RecordingPolicy: &pb.RecordingPolicy{
+					Enabled:        recordSessions,
+					RetentionHours: int32(recordRetention.Hours()),
+				},
+				Capture: captureTraffic,
 			})
 			if err != nil {
 				PrintError(err)
@@ -94,6 +176,8 @@ func (c *Client) CmdEventCreate() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&name, "name", "n", "", "the event name")
+	cmd.Flags().StringVar(&tag, "tag", "", "the event tag, alternative to giving it as argument")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "event template to use for values which are not given")
 	cmd.Flags().IntVarP(&available, "available", "a", 5, "amount of labs to make available initially for the event")
 	cmd.Flags().IntVarP(&capacity, "capacity", "c", 10, "maximum amount of labs")
 	cmd.Flags().BoolVarP(&onlyVPN, "vpnconn", "v", false, "enable only vpn connection")
@@ -102,12 +186,41 @@ func (c *Client) CmdEventCreate() *cobra.Command {
 	cmd.Flags().StringSliceVarP(&disabledExercises, "disabled-exercises", "x", []string{}, "list of disabled exercises, will be spin off by user in the event manually")
 	cmd.Flags().Uint64VarP(&finishTime, "finishtime", "d", 15, "expected finish time of the event")
 	cmd.Flags().Uint64VarP(&startTime, "starttime", "s", 0, "expected start time of the event")
+	cmd.Flags().StringVar(&start, "start", "", "start date of the event, e.g. 2020-02-15 or \"2020-02-15 09:00\" (overrides --starttime)")
+	cmd.Flags().StringVar(&finish, "finish", "", "finish date of the event, e.g. 2020-02-20 or \"2020-02-20 16:00\" (overrides --finishtime)")
 	cmd.Flags().StringVarP(&secretKey, "secretkey", "k", "", "secret key for protecting events")
-	cmd.MarkFlagRequired("name")
+	cmd.Flags().DurationVar(&idleThreshold, "idle-threshold", 0, "inactivity after which labs are suspended or stopped (default 8h)")
+	cmd.Flags().DurationVar(&idleInterval, "idle-interval", 0, "interval of checking idle labs (default 15m)")
+	cmd.Flags().StringVar(&idleAction, "idle-action", "", "action on idle labs, suspend or stop (default suspend)")
+	cmd.Flags().StringVar(&backend, "backend", "", "backend running the exercises of labs, docker or kubernetes (default docker)")
+	cmd.Flags().IntVar(&vpnProfiles, "vpn-profiles", 0, "amount of VPN profiles every team can create (default 4)")
+	cmd.Flags().BoolVar(&blockInternet, "block-internet", false, "exercises of labs cannot reach the internet")
+	cmd.Flags().StringSliceVar(&allowEgress, "allow-egress", nil, "domains, addresses or subnets exercises can reach when the internet is blocked")
+	cmd.Flags().BoolVar(&isolateLabs, "isolate-labs", false, "labs cannot reach the labs of other teams")
+	cmd.Flags().BoolVar(&ipv6, "ipv6", false, "dual-stack lab networks with IPv6 subnets")
+	cmd.Flags().BoolVar(&portForward, "port-forward", false, "forward the services exposed by exercises to teams on their own subdomains and ports")
+	cmd.Flags().StringSliceVar(&terminalExercises, "terminal", nil, "exercises which teams can solve from a terminal in their browser")
+	cmd.Flags().BoolVar(&recordSessions, "record-sessions", false, "record the guacamole connections of teams, to be replayed by organizers")
+	cmd.Flags().DurationVar(&recordRetention, "recording-retention", 0, "how long recordings are kept, in whole hours (default forever)")
+	cmd.Flags().BoolVar(&captureTraffic, "capture", false, "capture the traffic on the lab networks of teams, to be downloaded as pcap files")
 
 	return cmd
 }
 
+// eventTime parses a date given on the command line,
+// falling back to def when no date is given
+func eventTime(value string, def time.Time) (time.Time, error) {
+	if value == "" {
+		return def, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q, expected format 2006-01-02 or \"2006-01-02 15:04\"", value)
+}
+
 func (c *Client) CmdEventStop() *cobra.Command {
 	return &cobra.Command{
 		Use:     "stop [event tag]",
@@ -142,6 +255,63 @@ func (c *Client) CmdEventStop() *cobra.Command {
 	}
 }
 
+func (c *Client) CmdEventPrepare() *cobra.Command {
+	var (
+		exercises []string
+		frontends []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prepare [event tag]",
+		Short: "Pull the images of an event ahead of its start",
+		Example: `hkn event prepare esboot
+hkn event prepare -e xss,sql -f kali`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			req := &pb.PrepareEventRequest{
+				Exercises: exercises,
+				Frontends: frontends,
+			}
+			if len(args) > 0 {
+				req.EventTag = args[0]
+			}
+
+			stream, err := c.rpcClient.PrepareEvent(ctx, req)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			for {
+				s, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+
+				if err != nil {
+					PrintError(err)
+					return
+				}
+
+				host := s.Kind
+				if s.Worker != "" {
+					host = s.Worker + "/" + s.Kind
+				}
+				if s.ErrorMessage != "" {
+					fmt.Printf("[%s] %s: %s (%s)\n", host, s.Image, s.Status, s.ErrorMessage)
+					continue
+				}
+				fmt.Printf("[%s] %s: %s\n", host, s.Image, s.Status)
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&exercises, "exercises", "e", []string{}, "list of exercises to prepare, when no event tag is given")
+	cmd.Flags().StringSliceVarP(&frontends, "frontends", "f", []string{}, "list of frontends to prepare, when no event tag is given")
+
+	return cmd
+}
+
 func (c *Client) CmdEventSuspend() *cobra.Command {
 	return &cobra.Command{
 		Use:     "suspend",
@@ -278,8 +448,8 @@ func (c *Client) CmdEventTeams() *cobra.Command {
 			}
 
 			f := formatter{
-				header: []string{"TEAM ID", "NAME", "EMAIL", "LAST ACCESSED"},
-				fields: []string{"Id", "Name", "Email", "AccessedAt"},
+				header: []string{"TEAM ID", "NAME", "EMAIL", "LAST ACCESSED", "QUEUE POSITION"},
+				fields: []string{"Id", "Name", "Email", "AccessedAt", "QueuePosition"},
 			}
 
 			var elements []formatElement
@@ -293,32 +463,134 @@ func (c *Client) CmdEventTeams() *cobra.Command {
 				return
 			}
 			fmt.Printf(table)
+			if r.QueueLength > 0 {
+				fmt.Printf("%d team(s) waiting for a lab\n", r.QueueLength)
+			}
 		},
 	}
 }
 
-func (c *Client) CmdEventLoadTest() *cobra.Command {
-	var eventTag string
-	var numberOfTeams int32
+func (c *Client) CmdEventIdlePolicy() *cobra.Command {
+	var (
+		threshold   time.Duration
+		interval    time.Duration
+		action      string
+		exemptTeams []string
+	)
+
 	cmd := &cobra.Command{
-		Use:     "load",
-		Short:   "Apply load test on an event",
-		Example: `hkn event load -t test -r 3 `,
+		Use:     "idle [event tag]",
+		Short:   "Show or change idle policy of an event",
+		Example: `hkn event idle esboot --threshold 2h --interval 10m --action stop --exempt 3c2a5b1d`,
+		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
-			r, err := c.rpcClient.StressEvent(ctx, &pb.TestEventLoadReq{EventName: eventTag, NumberOfTeams: numberOfTeams})
 
+			req := &pb.EventIdlePolicyRequest{EventTag: args[0]}
+			var policy *pb.IdlePolicy
+			var err error
+			if cmd.Flags().NFlag() == 0 {
+				policy, err = c.rpcClient.GetEventIdlePolicy(ctx, req)
+			} else {
+				req.Policy = &pb.IdlePolicy{
+					ThresholdMinutes:     int32(threshold / time.Minute),
+					CheckIntervalMinutes: int32(interval / time.Minute),
+					Action:               action,
+					ExemptTeams:          exemptTeams,
+				}
+				// only the fields which are given are changed
+				for flag, field := range map[string]string{
+					"threshold": "threshold",
+					"interval":  "checkInterval",
+					"action":    "action",
+					"exempt":    "exemptTeams",
+				} {
+					if cmd.Flags().Changed(flag) {
+						req.Fields = append(req.Fields, field)
+					}
+				}
+				policy, err = c.rpcClient.SetEventIdlePolicy(ctx, req)
+			}
 			if err != nil {
 				PrintError(err)
 				return
 			}
-			fmt.Println(r.SignUpResult)
-			return
+
+			fmt.Printf("Threshold:      %s\n", time.Duration(policy.ThresholdMinutes)*time.Minute)
+			fmt.Printf("Check interval: %s\n", time.Duration(policy.CheckIntervalMinutes)*time.Minute)
+			fmt.Printf("Action:         %s\n", policy.Action)
+			fmt.Printf("Exempt teams:   %s\n", strings.Join(policy.ExemptTeams, ", "))
 		},
 	}
-	cmd.Flags().StringVarP(&eventTag, "tag", "t", "", "event tag")
-	cmd.Flags().Int32VarP(&numberOfTeams, "requests", "r", 1, "number of users")
+
+	cmd.Flags().DurationVar(&threshold, "threshold", 0, "inactivity after which labs are suspended or stopped")
+	cmd.Flags().DurationVar(&interval, "interval", 0, "interval of checking idle labs")
+	cmd.Flags().StringVar(&action, "action", "", "action on idle labs, suspend or stop")
+	cmd.Flags().StringSliceVar(&exemptTeams, "exempt", []string{}, "list of team ids which are never suspended")
+
+	return cmd
+}
+
+func (c *Client) CmdEventDNSRecords() *cobra.Command {
+	var (
+		records []string
+		clear   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "dns [event tag]",
+		Short:   "Show or change custom DNS records of an event",
+		Example: `hkn event dns esboot --record "formalbank-login.com A 10.10.10.5" --record "mail.formalbank.com CNAME formalbank.com"`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			req := &pb.EventDNSRecordsRequest{EventTag: args[0]}
+			var resp *pb.EventDNSRecords
+			var err error
+			if len(records) == 0 && !clear {
+				resp, err = c.rpcClient.GetEventDNSRecords(ctx, req)
+			} else {
+				for _, r := range records {
+					// the data of TXT records may contain spaces
+					parts := strings.SplitN(strings.TrimSpace(r), " ", 3)
+					if len(parts) != 3 {
+						PrintError(fmt.Errorf("invalid record %q, use \"<name> <type> <data>\"", r))
+						return
+					}
+					req.Records = append(req.Records, &pb.DNSRecord{Name: parts[0], Type: parts[1], Data: parts[2]})
+				}
+				resp, err = c.rpcClient.SetEventDNSRecords(ctx, req)
+			}
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"NAME", "TYPE", "DATA"},
+				fields: []string{"Name", "Type", "Data"},
+			}
+
+			var elements []formatElement
+			for _, r := range resp.Records {
+				elements = append(elements, r)
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&records, "record", "r", []string{}, "record as \"<name> <type> <data>\", replaces all records of the event")
+	cmd.Flags().BoolVar(&clear, "clear", false, "remove all records of the event")
+
 	return cmd
 }
 
@@ -409,3 +681,191 @@ func checkStatus(status string) int32 {
 	}
 	return statusID
 }
+
+func (c *Client) CmdEventSnapshots() *cobra.Command {
+	var teamId string
+
+	cmd := &cobra.Command{
+		Use:     "snapshots [event tag]",
+		Short:   "List frontend snapshots taken by teams of an event",
+		Example: `hkn event snapshots esboot --team 3c2a5b1d`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListSnapshots(ctx, &pb.ListSnapshotsRequest{
+				EventTag: args[0],
+				TeamId:   teamId,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"TEAM ID", "SNAPSHOT", "CREATED AT", "SIZE"},
+				fields: []string{"TeamId", "Name", "CreatedAt", "Size"},
+			}
+
+			var elements []formatElement
+			for _, s := range r.Snapshots {
+				elements = append(elements, struct {
+					TeamId    string
+					Name      string
+					CreatedAt string
+					Size      string
+				}{
+					TeamId:    s.TeamId,
+					Name:      s.Name,
+					CreatedAt: s.CreatedAt,
+					Size:      fmt.Sprintf("%.1f MB", float64(s.SizeBytes)/(1<<20)),
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+
+	cmd.Flags().StringVarP(&teamId, "team", "t", "", "only list snapshots of the given team")
+
+	return cmd
+}
+
+func (c *Client) CmdEventCapture() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:     "capture [event tag] [team id]",
+		Short:   "Download the captured traffic of the lab of a team",
+		Example: `hkn event capture esboot 3c2a5b1d -o 3c2a5b1d.tar.gz`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			stream, err := c.rpcClient.DownloadCapture(ctx, &pb.DownloadCaptureRequest{
+				EventTag: args[0],
+				TeamId:   args[1],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if output == "" {
+				output = fmt.Sprintf("capture-%s-%s.tar.gz", args[0], args[1])
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+			defer f.Close()
+
+			var size int
+			for {
+				chunk, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					os.Remove(output)
+					PrintError(err)
+					return
+				}
+
+				if _, err := f.Write(chunk.Data); err != nil {
+					PrintError(err)
+					return
+				}
+				size += len(chunk.Data)
+			}
+
+			fmt.Printf("Saved capture to %s (%.1f MB)\n", output, float64(size)/(1<<20))
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to save the capture to")
+
+	return cmd
+}
+
+func (c *Client) CmdEventRecordings() *cobra.Command {
+	return &cobra.Command{
+		Use:     "recordings [event tag]",
+		Short:   "List the recorded guacamole connections of the teams of an event",
+		Example: `hkn event recordings esboot`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListRecordings(ctx, &pb.ListRecordingsRequest{EventTag: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"ID", "TEAM", "STARTED AT", "FINISHED AT", "SIZE"},
+				fields: []string{"Id", "Team", "StartedAt", "FinishedAt", "Size"},
+			}
+
+			var elements []formatElement
+			for _, rec := range r.Recordings {
+				team := rec.TeamId
+				if rec.TeamName != "" {
+					team = fmt.Sprintf("%s (%s)", rec.TeamName, rec.TeamId)
+				}
+				elements = append(elements, struct {
+					Id         string
+					Team       string
+					StartedAt  string
+					FinishedAt string
+					Size       string
+				}{
+					Id:         rec.Id,
+					Team:       team,
+					StartedAt:  rec.StartedAt,
+					FinishedAt: rec.FinishedAt,
+					Size:       fmt.Sprintf("%.1f KB", float64(rec.Size)/(1<<10)),
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdEventReplay() *cobra.Command {
+	return &cobra.Command{
+		Use:     "replay [event tag] [recording id]",
+		Short:   "Get the address replaying a recorded guacamole connection in the browser",
+		Example: `hkn event replay esboot d11eb89b/20200215-090000.000000000`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ReplayRecording(ctx, &pb.ReplayRecordingRequest{
+				EventTag: args[0],
+				Id:       args[1],
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Println(r.Url)
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	color "github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
 	"io"
+	"time"
 )
 
 func (c *Client) CmdHost() *cobra.Command {
@@ -22,6 +23,8 @@ func (c *Client) CmdHost() *cobra.Command {
 
 	cmd.AddCommand(
 		c.CmdHostMonitor(),
+		c.CmdHostPrune(),
+		c.CmdHostSubnets(),
 	)
 
 	return cmd
@@ -100,3 +103,73 @@ func (c *Client) CmdHostMonitor() *cobra.Command {
 
 	return cmd
 }
+
+func (c *Client) CmdHostPrune() *cobra.Command {
+	return &cobra.Command{
+		Use:     "prune",
+		Short:   "Remove images and OVA imports which are not used by any event",
+		Example: `hkn host prune`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			resp, err := c.rpcClient.PruneImages(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			for _, i := range resp.Images {
+				fmt.Printf("Removed image %s\n", i)
+			}
+			for _, o := range resp.Ovas {
+				fmt.Printf("Removed OVA import %s\n", o)
+			}
+		},
+	}
+}
+
+func (c *Client) CmdHostSubnets() *cobra.Command {
+	return &cobra.Command{
+		Use:     "subnets",
+		Short:   "List the subnets allocated to labs, segments, VPN interfaces and services",
+		Example: `hkn host subnets`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListSubnets(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"SUBNET", "KIND", "OWNER", "CREATED AT", "CONFLICT"},
+				fields: []string{"Subnet", "Kind", "Owner", "CreatedAt", "Conflict"},
+			}
+
+			var elements []formatElement
+			for _, s := range r.Subnets {
+				elements = append(elements, struct {
+					Subnet    string
+					Kind      string
+					Owner     string
+					CreatedAt string
+					Conflict  string
+				}{
+					Subnet:    s.Subnet,
+					Kind:      s.Kind,
+					Owner:     s.Owner,
+					CreatedAt: s.CreatedAt,
+					Conflict:  s.Conflict,
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
+
+	"github.com/aau-network-security/haaukins/client/loadtest"
+	"github.com/spf13/cobra"
+)
+
+func (c *Client) CmdLoadTest() *cobra.Command {
+	var conf loadtest.Config
+
+	cmd := &cobra.Command{
+		Use:     "loadtest [event url]",
+		Short:   "Simulate teams participating in an event",
+		Long:    "Simulate teams which sign up, log in, list challenges, follow the scoreboard, submit flags and log in to guacamole",
+		Example: `hkn loadtest https://test.haaukins.com -n 50 -c 10 -r 1m -f 3 --guacamole`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			conf.Target = args[0]
+
+			// interrupting the load test still prints the report
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sig := make(chan os.Signal, 1)
+			signal.Notify(sig, os.Interrupt)
+			defer signal.Stop(sig)
+			go func() {
+				select {
+				case <-sig:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+
+			report, err := loadtest.Run(ctx, conf)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"STEP", "COUNT", "ERRORS", "ERROR RATE", "MEAN", "P50", "P90", "P99", "MAX"},
+				fields: []string{"Step", "Count", "Errors", "ErrorRate", "Mean", "P50", "P90", "P99", "Max"},
+			}
+
+			type element struct {
+				Step      string
+				Count     int
+				Errors    int
+				ErrorRate string
+				Mean      time.Duration
+				P50       time.Duration
+				P90       time.Duration
+				P99       time.Duration
+				Max       time.Duration
+			}
+
+			var elements []formatElement
+			for _, s := range append(report.Steps, report.LabAssignment) {
+				elements = append(elements, element{
+					Step:      s.Step,
+					Count:     s.Count,
+					Errors:    s.Errors,
+					ErrorRate: fmt.Sprintf("%.2f%%", 100*s.ErrorRate()),
+					Mean:      s.Mean.Round(time.Millisecond),
+					P50:       s.P50.Round(time.Millisecond),
+					P90:       s.P90.Round(time.Millisecond),
+					P99:       s.P99.Round(time.Millisecond),
+					Max:       s.Max.Round(time.Millisecond),
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+
+			fmt.Printf("\nTeams completed: %d/%d in %s, error rate: %.2f%%\n",
+				report.Completed, report.Teams, report.Duration.Round(time.Millisecond), 100*report.ErrorRate())
+
+			if len(report.Errors) > 0 {
+				var msgs []string
+				for msg := range report.Errors {
+					msgs = append(msgs, msg)
+				}
+				sort.Strings(msgs)
+				fmt.Println("\nErrors:")
+				for _, msg := range msgs {
+					fmt.Printf("  %dx %s\n", report.Errors[msg], msg)
+				}
+			}
+		},
+	}
+
+	cmd.Flags().IntVarP(&conf.Teams, "teams", "n", 10, "number of simulated teams")
+	cmd.Flags().IntVarP(&conf.Concurrency, "concurrency", "c", 0, "maximum number of teams running at the same time (default: number of teams)")
+	cmd.Flags().DurationVarP(&conf.RampUp, "ramp-up", "r", 0, "duration over which teams are started")
+	cmd.Flags().IntVarP(&conf.FlagAttempts, "flags", "f", 3, "number of flags submitted by each team")
+	cmd.Flags().BoolVar(&conf.Guacamole, "guacamole", false, "log in to guacamole once the lab is assigned")
+	cmd.Flags().StringVarP(&conf.SecretKey, "secret", "k", "", "secret key of a protected event")
+	cmd.Flags().DurationVar(&conf.Timeout, "timeout", 30*time.Second, "timeout of a single request")
+	cmd.Flags().DurationVar(&conf.LabTimeout, "lab-timeout", 10*time.Minute, "maximum time a team waits for a lab")
+	cmd.Flags().BoolVar(&conf.Insecure, "insecure", false, "skip verification of the certificate of the event")
+
+	return cmd
+}
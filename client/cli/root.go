@@ -70,6 +70,8 @@ func Execute() {
 		c.CmdFrontends(),
 		c.CmdHost(),
 		c.CmdTeam(),
+		c.CmdLoadTest(),
+		c.CmdTemplate(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
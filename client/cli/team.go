@@ -23,8 +23,11 @@ func (c *Client) CmdTeam() *cobra.Command {
 
 	cmd.AddCommand(
 		c.CmdTeamInfo(),
+		c.CmdTeamHealth(),
 		c.CmdTeamSuspend(),
 		c.CmdTeamResume(),
+		c.CmdTeamCheckpoint(),
+		c.CmdTeamRestore(),
 		c.CmdSolveChallenge(),
 		c.CmdTeamFlags(),
 		c.CmdUpdateTeamPassword(),
@@ -109,12 +112,119 @@ func (c *Client) CmdTeamInfo() *cobra.Command {
 				return
 			}
 			fmt.Printf(table)
+
+			if resp.ActivitySource != "" {
+				fmt.Printf("\nLast activity: %s (%s)\n", resp.LastActivity, resp.ActivitySource)
+			} else {
+				fmt.Printf("\nLast activity: %s\n", resp.LastActivity)
+			}
+			if resp.IdleDecision != "" {
+				fmt.Printf("Idle policy: %s\n", resp.IdleDecision)
+			}
+			if u := resp.Usage; u != nil {
+				fmt.Printf("Usage (%s): cpu %.1f%% (peak %.1f%%), memory %d MB (peak %d MB), %d processes\n",
+					u.SampledAt, u.CpuPercent, u.PeakCPUPercent, u.MemoryBytes/1024/1024, u.PeakMemoryBytes/1024/1024, u.Pids)
+				fmt.Printf("Network rx/tx: %d/%d KB, block read/write: %d/%d KB\n",
+					u.NetRxBytes/1024, u.NetTxBytes/1024, u.BlockRead/1024, u.BlockWrite/1024)
+			}
 		},
 	}
 
 	return cmd
 }
 
+func (c *Client) CmdTeamHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "health [team id] [event tag]",
+		Short:   "Get the health of a teams lab",
+		Example: "hkn team health azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			teamId := args[0]
+			eventTag := args[1]
+			req := &pb.GetLabHealthRequest{
+				TeamId:   teamId,
+				EventTag: eventTag,
+			}
+			resp, err := c.rpcClient.GetLabHealth(ctx, req)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("Lab %s is %s (checked at %s)\n", resp.LabTag, healthString(resp.Healthy), resp.CheckedAt)
+			fmt.Printf("DNS: %s  DHCP: %s\n", healthString(resp.Dns), healthString(resp.Dhcp))
+			if resp.Repairs > 0 {
+				fmt.Printf("Repaired %d time(s), last repair at %s\n", resp.Repairs, resp.LastRepairAt)
+			}
+			fmt.Println()
+
+			f := formatter{
+				header: []string{"NAME", "TYPE", "ID", "STATE", "HEALTH"},
+				fields: []string{"Name", "Type", "Id", "State", "Health"},
+			}
+
+			type element struct {
+				Name   string
+				Type   string
+				Id     string
+				State  string
+				Health string
+			}
+
+			var elements []formatElement
+			for _, fr := range resp.Frontends {
+				rdp := "rdp not listening"
+				if fr.RdpAlive {
+					rdp = "rdp listening"
+				}
+				elements = append(elements, element{
+					Name:   fmt.Sprintf("frontend:%d", fr.Port),
+					Type:   "vbox",
+					State:  stateString(fr.State),
+					Health: rdp,
+				})
+			}
+
+			for _, e := range resp.Exercises {
+				health := healthString(e.Healthy)
+				if e.Disabled {
+					health = "disabled"
+				}
+				for _, i := range e.Instances {
+					elements = append(elements, element{
+						Name:   e.Tag,
+						Type:   i.Type,
+						Id:     i.Id,
+						State:  stateString(i.State),
+						Health: health,
+					})
+				}
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateEListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+
+	return cmd
+}
+
+func healthString(healthy bool) string {
+	a := aurora.NewAurora(true)
+	if healthy {
+		return a.Green("healthy").String()
+	}
+	return a.Red("unhealthy").String()
+}
+
 func (c *Client) CmdTeamSuspend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "suspend [team id] [event tag]",
@@ -171,6 +281,56 @@ func (c *Client) CmdTeamResume() *cobra.Command {
 	return cmd
 }
 
+func (c *Client) CmdTeamCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checkpoint [team id] [event tag]",
+		Short:   "Save the state of the exercises of a teams lab",
+		Example: "hkn team checkpoint azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			req := &pb.TeamCheckpointRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+			}
+			_, err := c.rpcClient.CheckpointTeamLab(ctx, req)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+
+	return cmd
+}
+
+func (c *Client) CmdTeamRestore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restore [team id] [event tag]",
+		Short:   "Restore the exercises of a teams lab from its latest checkpoint",
+		Example: "hkn team restore azbu29c1 test-event",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			req := &pb.TeamCheckpointRequest{
+				TeamId:   args[0],
+				EventTag: args[1],
+			}
+			_, err := c.rpcClient.RestoreTeamLab(ctx, req)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+		},
+	}
+
+	return cmd
+}
+
 func (c *Client) CmdSolveChallenge() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "solve [event tag] [team id] [challenge-tag]",
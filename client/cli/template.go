@@ -0,0 +1,361 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	UnableCreateTListErr = errors.New("Failed to create template list")
+)
+
+func (c *Client) CmdTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Actions to perform on event templates",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		c.CmdTemplateCreate(),
+		c.CmdTemplateUpdate(),
+		c.CmdTemplateDelete(),
+		c.CmdTemplateList(),
+		c.CmdTemplateShow(),
+		c.CmdTemplateExport(),
+		c.CmdTemplateImport())
+
+	return cmd
+}
+
+// templateFlags are the fields of a template which can be set from
+// the command line, only flags which are changed are applied
+type templateFlags struct {
+	description       string
+	eventName         string
+	frontends         []string
+	exercises         []string
+	disabledExercises []string
+	available         int
+	capacity          int
+	vpn               int32
+	secretKey         string
+	idleThreshold     time.Duration
+	idleInterval      time.Duration
+	idleAction        string
+}
+
+func (tf *templateFlags) bind(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&tf.description, "description", "D", "", "description of the template")
+	cmd.Flags().StringVarP(&tf.eventName, "name", "n", "", "the event name")
+	cmd.Flags().StringSliceVarP(&tf.frontends, "frontends", "f", []string{}, "list of frontends to have for each lab")
+	cmd.Flags().StringSliceVarP(&tf.exercises, "exercises", "e", []string{}, "list of exercises to have for each lab")
+	cmd.Flags().StringSliceVarP(&tf.disabledExercises, "disabled-exercises", "x", []string{}, "list of disabled exercises, will be spin off by user in the event manually")
+	cmd.Flags().IntVarP(&tf.available, "available", "a", 0, "amount of labs to make available initially for the event")
+	cmd.Flags().IntVarP(&tf.capacity, "capacity", "c", 0, "maximum amount of labs")
+	cmd.Flags().Int32Var(&tf.vpn, "vpn", 0, "vpn mode of the event, 0: no vpn, 1: only vpn, 2: browser and vpn")
+	cmd.Flags().StringVarP(&tf.secretKey, "secretkey", "k", "", "secret key for protecting events")
+	cmd.Flags().DurationVar(&tf.idleThreshold, "idle-threshold", 0, "inactivity after which labs are suspended or stopped")
+	cmd.Flags().DurationVar(&tf.idleInterval, "idle-interval", 0, "interval of checking idle labs")
+	cmd.Flags().StringVar(&tf.idleAction, "idle-action", "", "action on idle labs, suspend or stop")
+}
+
+func (tf *templateFlags) apply(cmd *cobra.Command, t *pb.EventTemplate) {
+	changed := cmd.Flags().Changed
+	if changed("description") {
+		t.Description = tf.description
+	}
+	if changed("name") {
+		t.EventName = tf.eventName
+	}
+	if changed("frontends") {
+		t.Frontends = tf.frontends
+	}
+	if changed("exercises") {
+		t.Exercises = tf.exercises
+	}
+	if changed("disabled-exercises") {
+		t.DisableExercises = tf.disabledExercises
+	}
+	if changed("available") {
+		t.Available = int32(tf.available)
+	}
+	if changed("capacity") {
+		t.Capacity = int32(tf.capacity)
+	}
+	if changed("vpn") {
+		t.OnlyVPN = tf.vpn
+	}
+	if changed("secretkey") {
+		t.SecretEvent = tf.secretKey
+	}
+	if t.IdlePolicy == nil {
+		t.IdlePolicy = &pb.IdlePolicy{}
+	}
+	if changed("idle-threshold") {
+		t.IdlePolicy.ThresholdMinutes = int32(tf.idleThreshold / time.Minute)
+	}
+	if changed("idle-interval") {
+		t.IdlePolicy.CheckIntervalMinutes = int32(tf.idleInterval / time.Minute)
+	}
+	if changed("idle-action") {
+		t.IdlePolicy.Action = tf.idleAction
+	}
+}
+
+func (c *Client) CmdTemplateCreate() *cobra.Command {
+	var tf templateFlags
+
+	cmd := &cobra.Command{
+		Use:     "create [template name]",
+		Short:   "Create event template",
+		Example: `hkn template create course-intro -n "Introduction Course" -a 5 -c 30 -e scan,sql,hb -f kali --vpn 2`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			t := &pb.EventTemplate{Name: args[0]}
+			tf.apply(cmd, t)
+			r, err := c.rpcClient.CreateTemplate(ctx, t)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("%s: %s\n", r.Message, r.Name)
+		},
+	}
+
+	tf.bind(cmd)
+
+	return cmd
+}
+
+func (c *Client) CmdTemplateUpdate() *cobra.Command {
+	var tf templateFlags
+
+	cmd := &cobra.Command{
+		Use:     "update [template name]",
+		Short:   "Update fields of an event template",
+		Example: `hkn template update course-intro -c 40 -e scan,sql`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			t, err := c.rpcClient.GetTemplate(ctx, &pb.TemplateRequest{Name: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			tf.apply(cmd, t)
+			r, err := c.rpcClient.UpdateTemplate(ctx, t)
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("%s: %s\n", r.Message, r.Name)
+		},
+	}
+
+	tf.bind(cmd)
+
+	return cmd
+}
+
+func (c *Client) CmdTemplateDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete [template name]",
+		Short:   "Delete event template",
+		Example: `hkn template delete course-intro`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.DeleteTemplate(ctx, &pb.TemplateRequest{Name: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("%s: %s\n", r.Message, r.Name)
+		},
+	}
+}
+
+func (c *Client) CmdTemplateList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List event templates",
+		Example: `hkn template list`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ListTemplates(ctx, &pb.Empty{})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			f := formatter{
+				header: []string{"TEMPLATE", "EVENT NAME", "FRONTENDS", "# EXERCISES", "CAPACITY", "VPN", "CREATED BY", "UPDATED AT"},
+				fields: []string{"Name", "EventName", "Frontends", "Exercises", "Capacity", "VPN", "CreatedBy", "UpdatedAt"},
+			}
+
+			var elements []formatElement
+			for _, t := range r.Templates {
+				elements = append(elements, struct {
+					Name      string
+					EventName string
+					Frontends string
+					Exercises int
+					Capacity  int32
+					VPN       int32
+					CreatedBy string
+					UpdatedAt string
+				}{
+					Name:      t.Name,
+					EventName: t.EventName,
+					Frontends: strings.Join(t.Frontends, ","),
+					Exercises: len(t.Exercises),
+					Capacity:  t.Capacity,
+					VPN:       t.OnlyVPN,
+					CreatedBy: t.CreatedBy,
+					UpdatedAt: t.UpdatedAt,
+				})
+			}
+
+			table, err := f.AsTable(elements)
+			if err != nil {
+				PrintError(UnableCreateTListErr)
+				return
+			}
+			fmt.Printf(table)
+		},
+	}
+}
+
+func (c *Client) CmdTemplateShow() *cobra.Command {
+	return &cobra.Command{
+		Use:     "show [template name]",
+		Short:   "Show fields of an event template",
+		Example: `hkn template show course-intro`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			t, err := c.rpcClient.GetTemplate(ctx, &pb.TemplateRequest{Name: args[0]})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			fmt.Printf("Name:               %s\n", t.Name)
+			fmt.Printf("Description:        %s\n", t.Description)
+			fmt.Printf("Event name:         %s\n", t.EventName)
+			fmt.Printf("Frontends:          %s\n", strings.Join(t.Frontends, ", "))
+			fmt.Printf("Exercises:          %s\n", strings.Join(t.Exercises, ", "))
+			fmt.Printf("Disabled exercises: %s\n", strings.Join(t.DisableExercises, ", "))
+			fmt.Printf("Available:          %d\n", t.Available)
+			fmt.Printf("Capacity:           %d\n", t.Capacity)
+			fmt.Printf("VPN:                %d\n", t.OnlyVPN)
+			if p := t.IdlePolicy; p != nil {
+				fmt.Printf("Idle threshold:     %s\n", time.Duration(p.ThresholdMinutes)*time.Minute)
+				fmt.Printf("Idle interval:      %s\n", time.Duration(p.CheckIntervalMinutes)*time.Minute)
+				fmt.Printf("Idle action:        %s\n", p.Action)
+			}
+			fmt.Printf("Created by:         %s at %s\n", t.CreatedBy, t.CreatedAt)
+			fmt.Printf("Updated at:         %s\n", t.UpdatedAt)
+		},
+	}
+}
+
+func (c *Client) CmdTemplateExport() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:     "export [template name...]",
+		Short:   "Export event templates as yaml, all templates are exported when no name is given",
+		Example: `hkn template export course-intro -o templates/course-intro.yml`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			r, err := c.rpcClient.ExportTemplates(ctx, &pb.ExportTemplatesRequest{Names: args})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if output == "" {
+				fmt.Print(r.Yaml)
+				return
+			}
+			if err := ioutil.WriteFile(output, []byte(r.Yaml), 0644); err != nil {
+				PrintError(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the templates to (default stdout)")
+
+	return cmd
+}
+
+func (c *Client) CmdTemplateImport() *cobra.Command {
+	var overwrite bool
+
+	cmd := &cobra.Command{
+		Use:     "import [file]",
+		Short:   "Import event templates from a yaml file",
+		Example: `hkn template import templates/course-intro.yml --overwrite`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			r, err := c.rpcClient.ImportTemplates(ctx, &pb.ImportTemplatesRequest{
+				Yaml:      string(content),
+				Overwrite: overwrite,
+			})
+			if err != nil {
+				PrintError(err)
+				return
+			}
+
+			if len(r.Created) > 0 {
+				fmt.Printf("Created: %s\n", strings.Join(r.Created, ", "))
+			}
+			if len(r.Updated) > 0 {
+				fmt.Printf("Updated: %s\n", strings.Join(r.Updated, ", "))
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "overwrite existing templates")
+
+	return cmd
+}
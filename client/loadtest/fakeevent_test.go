@@ -0,0 +1,161 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	pbc "github.com/aau-network-security/haaukins/store/proto"
+	"github.com/aau-network-security/haaukins/svcs/amigo"
+	mockserver "github.com/aau-network-security/haaukins/testing"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultCapacity = 1000
+	// queueUpdatePeriod is short to keep waiting
+	// teams from slowing down the tests
+	queueUpdatePeriod = 50 * time.Millisecond
+)
+
+type fakeEventConfig struct {
+	Capacity   int
+	SecretKey  string
+	Challenges []store.ChildrenChalConfig
+	// LabDelay is the time it takes to assign a lab to a team,
+	// teams are put in the waiting queue when it is set
+	LabDelay time.Duration
+}
+
+// fakeEvent runs amigo of an event in memory, labs are
+// assigned without creating any virtual instances
+type fakeEvent struct {
+	URL string
+
+	conf       fakeEventConfig
+	srv        *httptest.Server
+	conn       *grpc.ClientConn
+	closeStore func() error
+	dir        string
+
+	m          sync.Mutex
+	pending    map[string]struct{}
+	guacLogins int
+}
+
+func newFakeEvent(conf fakeEventConfig) (*fakeEvent, error) {
+	if conf.Capacity <= 0 {
+		conf.Capacity = defaultCapacity
+	}
+
+	dir, err := ioutil.TempDir("", "fakeevent")
+	if err != nil {
+		return nil, err
+	}
+
+	dialer, closeStore := mockserver.Create()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		closeStore()
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	allChals := map[string][]string{}
+	for _, c := range conf.Challenges {
+		allChals[string(c.Tag)] = []string{string(c.Tag)}
+	}
+
+	ts, err := store.NewEventStore(store.EventConfig{
+		Name:          "Fake Event",
+		Tag:           "fake",
+		Available:     conf.Capacity,
+		Capacity:      conf.Capacity,
+		SecretKey:     conf.SecretKey,
+		AllChallenges: allChals,
+	}, dir, pbc.NewStoreClient(conn))
+	if err != nil {
+		conn.Close()
+		closeStore()
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ev := &fakeEvent{
+		conf:       conf,
+		conn:       conn,
+		closeStore: closeStore,
+		dir:        dir,
+		pending:    map[string]struct{}{},
+	}
+
+	am := amigo.NewAmigo(ts, conf.Challenges, "", nil, amigo.WithQueueUpdatePeriod(queueUpdatePeriod))
+	hooks := amigo.Hooks{
+		AssignLab:         ev.assignLab,
+		ResetExercise:     func(*store.Team, string) error { return nil },
+		StartStopExercise: func(*store.Team, string, bool) error { return nil },
+		ResetFrontend:     func(*store.Team) error { return nil },
+		ResumeTeamLab:     func(*store.Team) error { return nil },
+		QueueStatus:       ev.queueStatus,
+	}
+	ev.srv = httptest.NewServer(am.Handler(hooks, http.HandlerFunc(ev.handleGuacamole)))
+	ev.URL = ev.srv.URL
+
+	return ev, nil
+}
+
+// assignLab assigns a lab right away or puts the team
+// in the queue until the lab delay has passed
+func (ev *fakeEvent) assignLab(t *store.Team) error {
+	if ev.conf.LabDelay <= 0 {
+		t.CorrectedAssignedLab()
+		return nil
+	}
+
+	ev.m.Lock()
+	defer ev.m.Unlock()
+	if _, ok := ev.pending[t.ID()]; !ok {
+		ev.pending[t.ID()] = struct{}{}
+		time.AfterFunc(ev.conf.LabDelay, t.CorrectedAssignedLab)
+	}
+
+	return amigo.ErrTeamQueued
+}
+
+func (ev *fakeEvent) queueStatus(*store.Team) (int, time.Duration) {
+	return 1, ev.conf.LabDelay
+}
+
+func (ev *fakeEvent) handleGuacamole(w http.ResponseWriter, r *http.Request) {
+	ev.m.Lock()
+	ev.guacLogins += 1
+	ev.m.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GuacLogins returns the number of requests which reached guacamole
+func (ev *fakeEvent) GuacLogins() int {
+	ev.m.Lock()
+	defer ev.m.Unlock()
+	return ev.guacLogins
+}
+
+func (ev *fakeEvent) Close() {
+	ev.srv.Close()
+	ev.conn.Close()
+	ev.closeStore()
+	os.RemoveAll(ev.dir)
+}
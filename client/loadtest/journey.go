@@ -0,0 +1,420 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	StepSignup     = "signup"
+	StepLogin      = "login"
+	StepChallenges = "challenges"
+	StepScoreboard = "scoreboard"
+	StepFlag       = "flag"
+	StepGuacamole  = "guacamole"
+	StepLab        = "lab assignment"
+
+	// amigo replies with this error when the session is not valid
+	unauthorizedReply = "requires authentication"
+)
+
+var (
+	ErrNoSession       = errors.New("no session cookie received")
+	ErrLabNotAssigned  = errors.New("lab is not assigned to the team")
+	ErrUnauthenticated = errors.New("team is not authenticated")
+
+	// steps in the order they are performed by a team
+	steps = []string{
+		StepSignup,
+		StepLogin,
+		StepChallenges,
+		StepScoreboard,
+		StepFlag,
+		StepGuacamole,
+	}
+)
+
+// journey is a single simulated team
+type journey struct {
+	conf     Config
+	client   *http.Client
+	dialer   *websocket.Dialer
+	rec      *recorder
+	name     string
+	password string
+}
+
+func newJourney(conf Config, transport *http.Transport, rec *recorder) (*journey, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &journey{
+		conf: conf,
+		client: &http.Client{
+			Transport: transport,
+			Jar:       jar,
+			Timeout:   conf.Timeout,
+			// redirects tell the outcome of signup and login
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		dialer: &websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			TLSClientConfig:  transport.TLSClientConfig,
+			HandshakeTimeout: conf.Timeout,
+			Jar:              jar,
+		},
+		rec:      rec,
+		name:     randomString(teamNameLength),
+		password: randomString(passwordLength),
+	}, nil
+}
+
+func (j *journey) run(ctx context.Context) {
+	signupAt := time.Now()
+	var location string
+	err := j.step(StepSignup, func() (err error) {
+		location, err = j.signup(ctx)
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	// teams which are put in the queue continue
+	// browsing the event while waiting for their lab
+	lab := make(chan error, 1)
+	go func() {
+		lab <- j.waitForLab(ctx, signupAt, location)
+	}()
+
+	failed := j.step(StepLogin, func() error { return j.login(ctx) }) != nil
+
+	var tags []string
+	err = j.step(StepChallenges, func() (err error) {
+		tags, err = j.challenges(ctx)
+		return err
+	})
+	failed = failed || err != nil
+
+	failed = j.step(StepScoreboard, func() error { return j.scoreboard(ctx) }) != nil || failed
+
+	for i := 0; i < j.conf.FlagAttempts && len(tags) > 0; i++ {
+		tag := tags[i%len(tags)]
+		failed = j.step(StepFlag, func() error { return j.submitFlag(ctx, tag) }) != nil || failed
+	}
+
+	if err := <-lab; err != nil {
+		return
+	}
+
+	if j.conf.Guacamole {
+		failed = j.step(StepGuacamole, func() error { return j.guacLogin(ctx) }) != nil || failed
+	}
+
+	if !failed {
+		j.rec.complete()
+	}
+}
+
+// step measures the duration of a single step and records its outcome
+func (j *journey) step(name string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	j.rec.add(name, time.Since(start), err)
+	return err
+}
+
+func (j *journey) signup(ctx context.Context) (string, error) {
+	form := url.Values{}
+	form.Add("team-name", j.name)
+	form.Add("password", j.password)
+	form.Add("password-repeat", j.password)
+	form.Add("secret-event-key", j.conf.SecretKey)
+
+	location, err := j.postForm(ctx, "/signup", form)
+	if err != nil {
+		return "", err
+	}
+	if !j.hasSession() {
+		return "", ErrNoSession
+	}
+
+	return location, nil
+}
+
+func (j *journey) login(ctx context.Context) error {
+	form := url.Values{}
+	form.Add("username", j.name)
+	form.Add("password", j.password)
+
+	if _, err := j.postForm(ctx, "/login", form); err != nil {
+		return err
+	}
+	if !j.hasSession() {
+		return ErrNoSession
+	}
+
+	return nil
+}
+
+// postForm posts the form and returns the location which the
+// team is redirected to, amigo re-renders the page with status
+// 200 when the form is rejected
+func (j *journey) postForm(ctx context.Context, path string, form url.Values) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.conf.Target+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSeeOther {
+		return "", fmt.Errorf("%s rejected with status %d", path, resp.StatusCode)
+	}
+
+	return resp.Header.Get("Location"), nil
+}
+
+func (j *journey) hasSession() bool {
+	u, _ := url.Parse(j.conf.Target)
+	for _, c := range j.client.Jar.Cookies(u) {
+		if c.Name == "session" && c.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForLab records the time from signup until a lab is assigned,
+// teams in the waiting queue follow the waiting status of amigo
+// which is only as precise as the update period of the status
+func (j *journey) waitForLab(ctx context.Context, signupAt time.Time, location string) error {
+	type queueStatus struct {
+		Position      int  `json:"position"`
+		IsLabAssigned bool `json:"isLabAssigned"`
+	}
+
+	if location != "/waiting" {
+		j.rec.add(StepLab, time.Since(signupAt), nil)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, j.conf.LabTimeout)
+	defer cancel()
+
+	err := j.readWS(ctx, "/waiting/status", func(msg []byte) (bool, error) {
+		var status queueStatus
+		if err := json.Unmarshal(msg, &status); err != nil {
+			return false, err
+		}
+		return status.IsLabAssigned, nil
+	})
+	if err == nil {
+		j.rec.add(StepLab, time.Since(signupAt), nil)
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		err = ErrLabNotAssigned
+	}
+	j.rec.add(StepLab, 0, err)
+	return err
+}
+
+// challenges reads the challenge listing which is pushed
+// by amigo and returns tags of the challenges
+func (j *journey) challenges(ctx context.Context) ([]string, error) {
+	type challenge struct {
+		Info struct {
+			Tag string `json:"tag"`
+		} `json:"challenge"`
+	}
+
+	var tags []string
+	err := j.readMessage(ctx, "/challengesFrontend", "challenges", func(values json.RawMessage) error {
+		var chals []challenge
+		if err := json.Unmarshal(values, &chals); err != nil {
+			return err
+		}
+		for _, c := range chals {
+			tags = append(tags, c.Info.Tag)
+		}
+		return nil
+	})
+
+	return tags, err
+}
+
+func (j *journey) scoreboard(ctx context.Context) error {
+	return j.readMessage(ctx, "/scores", "scoreboard", func(json.RawMessage) error {
+		return nil
+	})
+}
+
+// submitFlag submits a random flag for the challenge, replies for
+// wrong flags are expected while failing requests are errors
+func (j *journey) submitFlag(ctx context.Context, tag string) error {
+	type reply struct {
+		Err    string `json:"error,omitempty"`
+		Status string `json:"status"`
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"tag":  tag,
+		"flag": randomString(passwordLength),
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.conf.Target+"/flags/verify", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("flag verification failed with status %d", resp.StatusCode)
+	}
+
+	var r reply
+	if err := json.Unmarshal(content, &r); err != nil {
+		return fmt.Errorf("unable to read flag reply: %v", err)
+	}
+	if r.Err == unauthorizedReply {
+		return ErrUnauthenticated
+	}
+
+	return nil
+}
+
+func (j *journey) guacLogin(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.conf.Target+"/guaclogin", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("guacamole login failed with status %d", resp.StatusCode)
+	}
+	switch resp.Header.Get("Location") {
+	case "/login":
+		return ErrUnauthenticated
+	case "/waiting":
+		return ErrLabNotAssigned
+	}
+
+	return nil
+}
+
+// readMessage waits for a message with the given name on the
+// websocket of amigo, amigo might batch multiple messages
+// into a single frame separated by newlines
+func (j *journey) readMessage(ctx context.Context, path, name string, fn func(values json.RawMessage) error) error {
+	type message struct {
+		Message string          `json:"msg"`
+		Values  json.RawMessage `json:"values"`
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, j.conf.Timeout)
+	defer cancel()
+
+	return j.readWS(ctx, path, func(frame []byte) (bool, error) {
+		for _, raw := range bytes.Split(frame, []byte{'\n'}) {
+			var msg message
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				return false, err
+			}
+			if msg.Message == name {
+				return true, fn(msg.Values)
+			}
+		}
+		return false, nil
+	})
+}
+
+// readWS reads frames from the websocket until fn is done
+// with them or the context is done
+func (j *journey) readWS(ctx context.Context, path string, fn func(frame []byte) (bool, error)) error {
+	u, _ := url.Parse(j.conf.Target + path)
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	conn, resp, err := j.dialer.DialContext(ctx, u.String(), nil)
+	if err != nil {
+		if resp != nil {
+			return fmt.Errorf("unable to connect to %s: status %d", path, resp.StatusCode)
+		}
+		return err
+	}
+	defer conn.Close()
+
+	// unblock reading when the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		_, frame, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		ok, err := fn(frame)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+// Package loadtest simulates teams participating in an event, each
+// team signs up, logs in, lists challenges, subscribes to the
+// scoreboard, submits flags and logs in to guacamole.
+package loadtest
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultLabTimeout = 10 * time.Minute
+	teamNameLength    = 12
+	passwordLength    = 10
+	charSet           = "abcdefghijklmnopqrstuvwxyz" +
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+var (
+	ErrMissingTarget = errors.New("target url of the event is required")
+	ErrInvalidTeams  = errors.New("number of teams should be at least 1")
+)
+
+// Config describes the load which is applied on an event
+type Config struct {
+	// Target is the url of the event, e.g. https://test.haaukins.com
+	Target string
+	// Teams is the total number of simulated teams
+	Teams int
+	// Concurrency is the maximum number of teams running
+	// their journeys at the same time, defaults to Teams
+	Concurrency int
+	// RampUp is the duration over which the teams are started
+	RampUp time.Duration
+	// FlagAttempts is the number of flags submitted by each team
+	FlagAttempts int
+	// Guacamole enables logging in to guacamole after the lab is assigned
+	Guacamole bool
+	// SecretKey is used for signing up to protected events
+	SecretKey string
+	// Timeout is the timeout of a single request
+	Timeout time.Duration
+	// LabTimeout is the maximum time a team waits for a lab
+	LabTimeout time.Duration
+	// Insecure skips verification of the certificate of the event
+	Insecure bool
+}
+
+func (conf Config) withDefaults() Config {
+	if conf.Concurrency <= 0 || conf.Concurrency > conf.Teams {
+		conf.Concurrency = conf.Teams
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultTimeout
+	}
+	if conf.LabTimeout <= 0 {
+		conf.LabTimeout = defaultLabTimeout
+	}
+	conf.Target = strings.TrimSuffix(conf.Target, "/")
+	return conf
+}
+
+func (conf Config) Validate() error {
+	if conf.Target == "" {
+		return ErrMissingTarget
+	}
+	u, err := url.Parse(conf.Target)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme of target url: %q", u.Scheme)
+	}
+	if conf.Teams < 1 {
+		return ErrInvalidTeams
+	}
+	return nil
+}
+
+// Run starts the journeys of all teams and waits until they are
+// completed or the context is cancelled, teams are started evenly
+// over the ramp-up duration
+func Run(ctx context.Context, conf Config) (*Report, error) {
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	conf = conf.withDefaults()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: conf.Insecure}
+	transport.MaxIdleConnsPerHost = conf.Concurrency
+
+	var interval time.Duration
+	if conf.Teams > 1 {
+		interval = conf.RampUp / time.Duration(conf.Teams-1)
+	}
+
+	rec := newRecorder()
+	sem := make(chan struct{}, conf.Concurrency)
+	var wg sync.WaitGroup
+	started := time.Now()
+
+Teams:
+	for i := 0; i < conf.Teams; i++ {
+		if i > 0 && interval > 0 {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				break Teams
+			}
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break Teams
+		}
+
+		j, err := newJourney(conf, transport, rec)
+		if err != nil {
+			<-sem
+			wg.Wait()
+			return nil, err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			j.run(ctx)
+		}()
+	}
+	wg.Wait()
+
+	return rec.report(conf.Teams, time.Since(started)), nil
+}
+
+func randomString(length int) string {
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = charSet[rand.Intn(len(charSet))]
+	}
+	return string(b)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestRun(t *testing.T) {
+	chals := []store.ChildrenChalConfig{
+		{Tag: "ftp", Name: "FTP", Points: 10, Category: "Network"},
+		{Tag: "sql", Name: "SQL Injection", Points: 20, Category: "Web"},
+	}
+
+	tt := []struct {
+		name     string
+		labDelay time.Duration
+	}{
+		{name: "Lab assigned on signup"},
+		{name: "Team waiting for lab", labDelay: 200 * time.Millisecond},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ev, err := newFakeEvent(fakeEventConfig{
+				Challenges: chals,
+				LabDelay:   tc.labDelay,
+			})
+			if err != nil {
+				t.Fatalf("unable to create fake event: %v", err)
+			}
+			defer ev.Close()
+
+			teams := 10
+			report, err := Run(context.Background(), Config{
+				Target:       ev.URL,
+				Teams:        teams,
+				Concurrency:  5,
+				RampUp:       100 * time.Millisecond,
+				FlagAttempts: 2,
+				Guacamole:    true,
+				LabTimeout:   time.Minute,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if report.Completed != teams {
+				t.Fatalf("expected %d completed teams, but got %d: %v", teams, report.Completed, report.Errors)
+			}
+			if rate := report.ErrorRate(); rate != 0 {
+				t.Fatalf("expected no errors, but got error rate %.2f: %v", rate, report.Errors)
+			}
+			if len(report.Steps) != len(steps) {
+				t.Fatalf("expected %d steps in report, but got %d", len(steps), len(report.Steps))
+			}
+			for _, s := range report.Steps {
+				expected := teams
+				if s.Step == StepFlag {
+					expected = 2 * teams
+				}
+				if s.Count != expected {
+					t.Fatalf("expected %d attempts of step %s, but got %d", expected, s.Step, s.Count)
+				}
+			}
+			if report.LabAssignment.Count != teams {
+				t.Fatalf("expected %d lab assignments, but got %d", teams, report.LabAssignment.Count)
+			}
+			if report.LabAssignment.P50 < tc.labDelay {
+				t.Fatalf("expected lab assignment to take at least %s, but got %s", tc.labDelay, report.LabAssignment.P50)
+			}
+			if n := ev.GuacLogins(); n != teams {
+				t.Fatalf("expected %d guacamole logins, but got %d", teams, n)
+			}
+		})
+	}
+}
+
+func TestRunInvalidConfig(t *testing.T) {
+	tt := []struct {
+		name string
+		conf Config
+		err  error
+	}{
+		{name: "Missing target", conf: Config{Teams: 1}, err: ErrMissingTarget},
+		{name: "No teams", conf: Config{Target: "http://localhost"}, err: ErrInvalidTeams},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Run(context.Background(), tc.conf); err != tc.err {
+				t.Fatalf("expected error %v, but got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	tt := []struct {
+		p        int
+		expected time.Duration
+	}{
+		{p: 50, expected: 50 * time.Millisecond},
+		{p: 90, expected: 90 * time.Millisecond},
+		{p: 99, expected: 99 * time.Millisecond},
+		{p: 100, expected: 100 * time.Millisecond},
+	}
+
+	for _, tc := range tt {
+		if d := percentile(latencies, tc.p); d != tc.expected {
+			t.Fatalf("expected p%d to be %s, but got %s", tc.p, tc.expected, d)
+		}
+	}
+}
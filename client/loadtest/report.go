@@ -0,0 +1,156 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package loadtest
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Stats summarizes all attempts of a single step, latencies
+// are computed over the attempts which succeeded
+type Stats struct {
+	Step   string
+	Count  int
+	Errors int
+	Mean   time.Duration
+	P50    time.Duration
+	P90    time.Duration
+	P99    time.Duration
+	Max    time.Duration
+}
+
+func (s Stats) ErrorRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Count)
+}
+
+// Report is the result of a load test
+type Report struct {
+	Teams     int
+	Completed int
+	Duration  time.Duration
+	// Steps are in the order they are performed by teams
+	Steps []Stats
+	// LabAssignment is the time from signup until a lab is assigned
+	LabAssignment Stats
+	// Errors counts the occurrences of each error message
+	Errors map[string]int
+}
+
+// ErrorRate is the ratio of failed requests across all steps
+func (r *Report) ErrorRate() float64 {
+	var count, errs int
+	for _, s := range r.Steps {
+		count += s.Count
+		errs += s.Errors
+	}
+	if count == 0 {
+		return 0
+	}
+	return float64(errs) / float64(count)
+}
+
+type recorder struct {
+	m         sync.Mutex
+	latencies map[string][]time.Duration
+	attempts  map[string]int
+	errors    map[string]int
+	messages  map[string]int
+	completed int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		latencies: map[string][]time.Duration{},
+		attempts:  map[string]int{},
+		errors:    map[string]int{},
+		messages:  map[string]int{},
+	}
+}
+
+func (r *recorder) add(step string, d time.Duration, err error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.attempts[step] += 1
+	if err != nil {
+		r.errors[step] += 1
+		r.messages[step+": "+err.Error()] += 1
+		return
+	}
+	r.latencies[step] = append(r.latencies[step], d)
+}
+
+// complete registers a team which finished its journey without errors
+func (r *recorder) complete() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.completed += 1
+}
+
+func (r *recorder) report(teams int, d time.Duration) *Report {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	report := &Report{
+		Teams:         teams,
+		Completed:     r.completed,
+		Duration:      d,
+		LabAssignment: r.stats(StepLab),
+		Errors:        map[string]int{},
+	}
+	for _, step := range steps {
+		if r.attempts[step] == 0 {
+			continue
+		}
+		report.Steps = append(report.Steps, r.stats(step))
+	}
+	for msg, n := range r.messages {
+		report.Errors[msg] = n
+	}
+
+	return report
+}
+
+func (r *recorder) stats(step string) Stats {
+	s := Stats{
+		Step:   step,
+		Count:  r.attempts[step],
+		Errors: r.errors[step],
+	}
+
+	latencies := append([]time.Duration(nil), r.latencies[step]...)
+	if len(latencies) == 0 {
+		return s
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	var total time.Duration
+	for _, l := range latencies {
+		total += l
+	}
+	s.Mean = total / time.Duration(len(latencies))
+	s.P50 = percentile(latencies, 50)
+	s.P90 = percentile(latencies, 90)
+	s.P99 = percentile(latencies, 99)
+	s.Max = latencies[len(latencies)-1]
+
+	return s
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
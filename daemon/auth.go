@@ -115,6 +115,11 @@ func (a *auth) AuthenticateContext(ctx context.Context) (context.Context, error)
 		return ctx, InvalidTokenFormatErr
 	}
 
+	// tokens for other uses, such as replaying recordings, have an audience
+	if _, ok := claims["aud"]; ok {
+		return ctx, InvalidTokenFormatErr
+	}
+
 	username, ok := claims[USERNAME_KEY].(string)
 	if !ok {
 		return ctx, InvalidTokenFormatErr
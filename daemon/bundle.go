@@ -0,0 +1,151 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/bundle"
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+func bundlePrivateKey(conf *Config) (ed25519.PrivateKey, error) {
+	if conf.Bundles == nil || conf.Bundles.PrivateKey == "" {
+		return nil, &MissingConfigErr{"Bundle private key"}
+	}
+
+	return bundle.ParsePrivateKey(conf.Bundles.PrivateKey)
+}
+
+func bundlePublicKey(conf *Config) (ed25519.PublicKey, error) {
+	if conf.Bundles == nil || conf.Bundles.PublicKey == "" {
+		return nil, &MissingConfigErr{"Bundle public key"}
+	}
+
+	return bundle.ParsePublicKey(conf.Bundles.PublicKey)
+}
+
+func ovaPath(conf *Config, name string) string {
+	if !strings.HasSuffix(name, ".ova") {
+		name += ".ova"
+	}
+
+	return filepath.Join(conf.ConfFiles.OvaDir, name)
+}
+
+// ExportBundle writes a signed bundle of the exercises to path, including their
+// docker images and OVAs together with the OVAs of the frontends. The images
+// are pulled from the registries beforehand, such that the latest version
+// is bundled.
+func ExportBundle(conf *Config, path string, exercises, frontends []string) error {
+	key, err := bundlePrivateKey(conf)
+	if err != nil {
+		return err
+	}
+
+	ff, err := store.NewFrontendsFile(conf.ConfFiles.FrontendsFile)
+	if err != nil {
+		return fmt.Errorf("unable to read frontends file: %v", err)
+	}
+
+	exClient, err := store.NewExerciseClientConn(conf.exerciseServiceConfig())
+	if err != nil {
+		return fmt.Errorf("[exercise-service]: error on creating gRPC communication %v ", err)
+	}
+
+	ctx := context.Background()
+	resp, err := exClient.GetExerciseByTags(ctx, &eproto.GetExerciseByTagsRequest{Tag: exercises})
+	if err != nil {
+		return fmt.Errorf("[exercises-service] error %v", err)
+	}
+
+	categories, err := exClient.GetCategories(ctx, &eproto.Empty{})
+	if err != nil {
+		return fmt.Errorf("[exercises-service] error %v", err)
+	}
+
+	images := frontendImages(ff, frontends)
+	exImages, err := exerciseImages(resp.Exercises)
+	if err != nil {
+		return err
+	}
+	images.add(exImages)
+
+	if err := docker.PrepareImages(ctx, images.docker, imagePulls, func(p docker.ImageProgress) {
+		if p.Err != nil {
+			log.Warn().Str("image", p.Image).Msgf("Unable to prepare image: %v", p.Err)
+			return
+		}
+		log.Debug().Str("image", p.Image).Str("status", p.Status).Msg("Preparing image")
+	}); err != nil {
+		return err
+	}
+
+	src := bundle.Source{
+		Exercises: resp.Exercises,
+		Images:    images.docker,
+		Ovas:      map[string]string{},
+	}
+
+	used := map[string]struct{}{}
+	for _, e := range resp.Exercises {
+		used[e.Category] = struct{}{}
+	}
+	for _, c := range categories.Categories {
+		if _, ok := used[c.Tag]; ok {
+			src.Categories = append(src.Categories, c)
+		}
+	}
+
+	for _, ova := range images.ovas {
+		src.Ovas[ova] = ovaPath(conf, ova)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, err := bundle.Export(ctx, f, src, key)
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	log.Info().
+		Int("exercises", len(m.Exercises)).
+		Int("images", len(m.Images)).
+		Int("ovas", len(m.Ovas)).
+		Msgf("Exported bundle to %s", path)
+
+	return f.Close()
+}
+
+// ImportBundle verifies and imports the bundle at path into the
+// bundle directory, docker and the OVA directory of the daemon
+func ImportBundle(conf *Config, path string) error {
+	key, err := bundlePublicKey(conf)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = bundle.Import(context.Background(), f, conf.Bundles.Directory, conf.ConfFiles.OvaDir, key)
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"bufio"
+	"errors"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	captureChunkSize  = 64 << 10
+	captureGCInterval = time.Hour
+)
+
+var (
+	NoPrivilegeToCapture = errors.New("No privilege to download the traffic of an event created by others !")
+	NoCaptureErr         = errors.New("Traffic of the lab is not captured")
+)
+
+// captureWriter sends the data written to it as chunks of the stream
+type captureWriter struct {
+	stream pb.Daemon_DownloadCaptureServer
+}
+
+func (cw *captureWriter) Write(p []byte) (int, error) {
+	// the chunk is sent before the buffer is reused
+	if err := cw.stream.Send(&pb.CaptureChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// DownloadCapture streams the captured traffic of the lab of a team
+// as a gzipped tarball of pcap files
+func (d *daemon) DownloadCapture(req *pb.DownloadCaptureRequest, stream pb.Daemon_DownloadCaptureServer) error {
+	ctx := stream.Context()
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return err
+	}
+	if !user.SuperUser && ev.GetConfig().CreatedBy != user.Username {
+		return NoPrivilegeToCapture
+	}
+
+	l, ok := ev.GetLabByTeam(req.TeamId)
+	if !ok {
+		return UnknownTeamErr
+	}
+	log.Ctx(ctx).Info().
+		Str("event", req.EventTag).
+		Str("team", req.TeamId).
+		Msg("Downloading traffic capture")
+
+	w := bufio.NewWriterSize(&captureWriter{stream}, captureChunkSize)
+	// labs on workers stream the capture from the worker
+	if err := l.Capture(ctx, w); err != nil {
+		if err == lab.ErrNoCapture {
+			return NoCaptureErr
+		}
+		return err
+	}
+
+	return w.Flush()
+}
+
+// pruneCaptures removes the captures of labs which
+// have not been written to within the retention
+func (d *daemon) pruneCaptures() error {
+	conf := d.conf.Capture.WithDefaults()
+	removed, err := capture.Prune(conf.Directory, conf.Retention)
+	if err != nil {
+		log.Warn().Msgf("Unable to prune captures: %v", err)
+		return err
+	}
+
+	if len(removed) > 0 {
+		log.Info().Strs("captures", removed).Msg("Removed captures after their retention")
+	}
+	return nil
+}
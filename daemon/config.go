@@ -5,6 +5,14 @@
 package daemon
 
 import (
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/network/forward"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/kube"
+	"github.com/aau-network-security/haaukins/worker"
 	dockerclient "github.com/fsouza/go-dockerclient"
 )
 
@@ -28,6 +36,27 @@ type Config struct {
 	APICreds           APICreds                         `yaml:"api-creds,omitempty"`
 	DockerRepositories []dockerclient.AuthConfiguration `yaml:"docker-repositories,omitempty"`
 	FileTransferRoot   FileTransferConf                 `yaml:"file-transfer-root,omitempty"`
+	FrontendSnapshots  SnapshotConf                     `yaml:"frontend-snapshots,omitempty"`
+	Kubernetes         *kube.Config                     `yaml:"kubernetes,omitempty"`
+	Workers            *worker.PoolConfig               `yaml:"workers,omitempty"`
+	Bundles            *BundleConf                      `yaml:"bundles,omitempty"`
+	Capture            *capture.Config                  `yaml:"capture,omitempty"`
+	Firewall           string                           `yaml:"firewall,omitempty"`
+	PortForward        *forward.Config                  `yaml:"port-forward,omitempty"`
+	Terminal           *lab.TerminalConfig              `yaml:"terminal,omitempty"`
+	WaitingQueueUpdate time.Duration                    `yaml:"waiting-queue-update,omitempty"`
+}
+
+func (c *Config) exerciseServiceConfig() store.ServiceConfig {
+	return store.ServiceConfig{
+		Grpc:     c.ExerciseService.Grpc,
+		AuthKey:  c.ExerciseService.AuthKey,
+		SignKey:  c.ExerciseService.SignKey,
+		Enabled:  c.ExerciseService.CertConfig.Enabled,
+		CertFile: c.ExerciseService.CertConfig.CertFile,
+		CertKey:  c.ExerciseService.CertConfig.CertKey,
+		CAFile:   c.ExerciseService.CertConfig.CAFile,
+	}
 }
 
 type APICreds struct {
@@ -44,6 +73,8 @@ type VPNConnConf struct {
 	SignKey  string            `yaml:"sign-key"`
 	Dir      string            `yaml:"client-conf-dir"`
 	CertConf CertificateConfig `yaml:"tls"`
+	Embedded bool              `yaml:"embedded"`
+	StateDir string            `yaml:"state-dir"`
 }
 
 type CertificateConfig struct {
@@ -63,13 +94,34 @@ type ServiceConfig struct {
 
 type Files struct {
 	OvaDir        string `yaml:"ova-directory,omitempty"`
+	LibvirtDir    string `yaml:"libvirt-directory,omitempty"`
 	LogDir        string `yaml:"log-directory,omitempty"`
 	EventsDir     string `yaml:"events-directory,omitempty"`
 	UsersFile     string `yaml:"users-file,omitempty"`
 	ExercisesFile string `yaml:"exercises-file,omitempty"`
 	FrontendsFile string `yaml:"frontends-file,omitempty"`
+	TemplatesFile string `yaml:"templates-file,omitempty"`
 }
 
 type FileTransferConf struct {
 	Path string `yaml:"path"`
 }
+
+// SnapshotConf bounds the snapshots teams can
+// take of their frontends from the amigo UI
+type SnapshotConf struct {
+	MaxCount  int   `yaml:"max-count,omitempty"`
+	MaxSizeMB int64 `yaml:"max-size-mb,omitempty"`
+}
+
+// BundleConf configures the offline bundles imported into the daemon,
+// in offline mode the exercises are served from the imported bundles
+// instead of the exercise service. Bundles are signed with the private
+// key on the exporting host and verified with the public key on
+// importing hosts, which do not need the private key.
+type BundleConf struct {
+	Directory  string `yaml:"directory,omitempty"`
+	PrivateKey string `yaml:"private-key,omitempty"`
+	PublicKey  string `yaml:"public-key,omitempty"`
+	Offline    bool   `yaml:"offline,omitempty"`
+}
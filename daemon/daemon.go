@@ -21,16 +21,24 @@ import (
 	"time"
 
 	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/network/forward"
 	wg "github.com/aau-network-security/haaukins/network/vpn"
 
+	"github.com/aau-network-security/haaukins/svcs/amigo"
 	"github.com/aau-network-security/haaukins/svcs/guacamole"
 
+	"github.com/aau-network-security/haaukins/bundle"
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/lab"
 	"github.com/aau-network-security/haaukins/logging"
 	"github.com/aau-network-security/haaukins/store"
 	pbc "github.com/aau-network-security/haaukins/store/proto"
 	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/kube"
+	"github.com/aau-network-security/haaukins/virtual/libvirt"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
+	"github.com/aau-network-security/haaukins/worker"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
@@ -58,7 +66,10 @@ const (
 	MngtPort           = ":5454"
 	displayTimeFormat  = time.RFC3339
 	dbTimeFormat       = "2006-01-02 15:04:05"
-	labCheckInterval   = 5 * time.Hour
+	labCheckInterval   = time.Minute // idle policy is checked by the interval of each event
+	labHealthInterval  = 5 * time.Minute
+	labReplaceTimeout  = 5 * time.Minute // waiting for a lab of the hub
+	labUsageInterval   = time.Minute
 	eventCheckInterval = 8 * time.Hour
 	closeEventCI       = 12 * time.Hour
 	Running            = int32(0)
@@ -68,6 +79,11 @@ const (
 	SuspendTeamS       = "Suspend Team Scheduler"
 	BookEventS         = "Check Booked Event Scheduler"
 	CheckOverdueEventS = "Check Overdue Event Scheduler"
+	LabHealthS         = "Lab Health Scheduler"
+	LabUsageS          = "Lab Usage Scheduler"
+	PrepareEventsS     = "Prepare Booked Events Scheduler"
+	ImageGCS           = "Image Garbage Collector Scheduler"
+	CaptureGCS         = "Capture Garbage Collector Scheduler"
 )
 
 type MissingConfigErr struct {
@@ -98,11 +114,16 @@ type daemon struct {
 	users                        store.UsersFile
 	eventPool                    *eventPool
 	frontends                    store.FrontendStore
+	templates                    store.TemplateStore
 	ehost                        guacamole.Host
 	logPool                      logging.Pool
 	closers                      []io.Closer
 	dbClient                     pbc.StoreClient
 	exClient                     eproto.ExerciseStoreClient
+	idleChecks                   map[store.Tag]time.Time
+	workers                      *worker.Pool
+	vlib                         vbox.Library
+	prepared                     map[store.Tag]time.Time
 	pb.UnimplementedDaemonServer // discussing regarding to this https://github.com/grpc/grpc-go/issues/3669
 }
 
@@ -130,6 +151,21 @@ func NewConfigFromFile(path string) (*Config, error) {
 		docker.Registries[repo.ServerAddress] = repo
 	}
 
+	if c.Bundles != nil {
+		if c.Bundles.Directory == "" {
+			dir, _ := os.Getwd()
+			c.Bundles.Directory = filepath.Join(dir, "bundles")
+		}
+
+		// images of bundles are verified against the
+		// bundle instead of the registries
+		images, err := bundle.LoadImages(c.Bundles.Directory)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read imported bundles")
+		}
+		docker.SetBundleImages(images)
+	}
+
 	// todo: replace all if statement with something better
 	// change the way of handling configuration files
 
@@ -158,6 +194,11 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.ConfFiles.OvaDir = filepath.Join(dir, "vbox")
 	}
 
+	if c.ConfFiles.LibvirtDir == "" {
+		dir, _ := os.Getwd()
+		c.ConfFiles.LibvirtDir = filepath.Join(dir, "libvirt")
+	}
+
 	if c.ConfFiles.LogDir == "" {
 		dir, _ := os.Getwd()
 		c.ConfFiles.LogDir = filepath.Join(dir, "logs")
@@ -175,10 +216,22 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.ConfFiles.FrontendsFile = "frontends.yml"
 	}
 
+	if c.ConfFiles.TemplatesFile == "" {
+		c.ConfFiles.TemplatesFile = "templates.yml"
+	}
+
 	if c.ConfFiles.EventsDir == "" {
 		c.ConfFiles.EventsDir = "events"
 	}
 
+	if c.FrontendSnapshots.MaxCount == 0 {
+		c.FrontendSnapshots.MaxCount = 3
+	}
+
+	if c.FrontendSnapshots.MaxSizeMB == 0 {
+		c.FrontendSnapshots.MaxSizeMB = 10240
+	}
+
 	if c.Database.AuthKey == "" {
 		log.Info().Str("DB AUTH KEY", "development-environment").
 			Msg("Database authentication key set ")
@@ -197,6 +250,20 @@ func NewConfigFromFile(path string) (*Config, error) {
 		c.Database.Grpc = "localhost:50051"
 	}
 
+	if c.VPNConn.Embedded && c.VPNConn.StateDir == "" {
+		dir, _ := os.Getwd()
+		c.VPNConn.StateDir = filepath.Join(dir, "wireguard")
+	}
+
+	if c.Capture != nil && c.Capture.Directory == "" {
+		dir, _ := os.Getwd()
+		c.Capture.Directory = filepath.Join(dir, "captures")
+	}
+
+	if c.Terminal != nil && c.Terminal.Image == "" {
+		return nil, errors.New("terminal requires an image")
+	}
+
 	if c.Certs.Enabled {
 		if c.Certs.Directory == "" {
 			usr, err := user.Current()
@@ -225,8 +292,40 @@ func New(conf *Config) (*daemon, error) {
 		return nil, errors.Wrap(err, fmt.Sprintf("unable to read frontends file: %s", conf.ConfFiles.FrontendsFile))
 	}
 
+	tf, err := store.NewTemplateFile(conf.ConfFiles.TemplatesFile)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unable to read templates file: %s", conf.ConfFiles.TemplatesFile))
+	}
+
+	// the pages of amigo are served from the working directory
+	if err := amigo.CheckVendoredAssets(); err != nil {
+		return nil, err
+	}
+
 	vlib := vbox.NewLibrary(conf.ConfFiles.OvaDir)
+	frontendProviders := lab.NewFrontendProviders(vlib, libvirt.NewLibrary(conf.ConfFiles.LibvirtDir))
 	eventPool := NewEventPool(conf.Host.Http)
+	eventPool.replayKey = replaySigningKey(conf.SigningKey)
+
+	// events can only select the kubernetes backend
+	// when a cluster is given in the configuration
+	var cluster *kube.Cluster
+	if conf.Kubernetes != nil {
+		cluster, err = kube.NewCluster(*conf.Kubernetes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to connect to kubernetes cluster")
+		}
+	}
+
+	// labs are placed on worker hosts when
+	// workers are given in the configuration
+	var workers *worker.Pool
+	if conf.Workers != nil {
+		workers, err = worker.NewPool(*conf.Workers)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create worker coordinator")
+		}
+	}
 
 	if len(uf.ListUsers()) == 0 && len(uf.ListSignupKeys()) == 0 {
 		k := store.NewSignupKey()
@@ -258,16 +357,6 @@ func New(conf *Config) (*daemon, error) {
 		CAFile:   conf.Database.CertConfig.CAFile,
 	}
 
-	eserviceConfig := store.ServiceConfig{
-		Grpc:     conf.ExerciseService.Grpc,
-		AuthKey:  conf.ExerciseService.AuthKey,
-		SignKey:  conf.ExerciseService.SignKey,
-		Enabled:  conf.ExerciseService.CertConfig.Enabled,
-		CertFile: conf.ExerciseService.CertConfig.CertFile,
-		CertKey:  conf.ExerciseService.CertConfig.CertKey,
-		CAFile:   conf.ExerciseService.CertConfig.CAFile,
-	}
-
 	vpnConfig := wg.WireGuardConfig{
 		Endpoint: conf.VPNConn.Endpoint,
 		Port:     conf.VPNConn.Port,
@@ -278,13 +367,33 @@ func New(conf *Config) (*daemon, error) {
 		CertKey:  conf.VPNConn.CertConf.CertKey,
 		CAFile:   conf.VPNConn.CertConf.CAFile,
 		Dir:      conf.VPNConn.Dir,
+		Embedded: conf.VPNConn.Embedded,
+		StateDir: conf.VPNConn.StateDir,
 	}
-
-	exServiceClient, err := store.NewExerciseClientConn(eserviceConfig)
+	vpnClient, err := wg.NewVPNClient(vpnConfig)
 	if err != nil {
-		return nil, fmt.Errorf("[exercise-service]: error on creating gRPC communication %v ", err)
+		return nil, fmt.Errorf("error on creating VPN client %v", err)
+	}
+
+	snapshotPolicy := lab.SnapshotPolicy{
+		MaxCount: conf.FrontendSnapshots.MaxCount,
+		MaxSize:  conf.FrontendSnapshots.MaxSizeMB << 20,
+	}
+
+	var exServiceClient eproto.ExerciseStoreClient
+	if conf.Bundles != nil && conf.Bundles.Offline {
+		exServiceClient, err = bundle.NewExerciseClient(conf.Bundles.Directory)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read exercises of imported bundles")
+		}
+		log.Debug().Msg("Serving exercises from imported bundles !")
+	} else {
+		exServiceClient, err = store.NewExerciseClientConn(conf.exerciseServiceConfig())
+		if err != nil {
+			return nil, fmt.Errorf("[exercise-service]: error on creating gRPC communication %v ", err)
+		}
+		log.Debug().Msg("Exercise service connected !")
 	}
-	log.Debug().Msg("Exercise service connected !")
 
 	dbc, err := store.NewGRPClientDBConnection(dbConfig)
 	if err != nil {
@@ -292,6 +401,13 @@ func New(conf *Config) (*daemon, error) {
 	}
 	log.Debug().Msg("Store service connected !")
 
+	// subnets of networks left by a previous run of the daemon and
+	// of restored VPN events are kept, new subnets avoid the subnets
+	// of host routes and other Docker networks
+	if err := docker.LoadSubnets(store.NewSubnetStore(dbc)); err != nil {
+		log.Warn().Msgf("Unable to load subnet allocations from store, only host routes and Docker networks are reserved: %v", err)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 
@@ -313,6 +429,29 @@ func New(conf *Config) (*daemon, error) {
 	dbEvents = append(dbEvents, runningEvents.Events...)
 	dbEvents = append(dbEvents, suspendedEvents.Events...)
 
+	fw, err := firewall.New(conf.Firewall)
+	if err != nil {
+		return nil, err
+	}
+	// labs of restored events get new subnets, rules left by
+	// a previous run of the daemon are therefore all stale
+	if err := fw.Reconcile(nil); err != nil {
+		log.Warn().Msgf("Unable to remove stale firewall rules: %v", err)
+	}
+	// daemons before the firewall of labs left their rules in DOCKER-USER
+	if err := firewall.RemoveLegacyRules(); err != nil {
+		log.Warn().Msgf("Unable to remove legacy firewall rules: %v", err)
+	}
+
+	// TCP services are only forwarded when the daemon has a port range
+	var ports *forward.Ports
+	if conf.PortForward != nil {
+		ports, err = forward.NewPorts(*conf.PortForward)
+		if err != nil {
+			return nil, fmt.Errorf("port-forward: %v", err)
+		}
+	}
+
 	logPool, err := logging.NewPool(conf.ConfFiles.LogDir)
 	if err != nil {
 		return nil, fmt.Errorf("error on creating new pool for looging :  %v", err)
@@ -324,16 +463,39 @@ func New(conf *Config) (*daemon, error) {
 		users:     uf,
 		eventPool: eventPool,
 		frontends: ff,
-		ehost:     guacamole.NewHost(vlib, exServiceClient, conf.ConfFiles.EventsDir, dbc, vpnConfig),
-		logPool:   logPool,
-		closers:   []io.Closer{logPool, eventPool},
-		dbClient:  dbc,
-		exClient:  exServiceClient,
+		templates: tf,
+		ehost: guacamole.NewHost(guacamole.HostConfig{
+			VBoxLibrary:   vlib,
+			Frontends:     frontendProviders,
+			Cluster:       cluster,
+			Workers:       workers,
+			ExerciseStore: exServiceClient,
+			EventsDir:     conf.ConfFiles.EventsDir,
+			Store:         dbc,
+			VPNConfig:     vpnConfig,
+			VPN:           vpnClient,
+			Snapshots:     snapshotPolicy,
+			Capture:       conf.Capture,
+			Firewall:      fw,
+			Ports:         ports,
+			Terminal:      conf.Terminal,
+			QueueUpdate:   conf.WaitingQueueUpdate,
+		}),
+		logPool:  logPool,
+		closers:  []io.Closer{logPool, eventPool},
+		dbClient: dbc,
+		exClient: exServiceClient,
+		workers:  workers,
+		vlib:     vlib,
+		prepared: map[store.Tag]time.Time{},
+	}
+	if workers != nil {
+		d.closers = append(d.closers, workers)
 	}
 
-	var vpnAddress string
 	for _, ef := range dbEvents {
 
+		var vpnAddress string
 		var eventConfig store.EventConfig
 		// check through status of event
 		// suspended is also included since at first start
@@ -341,11 +503,10 @@ func New(conf *Config) (*daemon, error) {
 		// and configuration should be loaded to daemon
 		if ef.Status == Running || ef.Status == Suspended {
 			if ef.OnlyVPN == int32(VPN) || ef.OnlyVPN == int32(VPNBrowser) {
-				vpnIP, err := getVPNIP()
+				vpnAddress, err = allocateVPNAddress(store.Tag(ef.Tag))
 				if err != nil {
 					log.Error().Msgf("Getting VPN address error on New() in daemon %v", err)
 				}
-				vpnAddress = fmt.Sprintf("%s.240.1/22", vpnIP)
 			}
 			if ef.Status == Suspended {
 				d.dbClient.SetEventStatus(ctx, &pbc.SetEventStatusRequest{Status: Running, EventTag: ef.Tag})
@@ -359,6 +520,8 @@ func New(conf *Config) (*daemon, error) {
 			}
 		}
 	}
+	d.releaseStaleVPNSubnets()
+
 	var waitGroup sync.WaitGroup
 
 	for _, ef := range suspendedEvents.Events {
@@ -483,6 +646,15 @@ func (d *daemon) Run() error {
 			http.Redirect(w, r, "https://"+r.Host+r.URL.String(), http.StatusMovedPermanently)
 		}))
 	}
+	// start coordinator of the worker hosts
+	if d.workers != nil {
+		go func() {
+			if err := d.workers.Run(); err != nil {
+				log.Error().Msgf("Worker coordinator error: %s", err)
+			}
+		}()
+	}
+
 	// start gRPC daemon
 	lis, err := net.Listen("tcp", MngtPort)
 	if err != nil {
@@ -558,7 +730,7 @@ func getDates(sT, fT time.Time) []time.Time {
 func (d *daemon) initializeScheduler() error {
 	jobs := make(map[string]jobSpecs)
 	jobs[SuspendTeamS] = jobSpecs{
-		function:      d.suspendTeams,
+		function:      d.checkIdleTeams,
 		checkInterval: labCheckInterval,
 	}
 	jobs[BookEventS] = jobSpecs{
@@ -569,6 +741,28 @@ func (d *daemon) initializeScheduler() error {
 		function:      d.closeEvents,
 		checkInterval: closeEventCI,
 	}
+	jobs[LabHealthS] = jobSpecs{
+		function:      d.checkLabsHealth,
+		checkInterval: labHealthInterval,
+	}
+	jobs[LabUsageS] = jobSpecs{
+		function:      d.sampleLabsUsage,
+		checkInterval: labUsageInterval,
+	}
+	jobs[PrepareEventsS] = jobSpecs{
+		function:      d.prepareBookedEvents,
+		checkInterval: prepareInterval,
+	}
+	jobs[ImageGCS] = jobSpecs{
+		function:      d.collectImages,
+		checkInterval: imageGCInterval,
+	}
+	if d.conf.Capture != nil {
+		jobs[CaptureGCS] = jobSpecs{
+			function:      d.pruneCaptures,
+			checkInterval: captureGCInterval,
+		}
+	}
 
 	for name, job := range jobs {
 		log.Info().Msgf("Running scheduler %s", name)
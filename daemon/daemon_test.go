@@ -152,6 +152,14 @@ func (fe *fakeEvent) GetTeams() []*store.Team {
 	return fe.teams
 }
 
+func (fe *fakeEvent) GetWaitingTeams() []*store.Team {
+	return nil
+}
+
+func (fe *fakeEvent) ReplaceLab(context.Context, *store.Team) error {
+	return nil
+}
+
 func (fe *fakeEvent) GetLabByTeam(teamId string) (lab.Lab, bool) {
 	if fe.lab != nil {
 		return fe.lab, true
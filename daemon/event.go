@@ -7,9 +7,6 @@ import (
 	"fmt"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
 	"math"
-	"math/rand"
-	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -17,26 +14,25 @@ import (
 
 	pb "github.com/aau-network-security/haaukins/daemon/proto"
 	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/network/subnet"
 	"github.com/aau-network-security/haaukins/store"
 	pbc "github.com/aau-network-security/haaukins/store/proto"
 	"github.com/aau-network-security/haaukins/svcs/guacamole"
+	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	charSet = "abcdefghijklmnopqrstuvwxyz" +
-		"ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	numSet     = "0123456789"
 	NoVPN      = 0
 	VPN        = 1
 	VPNBrowser = 2
 )
 
 var (
-	NoPrivilegeToStressTest = errors.New("No privilege to have stress test on Haaukins !")
+	NoPrivilegeToIdlePolicy = errors.New("No privilege to change idle policy of an event created by others !")
+	NoPrivilegeToDNSRecords = errors.New("No privilege to change DNS records of an event created by others !")
 	NPUserMaxLabs           = 40
 	NotAvailableTag         = "not available tag, there is already an event which is either running, booked or suspended"
-	vpnIPPools              = newIPPoolFromHost()
 	CapacityExceedsErr      = errors.New("VPN Events can have maximum 252 people on board !")
 	OutOfQuota              = errors.New("Out of quota for members, you have limited access")
 )
@@ -70,6 +66,12 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 	loggerInstance := &GrpcLogger{resp: resp}
 	vpnAddress := ""
 	ctx := context.WithValue(resp.Context(), "grpc_logger", loggerInstance)
+	if req.Template != "" {
+		if err := d.applyTemplate(req); err != nil {
+			resp.Send(&pb.LabStatus{ErrorMessage: err.Error()})
+			return err
+		}
+	}
 	log.Ctx(ctx).
 		Info().
 		Str("tag", req.Tag).
@@ -83,6 +85,7 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 		Str("finishTime", req.FinishTime).
 		Str("SecretKey", req.SecretEvent).
 		Int32("VPN", req.OnlyVPN).
+		Str("template", req.Template).
 		Msg("create event")
 	// get random subnet for vpn connection
 	// check from database if subnet is already assigned to an event or not
@@ -177,11 +180,10 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 		}
 		// 25.43
 		if req.OnlyVPN == int32(VPN) || req.OnlyVPN == int32(VPNBrowser) {
-			vpnIP, err := getVPNIP()
+			vpnAddress, err = allocateVPNAddress(evtag)
 			if err != nil {
 				log.Error().Msgf("Error on getting IP for VPN connection error: %v", err)
 			}
-			vpnAddress = fmt.Sprintf("%s.240.1/22", vpnIP)
 		}
 
 		secretKey := strings.TrimSpace(req.SecretEvent)
@@ -198,12 +200,21 @@ func (d *daemon) CreateEvent(req *pb.CreateEventRequest, resp pb.Daemon_CreateEv
 				Frontends:         d.frontends.GetFrontends(req.Frontends...),
 				Exercises:         tags,
 				DisabledExercises: disabledExs,
+				TerminalExercises: convertToTags(req.TerminalExercises),
 			},
-			Status:     Running,
-			CreatedBy:  user.Username,
-			OnlyVPN:    req.OnlyVPN, // 0 novpn 1 // vpn 2 // browser+vpn
-			VPNAddress: vpnAddress,
-			SecretKey:  secretKey,
+			Status:      Running,
+			CreatedBy:   user.Username,
+			OnlyVPN:     req.OnlyVPN, // 0 novpn 1 // vpn 2 // browser+vpn
+			VPNAddress:  vpnAddress,
+			SecretKey:   secretKey,
+			IdlePolicy:  idlePolicyFromProto(req.IdlePolicy),
+			Backend:     req.Backend,
+			VPNProfiles: int(req.VpnProfiles),
+			Egress:      egressPolicyFromProto(req.EgressPolicy),
+			IPv6:        req.Ipv6,
+			PortForward: req.PortForward,
+			Recording:   recordingPolicyFromProto(req.RecordingPolicy),
+			Capture:     req.Capture,
 		}
 
 		if err := conf.Validate(); err != nil {
@@ -284,6 +295,15 @@ func (d *daemon) bookEvent(ctx context.Context, req *pb.CreateEventRequest) erro
 			OnlyVPN:            req.OnlyVPN,
 			SecretKey:          req.SecretEvent,
 			DisabledExercises:  strings.Join(req.DisableExercises, ","),
+			IdlePolicy:         idlePolicyFromProto(req.IdlePolicy).String(),
+			Backend:            req.Backend,
+			VpnProfiles:        req.VpnProfiles,
+			EgressPolicy:       egressPolicyFromProto(req.EgressPolicy).String(),
+			Ipv6:               req.Ipv6,
+			PortForward:        req.PortForward,
+			TerminalExercises:  strings.Join(req.TerminalExercises, ","),
+			RecordingPolicy:    recordingPolicyFromProto(req.RecordingPolicy).String(),
+			Capture:            req.Capture,
 		})
 		if err != nil {
 			log.Warn().Msgf("problem for inserting booked event into table, err %v", err)
@@ -294,6 +314,16 @@ func (d *daemon) bookEvent(ctx context.Context, req *pb.CreateEventRequest) erro
 	return nil
 }
 
+// terminalExerciseTags returns the terminal exercises stored
+// for an event, an event without them has no terminal
+func terminalExerciseTags(exs string) []store.Tag {
+	if exs == "" {
+		return nil
+	}
+
+	return convertToTags(strings.Split(exs, ","))
+}
+
 func convertToTags(exs []string) []store.Tag {
 	tags := make([]store.Tag, len(exs))
 	for i, v := range exs {
@@ -315,20 +345,117 @@ func (d *daemon) ListEventTeams(ctx context.Context, req *pb.ListEventTeamsReque
 
 	teams := ev.GetTeams()
 
+	waiting := ev.GetWaitingTeams()
+	queuePosition := make(map[string]int32, len(waiting))
+	for i, t := range waiting {
+		queuePosition[t.ID()] = int32(i + 1)
+	}
+
 	for _, t := range teams {
 
 		accesedTime := t.LastAccessTime()
 
 		eventTeams = append(eventTeams, &pb.ListEventTeamsResponse_Teams{
-			Id:         strings.TrimSpace(t.ID()),
-			Name:       strings.TrimSpace(t.Name()),
-			Email:      strings.TrimSpace(t.Email()),
-			AccessedAt: accesedTime.Format(displayTimeFormat),
+			Id:            strings.TrimSpace(t.ID()),
+			Name:          strings.TrimSpace(t.Name()),
+			Email:         strings.TrimSpace(t.Email()),
+			AccessedAt:    accesedTime.Format(displayTimeFormat),
+			QueuePosition: queuePosition[t.ID()],
 		})
 
 	}
 
-	return &pb.ListEventTeamsResponse{Teams: eventTeams}, nil
+	return &pb.ListEventTeamsResponse{Teams: eventTeams, QueueLength: int32(len(waiting))}, nil
+}
+
+func (d *daemon) GetEventIdlePolicy(ctx context.Context, req *pb.EventIdlePolicyRequest) (*pb.IdlePolicy, error) {
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+
+	return idlePolicyToProto(ev.GetIdlePolicy()), nil
+}
+
+func (d *daemon) SetEventIdlePolicy(ctx context.Context, req *pb.EventIdlePolicyRequest) (*pb.IdlePolicy, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user credentials could not found on context %v", err)
+	}
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+	if !user.SuperUser && ev.GetConfig().CreatedBy != user.Username {
+		return nil, NoPrivilegeToIdlePolicy
+	}
+
+	policy, err := mergeIdlePolicy(ev.GetIdlePolicy(), idlePolicyFromProto(req.Policy), req.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := ev.SetIdlePolicy(policy); err != nil {
+		return nil, err
+	}
+	log.Info().Str("event", req.EventTag).
+		Str("user", user.Username).
+		Str("policy", policy.String()).
+		Msg("Idle policy changed")
+
+	return idlePolicyToProto(ev.GetIdlePolicy()), nil
+}
+
+func (d *daemon) GetEventDNSRecords(ctx context.Context, req *pb.EventDNSRecordsRequest) (*pb.EventDNSRecords, error) {
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsRecordsToProto(ev.GetDNSRecords()), nil
+}
+
+func (d *daemon) SetEventDNSRecords(ctx context.Context, req *pb.EventDNSRecordsRequest) (*pb.EventDNSRecords, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user credentials could not found on context %v", err)
+	}
+	evtag, err := store.NewTag(req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, err
+	}
+	if !user.SuperUser && ev.GetConfig().CreatedBy != user.Username {
+		return nil, NoPrivilegeToDNSRecords
+	}
+
+	var records []store.RecordConfig
+	for _, r := range req.Records {
+		records = append(records, store.RecordConfig{Name: r.Name, Type: r.Type, RData: r.Data})
+	}
+	if err := ev.SetDNSRecords(ctx, records); err != nil {
+		return nil, err
+	}
+	log.Info().Str("event", req.EventTag).
+		Str("user", user.Username).
+		Int("records", len(records)).
+		Msg("DNS records changed")
+
+	return dnsRecordsToProto(ev.GetDNSRecords()), nil
 }
 
 func (d *daemon) StopEvent(req *pb.StopEventRequest, resp pb.Daemon_StopEventServer) error {
@@ -370,9 +497,8 @@ func (d *daemon) StopEvent(req *pb.StopEventRequest, resp pb.Daemon_StopEventSer
 		eventConfig := ev.GetConfig()
 		createdBy := eventConfig.CreatedBy
 		if eventConfig.OnlyVPN == VPN || eventConfig.OnlyVPN == VPNBrowser {
-			vpnIP := strings.ReplaceAll(eventConfig.VPNAddress, ".240.1/22", "")
-			vpnIPPools.ReleaseIP(vpnIP)
-			log.Debug().Str("VPN IP", vpnIP).Msgf("VPN IP is released from allocated pool!")
+			docker.Subnets.Release(eventConfig.VPNAddress)
+			log.Debug().Str("VPN IP", eventConfig.VPNAddress).Msgf("VPN IP is released from allocated pool!")
 		}
 
 		if (user.NPUser && user.Username == createdBy) || !user.NPUser {
@@ -598,11 +724,10 @@ func (d *daemon) visitBookedEvents() error {
 			// set status to running if booked event startTime passed.
 
 			if event.OnlyVPN == int32(VPN) || event.OnlyVPN == int32(VPNBrowser) {
-				vpnIP, err := getVPNIP()
+				vpnAddress, err = allocateVPNAddress(store.Tag(event.Tag))
 				if err != nil {
 					log.Error().Msgf("Error on getting IP for VPN connection error: %v", err)
 				}
-				vpnAddress = fmt.Sprintf("%s.240.1/22", vpnIP)
 			}
 
 			eventConfig := d.generateEventConfig(event, Running, vpnAddress)
@@ -638,6 +763,22 @@ func (d *daemon) generateEventConfig(event *pbc.GetEventResponse_Events, status
 	for _, e := range disabledExs {
 		disabledExerciseTags = append(disabledExerciseTags, store.Tag(e))
 	}
+	idlePolicy, err := store.ParseIdlePolicy(event.IdlePolicy)
+	if err != nil {
+		log.Warn().Str("Event tag", event.Tag).Msgf("Unable to parse idle policy, using defaults: %v", err)
+	}
+	egressPolicy, err := store.ParseEgressPolicy(event.EgressPolicy)
+	if err != nil {
+		log.Warn().Str("Event tag", event.Tag).Msgf("Unable to parse egress policy, egress is not restricted: %v", err)
+	}
+	recordingPolicy, err := store.ParseRecordingPolicy(event.RecordingPolicy)
+	if err != nil {
+		log.Warn().Str("Event tag", event.Tag).Msgf("Unable to parse recording policy, connections are not recorded: %v", err)
+	}
+	dnsRecords, err := store.ParseDNSRecords(event.DnsRecords)
+	if err != nil {
+		log.Warn().Str("Event tag", event.Tag).Msgf("Unable to parse DNS records, labs only have the records of exercises: %v", err)
+	}
 
 	log.Debug().Str("Event name", event.Name).
 		Str("Event tag", event.Tag).
@@ -661,6 +802,7 @@ func (d *daemon) generateEventConfig(event *pbc.GetEventResponse_Events, status
 			Frontends:         instanceConfig,
 			Exercises:         exercises,
 			DisabledExercises: disabledExerciseTags,
+			TerminalExercises: terminalExerciseTags(event.TerminalExercises),
 		},
 		StartedAt:      &requestedStartTime,
 		FinishExpected: &requestedFinishTime,
@@ -670,6 +812,15 @@ func (d *daemon) generateEventConfig(event *pbc.GetEventResponse_Events, status
 		VPNAddress:     vpnAddress,
 		OnlyVPN:        event.OnlyVPN, // 0 NoVPN 1 VPN 2 Browser+VPN
 		SecretKey:      event.SecretKey,
+		IdlePolicy:     idlePolicy,
+		Backend:        event.Backend,
+		VPNProfiles:    int(event.VpnProfiles),
+		Egress:         egressPolicy,
+		IPv6:           event.Ipv6,
+		PortForward:    event.PortForward,
+		Recording:      recordingPolicy,
+		Capture:        event.Capture,
+		DNSRecords:     dnsRecords,
 	}
 
 	return eventConfig
@@ -698,9 +849,8 @@ func (d *daemon) closeEvent(ch chan guacamole.Event, wg *sync.WaitGroup) error {
 		e := ev.GetConfig()
 		log.Info().Msgf("Running close events, checking %s", e.Tag)
 		if e.OnlyVPN == VPN || e.OnlyVPN == VPNBrowser {
-			vpnIP := strings.ReplaceAll(e.VPNAddress, ".240.1/22", "")
-			vpnIPPools.ReleaseIP(vpnIP)
-			log.Debug().Str("VPN IP", vpnIP).Msgf("VPNIP is released from allocated pool!")
+			docker.Subnets.Release(e.VPNAddress)
+			log.Debug().Str("VPN IP", e.VPNAddress).Msgf("VPNIP is released from allocated pool!")
 		}
 
 		if isDelayed(e.FinishExpected.Format(displayTimeFormat)) {
@@ -737,86 +887,6 @@ func (d *daemon) closeEvent(ch chan guacamole.Event, wg *sync.WaitGroup) error {
 	return closeErr
 }
 
-// StressEvent is making requests to daemon to see how daemon can handle them
-func (d *daemon) StressEvent(ctx context.Context, req *pb.TestEventLoadReq) (*pb.TestEventLoadResp, error) {
-	user, err := getUserFromIncomingContext(ctx)
-	if err != nil {
-		return &pb.TestEventLoadResp{}, err
-	}
-	if !user.SuperUser {
-		return &pb.TestEventLoadResp{}, NoPrivilegeToStressTest
-	}
-
-	ev, err := d.eventPool.GetEvent(store.Tag(req.EventName))
-	if ev == nil {
-		return &pb.TestEventLoadResp{}, fmt.Errorf("no such an event called %s, error: %v !", req.EventName, err)
-	}
-
-	if ev.GetConfig().Capacity < int(req.NumberOfTeams) {
-		return &pb.TestEventLoadResp{}, errors.New("event capacity is less than provided number of teams. skipping testing...")
-	}
-	var port, protocol string
-	if d.conf.Certs.Enabled {
-		port = strconv.FormatUint(uint64(d.conf.Port.Secure), 10)
-		protocol = "https://"
-	} else {
-		port = strconv.FormatUint(uint64(d.conf.Port.InSecure), 10)
-		protocol = "http://"
-	}
-	endPoint := fmt.Sprintf(protocol + req.EventName + "." + d.conf.Host.Http + ":" + port + "/signup")
-	resp := make(chan string)
-	for i := 0; i < int(req.NumberOfTeams); i++ {
-		go func() {
-			resp <- d.postRequest(endPoint, protocol)
-		}()
-	}
-	response := <-resp
-
-	return &pb.TestEventLoadResp{SignUpResult: response}, nil
-}
-
-// constructRandomValues will create random form for signing up
-func constructRandomValues() url.Values {
-	name := stringWithCharset(10, charSet)
-	email := fmt.Sprintf("%s@%s.com", stringWithCharset(10, charSet), stringWithCharset(10, charSet))
-	password := fmt.Sprintf("%s", stringWithCharset(6, numSet))
-	form := url.Values{}
-	form.Add("email", email)
-	form.Add("team-name", name)
-	form.Add("password", password)
-	form.Add("password-repeat", password)
-	return form
-}
-
-func (d *daemon) postRequest(endPoint, protocol string) string {
-	hc := http.Client{}
-	v := constructRandomValues()
-	postReq, err := http.NewRequest("POST", endPoint, strings.NewReader(v.Encode()))
-	if err != nil {
-		return err.Error()
-	}
-	postReq.Form = v
-	postReq.Header.Add("User-Agent", "Mozilla/5.0 (Windows NT 6.3; WOW64; rv:43.0) Gecko/20100101 Firefox/43.0")
-	postReq.Header.Add("Referer", protocol+endPoint)
-	postReq.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	resp, err := hc.Do(postReq)
-	if err != nil {
-		return err.Error()
-	}
-	return resp.Status
-}
-
-// StringWithCharset will return random characters
-func stringWithCharset(length int, charset string) string {
-	var seededRand *rand.Rand = rand.New(
-		rand.NewSource(time.Now().UnixNano()))
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[seededRand.Intn(len(charset))]
-	}
-	return string(b)
-}
-
 func isDelayed(customTime string) bool {
 	now := time.Now()
 	givenTime, _ := time.Parse(displayTimeFormat, customTime)
@@ -864,13 +934,15 @@ func (d *daemon) dropEvent(ctx context.Context, evTag string) error {
 	return nil
 }
 
-func getVPNIP() (string, error) {
-	// by default CreateEvent function will create event VPN  + Kali Connection
-	ip, err := vpnIPPools.Get()
+// allocateVPNAddress returns the address of the wireguard interface of
+// the event (x.y.240.1/22), a restored event keeps its previous subnet
+func allocateVPNAddress(tag store.Tag) (string, error) {
+	s, err := docker.Subnets.Allocate(subnet.VPN, string(tag))
 	if err != nil {
 		return "", err
 	}
-	return ip, nil
+
+	return strings.Replace(s, ".0/22", ".1/22", 1), nil
 }
 
 func (d *daemon) SaveProfile(req *pb.SaveProfileRequest, resp pb.Daemon_SaveProfileServer) error {
@@ -1009,3 +1081,101 @@ func (d *daemon) DeleteProfile(req *pb.DeleteProfileRequest, resp pb.Daemon_Dele
 
 	return errors.New("You don't have the privilege to delete profiles")
 }
+
+// idlePolicyFromProto converts the idle policy of requests
+// where durations are given in minutes
+func idlePolicyFromProto(p *pb.IdlePolicy) store.IdlePolicy {
+	if p == nil {
+		return store.IdlePolicy{}
+	}
+	return store.IdlePolicy{
+		Threshold:     time.Duration(p.ThresholdMinutes) * time.Minute,
+		CheckInterval: time.Duration(p.CheckIntervalMinutes) * time.Minute,
+		Action:        store.IdleAction(strings.TrimSpace(p.Action)),
+		ExemptTeams:   p.ExemptTeams,
+	}
+}
+
+// mergeIdlePolicy returns the current policy where the given fields
+// are replaced by the values of the update, all fields are replaced
+// when none is given
+func mergeIdlePolicy(current, update store.IdlePolicy, fields []string) (store.IdlePolicy, error) {
+	if len(fields) == 0 {
+		return update, nil
+	}
+	for _, f := range fields {
+		switch f {
+		case "threshold":
+			current.Threshold = update.Threshold
+		case "checkInterval":
+			current.CheckInterval = update.CheckInterval
+		case "action":
+			current.Action = update.Action
+		case "exemptTeams":
+			current.ExemptTeams = update.ExemptTeams
+		default:
+			return store.IdlePolicy{}, fmt.Errorf("unknown idle policy field %q", f)
+		}
+	}
+	return current, nil
+}
+
+func idlePolicyToProto(p store.IdlePolicy) *pb.IdlePolicy {
+	return &pb.IdlePolicy{
+		ThresholdMinutes:     int32(p.Threshold / time.Minute),
+		CheckIntervalMinutes: int32(p.CheckInterval / time.Minute),
+		Action:               string(p.Action),
+		ExemptTeams:          p.ExemptTeams,
+	}
+}
+
+func recordingPolicyFromProto(p *pb.RecordingPolicy) store.RecordingPolicy {
+	if p == nil {
+		return store.RecordingPolicy{}
+	}
+	return store.RecordingPolicy{
+		Enabled:   p.Enabled,
+		Retention: time.Duration(p.RetentionHours) * time.Hour,
+	}
+}
+
+func recordingPolicyToProto(p store.RecordingPolicy) *pb.RecordingPolicy {
+	return &pb.RecordingPolicy{
+		Enabled:        p.Enabled,
+		RetentionHours: int32(p.Retention / time.Hour),
+	}
+}
+
+func egressPolicyFromProto(p *pb.EgressPolicy) store.EgressPolicy {
+	if p == nil {
+		return store.EgressPolicy{}
+	}
+	var allow []string
+	for _, a := range p.Allow {
+		if a = strings.TrimSpace(a); a != "" {
+			allow = append(allow, a)
+		}
+	}
+	return store.EgressPolicy{
+		BlockInternet: p.BlockInternet,
+		Allow:         allow,
+		Isolate:       p.Isolate,
+	}
+}
+
+func egressPolicyToProto(p store.EgressPolicy) *pb.EgressPolicy {
+	return &pb.EgressPolicy{
+		BlockInternet: p.BlockInternet,
+		Allow:         p.Allow,
+		Isolate:       p.Isolate,
+	}
+}
+
+func dnsRecordsToProto(records []store.RecordConfig) *pb.EventDNSRecords {
+	resp := &pb.EventDNSRecords{}
+	for _, r := range records {
+		resp.Records = append(resp.Records, &pb.DNSRecord{Name: r.Name, Type: r.Type, Data: r.RData})
+	}
+
+	return resp
+}
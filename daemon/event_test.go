@@ -368,6 +368,42 @@ func TestCheckTime(t *testing.T) {
 	}
 }
 
+func TestMergeIdlePolicy(t *testing.T) {
+	current := store.IdlePolicy{
+		Threshold:     2 * time.Hour,
+		CheckInterval: 10 * time.Minute,
+		Action:        store.IdleStop,
+		ExemptTeams:   []string{"team1"},
+	}
+	update := store.IdlePolicy{ExemptTeams: []string{"team2"}}
+	tests := []struct {
+		name   string
+		fields []string
+		want   store.IdlePolicy
+		err    bool
+	}{
+		{name: "All fields", want: update},
+		{name: "Exempt teams", fields: []string{"exemptTeams"}, want: store.IdlePolicy{
+			Threshold:     2 * time.Hour,
+			CheckInterval: 10 * time.Minute,
+			Action:        store.IdleStop,
+			ExemptTeams:   []string{"team2"},
+		}},
+		{name: "Unknown field", fields: []string{"unknown"}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeIdlePolicy(current, update, tt.fields)
+			if (err != nil) != tt.err {
+				t.Fatalf("mergeIdlePolicy() error = %v, expected error: %t", err, tt.err)
+			}
+			if !tt.err && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeIdlePolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func toTime(year, month, day, hour, minute, second int) time.Time {
 	return time.Date(year, time.Month(month), day, hour, minute, second, 0000, time.UTC)
 }
@@ -22,7 +22,12 @@ type eventPool struct {
 	notFoundHandler http.Handler
 	events          map[store.Tag]guacamole.Event
 	handlers        map[store.Tag]http.Handler
-	wg              wg.WireguardClient
+	// forwards serve the services of labs of events
+	// forwarding services on the subdomains of teams
+	forwards map[store.Tag]http.Handler
+	wg       wg.WireguardClient
+	// replayKey signs the tokens of replays of recordings
+	replayKey []byte
 }
 
 func NewEventPool(host string) *eventPool {
@@ -31,6 +36,7 @@ func NewEventPool(host string) *eventPool {
 		notFoundHandler: notFoundHandler(),
 		events:          map[store.Tag]guacamole.Event{},
 		handlers:        map[store.Tag]http.Handler{},
+		forwards:        map[store.Tag]http.Handler{},
 	}
 }
 
@@ -42,6 +48,9 @@ func (ep *eventPool) AddEvent(ev guacamole.Event) {
 
 	ep.events[tag] = ev
 	ep.handlers[tag] = ev.Handler()
+	if ev.GetConfig().PortForward {
+		ep.forwards[tag] = ev.ForwardHandler()
+	}
 }
 
 func (ep *eventPool) RemoveEvent(t store.Tag) error {
@@ -54,6 +63,7 @@ func (ep *eventPool) RemoveEvent(t store.Tag) error {
 
 	delete(ep.events, t)
 	delete(ep.handlers, t)
+	delete(ep.forwards, t)
 
 	return nil
 }
@@ -105,21 +115,48 @@ func (ep *eventPool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	sub, dom := domainParts[0], domainParts[1]
 	if !strings.HasPrefix(dom, ep.host) {
-		ep.notFoundHandler.ServeHTTP(w, r)
+		ep.serveForward(w, r)
 		return
 	}
 
 	ep.m.RLock()
 	mux, ok := ep.handlers[store.Tag(sub)]
+	ev := ep.events[store.Tag(sub)]
 	ep.m.RUnlock()
 	if !ok {
 		ep.notFoundHandler.ServeHTTP(w, r)
 		return
 	}
 
+	if ev != nil && strings.HasPrefix(r.URL.Path, recordingsPath) {
+		ep.serveRecording(w, r, ev)
+		return
+	}
+
 	mux.ServeHTTP(w, r)
 }
 
+// serveForward serves the forwarded services of labs,
+// which are on subdomains of teams of the event, e.g.
+// web1.<team>.<event>.<host>
+func (ep *eventPool) serveForward(w http.ResponseWriter, r *http.Request) {
+	domainParts := strings.SplitN(r.Host, ".", 4)
+	if len(domainParts) != 4 || !strings.HasPrefix(domainParts[3], ep.host) {
+		ep.notFoundHandler.ServeHTTP(w, r)
+		return
+	}
+
+	ep.m.RLock()
+	forward, ok := ep.forwards[store.Tag(domainParts[2])]
+	ep.m.RUnlock()
+	if !ok {
+		ep.notFoundHandler.ServeHTTP(w, r)
+		return
+	}
+
+	forward.ServeHTTP(w, r)
+}
+
 func getHost(r *http.Request) string {
 	if r.URL.IsAbs() {
 		host := r.Host
@@ -0,0 +1,362 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/exercise"
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	pbc "github.com/aau-network-security/haaukins/store/proto"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	wpb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DockerImage = "docker"
+	OvaImage    = "ova"
+
+	// images of booked events are prepared when the
+	// event starts within prepareAhead
+	prepareAhead    = 2 * time.Hour
+	prepareInterval = 30 * time.Minute
+	imageGCInterval = 24 * time.Hour
+	imagePulls      = 4
+)
+
+var (
+	NoEventImagesErr = errors.New("Event tag or exercises are required to prepare images")
+
+	prepareDockerImages = docker.PrepareImages
+)
+
+// eventImages are the docker images and OVAs used by the labs of an event
+type eventImages struct {
+	docker []string
+	ovas   []string
+}
+
+func (ei *eventImages) add(o eventImages) {
+	ei.docker = append(ei.docker, o.docker...)
+	ei.ovas = append(ei.ovas, o.ovas...)
+}
+
+func (d *daemon) resolveImages(ctx context.Context, exercises []string, frontends []string) (eventImages, error) {
+	images := frontendImages(d.frontends, frontends)
+	if len(exercises) == 0 {
+		return images, nil
+	}
+
+	resp, err := d.exClient.GetExerciseByTags(ctx, &eproto.GetExerciseByTagsRequest{Tag: exercises})
+	if err != nil {
+		return images, fmt.Errorf("[exercises-service] error %v", err)
+	}
+
+	exImages, err := exerciseImages(resp.Exercises)
+	if err != nil {
+		return images, err
+	}
+	images.add(exImages)
+
+	return images, nil
+}
+
+func frontendImages(ff store.FrontendStore, frontends []string) eventImages {
+	var images eventImages
+	for _, f := range ff.GetFrontends(frontends...) {
+		switch f.Provider {
+		case "", lab.VBoxFrontend:
+			images.ovas = append(images.ovas, f.Image)
+		case lab.DockerFrontend:
+			images.docker = append(images.docker, f.Image)
+		}
+	}
+
+	return images
+}
+
+func exerciseImages(exercises []*eproto.Exercise) (eventImages, error) {
+	var images eventImages
+	for _, e := range exercises {
+		raw, err := protobufToJson(e)
+		if err != nil {
+			return images, err
+		}
+		var ex store.Exercise
+		if err := json.Unmarshal([]byte(raw), &ex); err != nil {
+			return images, err
+		}
+		if ex.Static {
+			continue
+		}
+
+		for _, i := range ex.Instance {
+			if strings.Contains(i.Image, exercise.OvaSuffix) {
+				images.ovas = append(images.ovas, i.Image)
+				continue
+			}
+			images.docker = append(images.docker, i.Image)
+		}
+	}
+
+	return images, nil
+}
+
+func (d *daemon) resolveEventImages(ctx context.Context, event *pbc.GetEventResponse_Events) (eventImages, error) {
+	return d.resolveImages(ctx, splitList(event.Exercises), splitList(event.Frontends))
+}
+
+func splitList(s string) []string {
+	var res []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// prepareImages prepares the images on the daemon host and on the
+// workers at the same time, progress may be called concurrently
+func (d *daemon) prepareImages(ctx context.Context, images eventImages, progress func(*pb.PrepareEventStatus)) error {
+	if d.workers == nil {
+		return d.prepareLocalImages(ctx, images, progress)
+	}
+
+	var workersErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		workersErr = d.workers.PrepareImages(ctx, images.docker, images.ovas, func(name string, s *wpb.PrepareImagesStatus) {
+			kind := DockerImage
+			if s.Ova {
+				kind = OvaImage
+			}
+			progress(&pb.PrepareEventStatus{Image: s.Image, Kind: kind, Status: s.Status, ErrorMessage: s.ErrorMessage, Worker: name})
+		})
+	}()
+
+	err := d.prepareLocalImages(ctx, images, progress)
+	<-done
+	if err != nil {
+		return err
+	}
+
+	return workersErr
+}
+
+func (d *daemon) prepareLocalImages(ctx context.Context, images eventImages, progress func(*pb.PrepareEventStatus)) error {
+	err := prepareDockerImages(ctx, images.docker, imagePulls, func(p docker.ImageProgress) {
+		status := &pb.PrepareEventStatus{Image: p.Image, Kind: DockerImage, Status: p.Status}
+		if p.Err != nil {
+			status.ErrorMessage = p.Err.Error()
+		}
+		progress(status)
+	})
+
+	seen := map[string]struct{}{}
+	for _, ova := range images.ovas {
+		if _, ok := seen[ova]; ok {
+			continue
+		}
+		seen[ova] = struct{}{}
+
+		progress(&pb.PrepareEventStatus{Image: ova, Kind: OvaImage, Status: docker.ImagePulling})
+		if prepErr := d.vlib.Prepare(ctx, ova); prepErr != nil {
+			progress(&pb.PrepareEventStatus{Image: ova, Kind: OvaImage, Status: docker.ImageFailed, ErrorMessage: prepErr.Error()})
+			if err == nil {
+				err = prepErr
+			}
+			continue
+		}
+		progress(&pb.PrepareEventStatus{Image: ova, Kind: OvaImage, Status: docker.ImageUpToDate})
+	}
+
+	return err
+}
+
+// PrepareEvent pulls the images and imports the OVAs used by an event on
+// the daemon host and its workers, such that the labs of the event do
+// not wait for the registry
+func (d *daemon) PrepareEvent(req *pb.PrepareEventRequest, stream pb.Daemon_PrepareEventServer) error {
+	ctx := stream.Context()
+	usr, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return err
+	}
+	if usr.NPUser {
+		return fmt.Errorf("No priviledge to prepare images of events")
+	}
+
+	var images eventImages
+	switch {
+	case req.EventTag != "":
+		event, err := d.findEvent(ctx, req.EventTag)
+		if err != nil {
+			return err
+		}
+		images, err = d.resolveEventImages(ctx, event)
+		if err != nil {
+			return err
+		}
+	case len(req.Exercises) > 0:
+		images, err = d.resolveImages(ctx, req.Exercises, req.Frontends)
+		if err != nil {
+			return err
+		}
+	default:
+		return NoEventImagesErr
+	}
+
+	var m sync.Mutex
+	return d.prepareImages(ctx, images, func(s *pb.PrepareEventStatus) {
+		m.Lock()
+		defer m.Unlock()
+		stream.Send(s)
+	})
+}
+
+// findEvent looks up a booked, running or suspended event in the database
+func (d *daemon) findEvent(ctx context.Context, tag string) (*pbc.GetEventResponse_Events, error) {
+	for _, status := range []int32{Booked, Running, Suspended} {
+		resp, err := d.dbClient.GetEvents(ctx, &pbc.GetEventRequest{Status: status})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.Events {
+			if e.Tag == tag {
+				return e, nil
+			}
+		}
+	}
+
+	return nil, UnknownEventErr
+}
+
+// prepareBookedEvents prepares the images of booked
+// events starting soon, once per event
+func (d *daemon) prepareBookedEvents() error {
+	ctx := context.Background()
+	resp, err := d.dbClient.GetEvents(ctx, &pbc.GetEventRequest{Status: Booked})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	booked := map[store.Tag]struct{}{}
+	for _, event := range resp.Events {
+		booked[store.Tag(event.Tag)] = struct{}{}
+	}
+	// started or cancelled events
+	for tag := range d.prepared {
+		if _, ok := booked[tag]; !ok {
+			delete(d.prepared, tag)
+		}
+	}
+
+	for _, event := range resp.Events {
+		startTime, err := time.Parse(displayTimeFormat, event.StartedAt)
+		if err != nil || startTime.After(now.Add(prepareAhead)) {
+			continue
+		}
+		// the nodes of the cluster pull the images themselves
+		if event.Backend == store.KubernetesBackend {
+			continue
+		}
+		if _, ok := d.prepared[store.Tag(event.Tag)]; ok {
+			continue
+		}
+
+		images, err := d.resolveEventImages(ctx, event)
+		if err != nil {
+			log.Warn().Str("event", event.Tag).Msgf("Unable to resolve images of booked event: %v", err)
+			continue
+		}
+
+		log.Info().Str("event", event.Tag).Msg("Preparing images of booked event")
+		if err := d.prepareImages(ctx, images, func(*pb.PrepareEventStatus) {}); err != nil {
+			log.Warn().Str("event", event.Tag).Msgf("Unable to prepare images of booked event, retrying later: %v", err)
+			continue
+		}
+		d.prepared[store.Tag(event.Tag)] = now
+	}
+
+	return nil
+}
+
+// usedImages are the images referenced by booked, running and suspended events
+func (d *daemon) usedImages(ctx context.Context) (eventImages, error) {
+	var images eventImages
+	for _, status := range []int32{Booked, Running, Suspended} {
+		resp, err := d.dbClient.GetEvents(ctx, &pbc.GetEventRequest{Status: status})
+		if err != nil {
+			return images, err
+		}
+		for _, e := range resp.Events {
+			ei, err := d.resolveEventImages(ctx, e)
+			if err != nil {
+				return images, err
+			}
+			images.add(ei)
+		}
+	}
+
+	return images, nil
+}
+
+func (d *daemon) pruneImages(ctx context.Context) (*pb.PruneImagesResponse, error) {
+	// an event whose images cannot be resolved
+	// could lose its images, hence nothing is pruned
+	used, err := d.usedImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := docker.PruneImages(used.docker)
+	if err != nil {
+		return nil, err
+	}
+
+	ovas, err := d.vlib.Prune(ctx, used.ovas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PruneImagesResponse{Images: images, Ovas: ovas}, nil
+}
+
+// collectImages is the garbage collector of images and OVAs
+func (d *daemon) collectImages() error {
+	resp, err := d.pruneImages(context.Background())
+	if err != nil {
+		log.Warn().Msgf("Unable to prune images: %v", err)
+		return err
+	}
+
+	log.Info().Int("images", len(resp.Images)).Int("ovas", len(resp.Ovas)).Msg("Pruned unused images")
+	return nil
+}
+
+func (d *daemon) PruneImages(ctx context.Context, req *pb.Empty) (*pb.PruneImagesResponse, error) {
+	usr, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !usr.SuperUser {
+		return nil, fmt.Errorf("No priviledge to prune images")
+	}
+
+	return d.pruneImages(ctx)
+}
@@ -0,0 +1,113 @@
+package daemon
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/store"
+	pbc "github.com/aau-network-security/haaukins/store/proto"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/vbox"
+	"google.golang.org/grpc"
+)
+
+type fakeExerciseClient struct {
+	eproto.ExerciseStoreClient
+	exercises []*eproto.Exercise
+}
+
+func (fe *fakeExerciseClient) GetExerciseByTags(ctx context.Context, in *eproto.GetExerciseByTagsRequest, opts ...grpc.CallOption) (*eproto.GetExercisesResponse, error) {
+	return &eproto.GetExercisesResponse{Exercises: fe.exercises}, nil
+}
+
+func TestResolveImages(t *testing.T) {
+	d := &daemon{
+		frontends: &fakeFrontendStore{},
+		exClient: &fakeExerciseClient{exercises: []*eproto.Exercise{
+			{Tag: "sql", Instance: []*eproto.ExerciseInstance{{Image: "registry.gitlab.com/aau/sql"}, {Image: "router.ova"}}},
+			{Tag: "quiz", Static: true, Instance: []*eproto.ExerciseInstance{{Image: "registry.gitlab.com/aau/quiz"}}},
+		}},
+	}
+
+	images, err := d.resolveImages(context.Background(), splitList("sql, quiz"), splitList("kali"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(images.docker, []string{"registry.gitlab.com/aau/sql"}) {
+		t.Fatalf("unexpected docker images: %v", images.docker)
+	}
+	if !reflect.DeepEqual(images.ovas, []string{"kali", "router.ova"}) {
+		t.Fatalf("unexpected ovas: %v", images.ovas)
+	}
+}
+
+type fakeBookedStore struct {
+	pbc.StoreClient
+	events []*pbc.GetEventResponse_Events
+}
+
+func (fs *fakeBookedStore) GetEvents(ctx context.Context, in *pbc.GetEventRequest, opts ...grpc.CallOption) (*pbc.GetEventResponse, error) {
+	if in.Status != Booked {
+		return &pbc.GetEventResponse{}, nil
+	}
+	return &pbc.GetEventResponse{Events: fs.events}, nil
+}
+
+type fakePrepareLibrary struct {
+	vbox.Library
+	prepared []string
+}
+
+func (fl *fakePrepareLibrary) Prepare(ctx context.Context, ova string) error {
+	fl.prepared = append(fl.prepared, ova)
+	return nil
+}
+
+func TestPrepareBookedEvents(t *testing.T) {
+	var pulled []string
+	prepareDockerImages = func(ctx context.Context, images []string, parallel int, progress func(docker.ImageProgress)) error {
+		pulled = append(pulled, images...)
+		return nil
+	}
+	defer func() { prepareDockerImages = docker.PrepareImages }()
+
+	soon := time.Now().Add(time.Hour).Format(displayTimeFormat)
+	later := time.Now().Add(prepareAhead + time.Hour).Format(displayTimeFormat)
+	vlib := &fakePrepareLibrary{}
+	d := &daemon{
+		frontends: &fakeFrontendStore{},
+		exClient: &fakeExerciseClient{exercises: []*eproto.Exercise{
+			{Tag: "sql", Instance: []*eproto.ExerciseInstance{{Image: "registry.gitlab.com/aau/sql"}, {Image: "router.ova"}}},
+		}},
+		dbClient: &fakeBookedStore{events: []*pbc.GetEventResponse_Events{
+			{Tag: "soon", StartedAt: soon, Exercises: "sql", Frontends: "kali"},
+			{Tag: "later", StartedAt: later, Exercises: "sql", Frontends: "kali"},
+			{Tag: "cluster", StartedAt: soon, Exercises: "sql", Backend: store.KubernetesBackend},
+		}},
+		vlib:     vlib,
+		prepared: map[store.Tag]time.Time{"cancelled": time.Now()},
+	}
+
+	// booked events are prepared once
+	for i := 0; i < 2; i++ {
+		if err := d.prepareBookedEvents(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if !reflect.DeepEqual(pulled, []string{"registry.gitlab.com/aau/sql"}) {
+		t.Fatalf("expected images of the event starting soon to be pulled once, got: %v", pulled)
+	}
+	sort.Strings(vlib.prepared)
+	if !reflect.DeepEqual(vlib.prepared, []string{"kali", "router.ova"}) {
+		t.Fatalf("expected ovas of the event starting soon to be prepared once, got: %v", vlib.prepared)
+	}
+	if _, ok := d.prepared["soon"]; !ok || len(d.prepared) != 1 {
+		t.Fatalf("expected only the event starting soon to be prepared, got: %v", d.prepared)
+	}
+}
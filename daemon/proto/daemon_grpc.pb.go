@@ -25,6 +25,8 @@ type DaemonClient interface {
 	ChangeUserPasswd(ctx context.Context, in *UpdatePasswdRequest, opts ...grpc.CallOption) (*UpdatePasswdResponse, error)
 	DestroyUser(ctx context.Context, in *DestroyUserRequest, opts ...grpc.CallOption) (*DestroyUserResponse, error)
 	SetTeamSuspend(ctx context.Context, in *SetTeamSuspendRequest, opts ...grpc.CallOption) (*Empty, error)
+	CheckpointTeamLab(ctx context.Context, in *TeamCheckpointRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestoreTeamLab(ctx context.Context, in *TeamCheckpointRequest, opts ...grpc.CallOption) (*Empty, error)
 	UpdateTeamPassword(ctx context.Context, in *UpdateTeamPassRequest, opts ...grpc.CallOption) (*UpdateTeamPassResponse, error)
 	GetAPICreds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CredsResponse, error)
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (Daemon_CreateEventClient, error)
@@ -32,13 +34,16 @@ type DaemonClient interface {
 	SuspendEvent(ctx context.Context, in *SuspendEventRequest, opts ...grpc.CallOption) (Daemon_SuspendEventClient, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	ListEventTeams(ctx context.Context, in *ListEventTeamsRequest, opts ...grpc.CallOption) (*ListEventTeamsResponse, error)
+	GetEventIdlePolicy(ctx context.Context, in *EventIdlePolicyRequest, opts ...grpc.CallOption) (*IdlePolicy, error)
+	SetEventIdlePolicy(ctx context.Context, in *EventIdlePolicyRequest, opts ...grpc.CallOption) (*IdlePolicy, error)
+	GetEventDNSRecords(ctx context.Context, in *EventDNSRecordsRequest, opts ...grpc.CallOption) (*EventDNSRecords, error)
+	SetEventDNSRecords(ctx context.Context, in *EventDNSRecordsRequest, opts ...grpc.CallOption) (*EventDNSRecords, error)
 	RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error)
 	SolveChallenge(ctx context.Context, in *SolveChallengeRequest, opts ...grpc.CallOption) (*SolveChallengeResponse, error)
 	AddChallenge(ctx context.Context, in *AddChallengeRequest, opts ...grpc.CallOption) (Daemon_AddChallengeClient, error)
 	AddNotification(ctx context.Context, in *AddNotificationRequest, opts ...grpc.CallOption) (*AddNotificationResponse, error)
 	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (Daemon_DeleteTeamClient, error)
 	GetTeamChals(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*TeamChalsInfo, error)
-	StressEvent(ctx context.Context, in *TestEventLoadReq, opts ...grpc.CallOption) (*TestEventLoadResp, error)
 	ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error)
 	ResetExercise(ctx context.Context, in *ResetExerciseRequest, opts ...grpc.CallOption) (Daemon_ResetExerciseClient, error)
 	GetExercisesByTags(ctx context.Context, in *GetExsByTagsReq, opts ...grpc.CallOption) (*GetExsByTagsResp, error)
@@ -47,13 +52,28 @@ type DaemonClient interface {
 	SetFrontendMemory(ctx context.Context, in *SetFrontendMemoryRequest, opts ...grpc.CallOption) (*Empty, error)
 	SetFrontendCpu(ctx context.Context, in *SetFrontendCpuRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*GetTeamInfoResponse, error)
+	GetLabHealth(ctx context.Context, in *GetLabHealthRequest, opts ...grpc.CallOption) (*GetLabHealthResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DownloadCapture(ctx context.Context, in *DownloadCaptureRequest, opts ...grpc.CallOption) (Daemon_DownloadCaptureClient, error)
+	ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error)
+	ReplayRecording(ctx context.Context, in *ReplayRecordingRequest, opts ...grpc.CallOption) (*ReplayRecordingResponse, error)
 	MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error)
+	PrepareEvent(ctx context.Context, in *PrepareEventRequest, opts ...grpc.CallOption) (Daemon_PrepareEventClient, error)
+	PruneImages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PruneImagesResponse, error)
+	ListSubnets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSubnetsResponse, error)
 	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (Daemon_SaveProfileClient, error)
 	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (Daemon_DeleteProfileClient, error)
 	EditProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (Daemon_EditProfileClient, error)
 	ListProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	CreateTemplate(ctx context.Context, in *EventTemplate, opts ...grpc.CallOption) (*TemplateResponse, error)
+	UpdateTemplate(ctx context.Context, in *EventTemplate, opts ...grpc.CallOption) (*TemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
+	GetTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*EventTemplate, error)
+	ListTemplates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	ExportTemplates(ctx context.Context, in *ExportTemplatesRequest, opts ...grpc.CallOption) (*ExportTemplatesResponse, error)
+	ImportTemplates(ctx context.Context, in *ImportTemplatesRequest, opts ...grpc.CallOption) (*ImportTemplatesResponse, error)
 }
 
 type daemonClient struct {
@@ -127,6 +147,24 @@ func (c *daemonClient) SetTeamSuspend(ctx context.Context, in *SetTeamSuspendReq
 	return out, nil
 }
 
+func (c *daemonClient) CheckpointTeamLab(ctx context.Context, in *TeamCheckpointRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/CheckpointTeamLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RestoreTeamLab(ctx context.Context, in *TeamCheckpointRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/RestoreTeamLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) UpdateTeamPassword(ctx context.Context, in *UpdateTeamPassRequest, opts ...grpc.CallOption) (*UpdateTeamPassResponse, error) {
 	out := new(UpdateTeamPassResponse)
 	err := c.cc.Invoke(ctx, "/daemon.Daemon/UpdateTeamPassword", in, out, opts...)
@@ -259,6 +297,42 @@ func (c *daemonClient) ListEventTeams(ctx context.Context, in *ListEventTeamsReq
 	return out, nil
 }
 
+func (c *daemonClient) GetEventIdlePolicy(ctx context.Context, in *EventIdlePolicyRequest, opts ...grpc.CallOption) (*IdlePolicy, error) {
+	out := new(IdlePolicy)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/GetEventIdlePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetEventIdlePolicy(ctx context.Context, in *EventIdlePolicyRequest, opts ...grpc.CallOption) (*IdlePolicy, error) {
+	out := new(IdlePolicy)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/SetEventIdlePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetEventDNSRecords(ctx context.Context, in *EventDNSRecordsRequest, opts ...grpc.CallOption) (*EventDNSRecords, error) {
+	out := new(EventDNSRecords)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/GetEventDNSRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetEventDNSRecords(ctx context.Context, in *EventDNSRecordsRequest, opts ...grpc.CallOption) (*EventDNSRecords, error) {
+	out := new(EventDNSRecords)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/SetEventDNSRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) RestartTeamLab(ctx context.Context, in *RestartTeamLabRequest, opts ...grpc.CallOption) (Daemon_RestartTeamLabClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], "/daemon.Daemon/RestartTeamLab", opts...)
 	if err != nil {
@@ -382,15 +456,6 @@ func (c *daemonClient) GetTeamChals(ctx context.Context, in *GetTeamInfoRequest,
 	return out, nil
 }
 
-func (c *daemonClient) StressEvent(ctx context.Context, in *TestEventLoadReq, opts ...grpc.CallOption) (*TestEventLoadResp, error) {
-	out := new(TestEventLoadResp)
-	err := c.cc.Invoke(ctx, "/daemon.Daemon/StressEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
 func (c *daemonClient) ListExercises(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListExercisesResponse, error) {
 	out := new(ListExercisesResponse)
 	err := c.cc.Invoke(ctx, "/daemon.Daemon/ListExercises", in, out, opts...)
@@ -509,8 +574,76 @@ func (c *daemonClient) GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest,
 	return out, nil
 }
 
+func (c *daemonClient) GetLabHealth(ctx context.Context, in *GetLabHealthRequest, opts ...grpc.CallOption) (*GetLabHealthResponse, error) {
+	out := new(GetLabHealthResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/GetLabHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DownloadCapture(ctx context.Context, in *DownloadCaptureRequest, opts ...grpc.CallOption) (Daemon_DownloadCaptureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[8], "/daemon.Daemon/DownloadCapture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonDownloadCaptureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_DownloadCaptureClient interface {
+	Recv() (*CaptureChunk, error)
+	grpc.ClientStream
+}
+
+type daemonDownloadCaptureClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonDownloadCaptureClient) Recv() (*CaptureChunk, error) {
+	m := new(CaptureChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error) {
+	out := new(ListRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ListRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ReplayRecording(ctx context.Context, in *ReplayRecordingRequest, opts ...grpc.CallOption) (*ReplayRecordingResponse, error) {
+	out := new(ReplayRecordingResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ReplayRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) MonitorHost(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_MonitorHostClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[8], "/daemon.Daemon/MonitorHost", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[9], "/daemon.Daemon/MonitorHost", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -541,6 +674,56 @@ func (x *daemonMonitorHostClient) Recv() (*MonitorHostResponse, error) {
 	return m, nil
 }
 
+func (c *daemonClient) PrepareEvent(ctx context.Context, in *PrepareEventRequest, opts ...grpc.CallOption) (Daemon_PrepareEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[10], "/daemon.Daemon/PrepareEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonPrepareEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_PrepareEventClient interface {
+	Recv() (*PrepareEventStatus, error)
+	grpc.ClientStream
+}
+
+type daemonPrepareEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonPrepareEventClient) Recv() (*PrepareEventStatus, error) {
+	m := new(PrepareEventStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) PruneImages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PruneImagesResponse, error) {
+	out := new(PruneImagesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/PruneImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListSubnets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSubnetsResponse, error) {
+	out := new(ListSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ListSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
 	out := new(VersionResponse)
 	err := c.cc.Invoke(ctx, "/daemon.Daemon/Version", in, out, opts...)
@@ -560,7 +743,7 @@ func (c *daemonClient) ListCategories(ctx context.Context, in *Empty, opts ...gr
 }
 
 func (c *daemonClient) SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (Daemon_SaveProfileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[9], "/daemon.Daemon/SaveProfile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[11], "/daemon.Daemon/SaveProfile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -592,7 +775,7 @@ func (x *daemonSaveProfileClient) Recv() (*ProfileStatus, error) {
 }
 
 func (c *daemonClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (Daemon_DeleteProfileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[10], "/daemon.Daemon/DeleteProfile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[12], "/daemon.Daemon/DeleteProfile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -624,7 +807,7 @@ func (x *daemonDeleteProfileClient) Recv() (*ProfileStatus, error) {
 }
 
 func (c *daemonClient) EditProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (Daemon_EditProfileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[11], "/daemon.Daemon/EditProfile", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[13], "/daemon.Daemon/EditProfile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -664,6 +847,69 @@ func (c *daemonClient) ListProfiles(ctx context.Context, in *Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *daemonClient) CreateTemplate(ctx context.Context, in *EventTemplate, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/CreateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) UpdateTemplate(ctx context.Context, in *EventTemplate, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/UpdateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DeleteTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error) {
+	out := new(TemplateResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetTemplate(ctx context.Context, in *TemplateRequest, opts ...grpc.CallOption) (*EventTemplate, error) {
+	out := new(EventTemplate)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ListTemplates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ExportTemplates(ctx context.Context, in *ExportTemplatesRequest, opts ...grpc.CallOption) (*ExportTemplatesResponse, error) {
+	out := new(ExportTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ExportTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ImportTemplates(ctx context.Context, in *ImportTemplatesRequest, opts ...grpc.CallOption) (*ImportTemplatesResponse, error) {
+	out := new(ImportTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/daemon.Daemon/ImportTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServer is the server API for Daemon service.
 // All implementations must embed UnimplementedDaemonServer
 // for forward compatibility
@@ -675,6 +921,8 @@ type DaemonServer interface {
 	ChangeUserPasswd(context.Context, *UpdatePasswdRequest) (*UpdatePasswdResponse, error)
 	DestroyUser(context.Context, *DestroyUserRequest) (*DestroyUserResponse, error)
 	SetTeamSuspend(context.Context, *SetTeamSuspendRequest) (*Empty, error)
+	CheckpointTeamLab(context.Context, *TeamCheckpointRequest) (*Empty, error)
+	RestoreTeamLab(context.Context, *TeamCheckpointRequest) (*Empty, error)
 	UpdateTeamPassword(context.Context, *UpdateTeamPassRequest) (*UpdateTeamPassResponse, error)
 	GetAPICreds(context.Context, *Empty) (*CredsResponse, error)
 	CreateEvent(*CreateEventRequest, Daemon_CreateEventServer) error
@@ -682,13 +930,16 @@ type DaemonServer interface {
 	SuspendEvent(*SuspendEventRequest, Daemon_SuspendEventServer) error
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	ListEventTeams(context.Context, *ListEventTeamsRequest) (*ListEventTeamsResponse, error)
+	GetEventIdlePolicy(context.Context, *EventIdlePolicyRequest) (*IdlePolicy, error)
+	SetEventIdlePolicy(context.Context, *EventIdlePolicyRequest) (*IdlePolicy, error)
+	GetEventDNSRecords(context.Context, *EventDNSRecordsRequest) (*EventDNSRecords, error)
+	SetEventDNSRecords(context.Context, *EventDNSRecordsRequest) (*EventDNSRecords, error)
 	RestartTeamLab(*RestartTeamLabRequest, Daemon_RestartTeamLabServer) error
 	SolveChallenge(context.Context, *SolveChallengeRequest) (*SolveChallengeResponse, error)
 	AddChallenge(*AddChallengeRequest, Daemon_AddChallengeServer) error
 	AddNotification(context.Context, *AddNotificationRequest) (*AddNotificationResponse, error)
 	DeleteTeam(*DeleteTeamRequest, Daemon_DeleteTeamServer) error
 	GetTeamChals(context.Context, *GetTeamInfoRequest) (*TeamChalsInfo, error)
-	StressEvent(context.Context, *TestEventLoadReq) (*TestEventLoadResp, error)
 	ListExercises(context.Context, *Empty) (*ListExercisesResponse, error)
 	ResetExercise(*ResetExerciseRequest, Daemon_ResetExerciseServer) error
 	GetExercisesByTags(context.Context, *GetExsByTagsReq) (*GetExsByTagsResp, error)
@@ -697,13 +948,28 @@ type DaemonServer interface {
 	SetFrontendMemory(context.Context, *SetFrontendMemoryRequest) (*Empty, error)
 	SetFrontendCpu(context.Context, *SetFrontendCpuRequest) (*Empty, error)
 	GetTeamInfo(context.Context, *GetTeamInfoRequest) (*GetTeamInfoResponse, error)
+	GetLabHealth(context.Context, *GetLabHealthRequest) (*GetLabHealthResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DownloadCapture(*DownloadCaptureRequest, Daemon_DownloadCaptureServer) error
+	ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsResponse, error)
+	ReplayRecording(context.Context, *ReplayRecordingRequest) (*ReplayRecordingResponse, error)
 	MonitorHost(*Empty, Daemon_MonitorHostServer) error
+	PrepareEvent(*PrepareEventRequest, Daemon_PrepareEventServer) error
+	PruneImages(context.Context, *Empty) (*PruneImagesResponse, error)
+	ListSubnets(context.Context, *Empty) (*ListSubnetsResponse, error)
 	Version(context.Context, *Empty) (*VersionResponse, error)
 	ListCategories(context.Context, *Empty) (*ListCategoriesResponse, error)
 	SaveProfile(*SaveProfileRequest, Daemon_SaveProfileServer) error
 	DeleteProfile(*DeleteProfileRequest, Daemon_DeleteProfileServer) error
 	EditProfile(*SaveProfileRequest, Daemon_EditProfileServer) error
 	ListProfiles(context.Context, *Empty) (*ListProfilesResponse, error)
+	CreateTemplate(context.Context, *EventTemplate) (*TemplateResponse, error)
+	UpdateTemplate(context.Context, *EventTemplate) (*TemplateResponse, error)
+	DeleteTemplate(context.Context, *TemplateRequest) (*TemplateResponse, error)
+	GetTemplate(context.Context, *TemplateRequest) (*EventTemplate, error)
+	ListTemplates(context.Context, *Empty) (*ListTemplatesResponse, error)
+	ExportTemplates(context.Context, *ExportTemplatesRequest) (*ExportTemplatesResponse, error)
+	ImportTemplates(context.Context, *ImportTemplatesRequest) (*ImportTemplatesResponse, error)
 	mustEmbedUnimplementedDaemonServer()
 }
 
@@ -732,6 +998,12 @@ func (UnimplementedDaemonServer) DestroyUser(context.Context, *DestroyUserReques
 func (UnimplementedDaemonServer) SetTeamSuspend(context.Context, *SetTeamSuspendRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTeamSuspend not implemented")
 }
+func (UnimplementedDaemonServer) CheckpointTeamLab(context.Context, *TeamCheckpointRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointTeamLab not implemented")
+}
+func (UnimplementedDaemonServer) RestoreTeamLab(context.Context, *TeamCheckpointRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTeamLab not implemented")
+}
 func (UnimplementedDaemonServer) UpdateTeamPassword(context.Context, *UpdateTeamPassRequest) (*UpdateTeamPassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamPassword not implemented")
 }
@@ -753,6 +1025,18 @@ func (UnimplementedDaemonServer) ListEvents(context.Context, *ListEventsRequest)
 func (UnimplementedDaemonServer) ListEventTeams(context.Context, *ListEventTeamsRequest) (*ListEventTeamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEventTeams not implemented")
 }
+func (UnimplementedDaemonServer) GetEventIdlePolicy(context.Context, *EventIdlePolicyRequest) (*IdlePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventIdlePolicy not implemented")
+}
+func (UnimplementedDaemonServer) SetEventIdlePolicy(context.Context, *EventIdlePolicyRequest) (*IdlePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventIdlePolicy not implemented")
+}
+func (UnimplementedDaemonServer) GetEventDNSRecords(context.Context, *EventDNSRecordsRequest) (*EventDNSRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventDNSRecords not implemented")
+}
+func (UnimplementedDaemonServer) SetEventDNSRecords(context.Context, *EventDNSRecordsRequest) (*EventDNSRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventDNSRecords not implemented")
+}
 func (UnimplementedDaemonServer) RestartTeamLab(*RestartTeamLabRequest, Daemon_RestartTeamLabServer) error {
 	return status.Errorf(codes.Unimplemented, "method RestartTeamLab not implemented")
 }
@@ -771,9 +1055,6 @@ func (UnimplementedDaemonServer) DeleteTeam(*DeleteTeamRequest, Daemon_DeleteTea
 func (UnimplementedDaemonServer) GetTeamChals(context.Context, *GetTeamInfoRequest) (*TeamChalsInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTeamChals not implemented")
 }
-func (UnimplementedDaemonServer) StressEvent(context.Context, *TestEventLoadReq) (*TestEventLoadResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StressEvent not implemented")
-}
 func (UnimplementedDaemonServer) ListExercises(context.Context, *Empty) (*ListExercisesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExercises not implemented")
 }
@@ -798,9 +1079,33 @@ func (UnimplementedDaemonServer) SetFrontendCpu(context.Context, *SetFrontendCpu
 func (UnimplementedDaemonServer) GetTeamInfo(context.Context, *GetTeamInfoRequest) (*GetTeamInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTeamInfo not implemented")
 }
+func (UnimplementedDaemonServer) GetLabHealth(context.Context, *GetLabHealthRequest) (*GetLabHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabHealth not implemented")
+}
+func (UnimplementedDaemonServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedDaemonServer) DownloadCapture(*DownloadCaptureRequest, Daemon_DownloadCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadCapture not implemented")
+}
+func (UnimplementedDaemonServer) ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (UnimplementedDaemonServer) ReplayRecording(context.Context, *ReplayRecordingRequest) (*ReplayRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayRecording not implemented")
+}
 func (UnimplementedDaemonServer) MonitorHost(*Empty, Daemon_MonitorHostServer) error {
 	return status.Errorf(codes.Unimplemented, "method MonitorHost not implemented")
 }
+func (UnimplementedDaemonServer) PrepareEvent(*PrepareEventRequest, Daemon_PrepareEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method PrepareEvent not implemented")
+}
+func (UnimplementedDaemonServer) PruneImages(context.Context, *Empty) (*PruneImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneImages not implemented")
+}
+func (UnimplementedDaemonServer) ListSubnets(context.Context, *Empty) (*ListSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubnets not implemented")
+}
 func (UnimplementedDaemonServer) Version(context.Context, *Empty) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -819,6 +1124,27 @@ func (UnimplementedDaemonServer) EditProfile(*SaveProfileRequest, Daemon_EditPro
 func (UnimplementedDaemonServer) ListProfiles(context.Context, *Empty) (*ListProfilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
 }
+func (UnimplementedDaemonServer) CreateTemplate(context.Context, *EventTemplate) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedDaemonServer) UpdateTemplate(context.Context, *EventTemplate) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedDaemonServer) DeleteTemplate(context.Context, *TemplateRequest) (*TemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedDaemonServer) GetTemplate(context.Context, *TemplateRequest) (*EventTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedDaemonServer) ListTemplates(context.Context, *Empty) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedDaemonServer) ExportTemplates(context.Context, *ExportTemplatesRequest) (*ExportTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTemplates not implemented")
+}
+func (UnimplementedDaemonServer) ImportTemplates(context.Context, *ImportTemplatesRequest) (*ImportTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplates not implemented")
+}
 func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
 
 // UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
@@ -958,6 +1284,42 @@ func _Daemon_SetTeamSuspend_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_CheckpointTeamLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CheckpointTeamLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/CheckpointTeamLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CheckpointTeamLab(ctx, req.(*TeamCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RestoreTeamLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RestoreTeamLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/RestoreTeamLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RestoreTeamLab(ctx, req.(*TeamCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_UpdateTeamPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTeamPassRequest)
 	if err := dec(in); err != nil {
@@ -1093,50 +1455,122 @@ func _Daemon_ListEventTeams_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_RestartTeamLab_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RestartTeamLabRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_GetEventIdlePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventIdlePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).RestartTeamLab(m, &daemonRestartTeamLabServer{stream})
-}
-
-type Daemon_RestartTeamLabServer interface {
-	Send(*EventStatus) error
-	grpc.ServerStream
-}
-
-type daemonRestartTeamLabServer struct {
-	grpc.ServerStream
-}
-
-func (x *daemonRestartTeamLabServer) Send(m *EventStatus) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(DaemonServer).GetEventIdlePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/GetEventIdlePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetEventIdlePolicy(ctx, req.(*EventIdlePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SolveChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SolveChallengeRequest)
+func _Daemon_SetEventIdlePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventIdlePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SolveChallenge(ctx, in)
+		return srv.(DaemonServer).SetEventIdlePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/daemon.Daemon/SolveChallenge",
+		FullMethod: "/daemon.Daemon/SetEventIdlePolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SolveChallenge(ctx, req.(*SolveChallengeRequest))
+		return srv.(DaemonServer).SetEventIdlePolicy(ctx, req.(*EventIdlePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_AddChallenge_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AddChallengeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
+func _Daemon_GetEventDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetEventDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/GetEventDNSRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetEventDNSRecords(ctx, req.(*EventDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetEventDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetEventDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/SetEventDNSRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetEventDNSRecords(ctx, req.(*EventDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RestartTeamLab_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RestartTeamLabRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).RestartTeamLab(m, &daemonRestartTeamLabServer{stream})
+}
+
+type Daemon_RestartTeamLabServer interface {
+	Send(*EventStatus) error
+	grpc.ServerStream
+}
+
+type daemonRestartTeamLabServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonRestartTeamLabServer) Send(m *EventStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_SolveChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SolveChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/SolveChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SolveChallenge(ctx, req.(*SolveChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AddChallenge_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddChallengeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
 	return srv.(DaemonServer).AddChallenge(m, &daemonAddChallengeServer{stream})
 }
 
@@ -1210,24 +1644,6 @@ func _Daemon_GetTeamChals_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_StressEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TestEventLoadReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DaemonServer).StressEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/daemon.Daemon/StressEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).StressEvent(ctx, req.(*TestEventLoadReq))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
 func _Daemon_ListExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1378,6 +1794,99 @@ func _Daemon_GetTeamInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_GetLabHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetLabHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/GetLabHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetLabHealth(ctx, req.(*GetLabHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DownloadCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCaptureRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).DownloadCapture(m, &daemonDownloadCaptureServer{stream})
+}
+
+type Daemon_DownloadCaptureServer interface {
+	Send(*CaptureChunk) error
+	grpc.ServerStream
+}
+
+type daemonDownloadCaptureServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonDownloadCaptureServer) Send(m *CaptureChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ListRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListRecordings(ctx, req.(*ListRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ReplayRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ReplayRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ReplayRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ReplayRecording(ctx, req.(*ReplayRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_MonitorHost_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1399,6 +1908,63 @@ func (x *daemonMonitorHostServer) Send(m *MonitorHostResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_PrepareEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrepareEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).PrepareEvent(m, &daemonPrepareEventServer{stream})
+}
+
+type Daemon_PrepareEventServer interface {
+	Send(*PrepareEventStatus) error
+	grpc.ServerStream
+}
+
+type daemonPrepareEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonPrepareEventServer) Send(m *PrepareEventStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_PruneImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PruneImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/PruneImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PruneImages(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ListSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ListSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListSubnets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1516,6 +2082,132 @@ func _Daemon_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/CreateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CreateTemplate(ctx, req.(*EventTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/UpdateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).UpdateTemplate(ctx, req.(*EventTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DeleteTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetTemplate(ctx, req.(*TemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ListTemplates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ExportTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ExportTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ExportTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ExportTemplates(ctx, req.(*ExportTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ImportTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ImportTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daemon.Daemon/ImportTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ImportTemplates(ctx, req.(*ImportTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1551,6 +2243,14 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTeamSuspend",
 			Handler:    _Daemon_SetTeamSuspend_Handler,
 		},
+		{
+			MethodName: "CheckpointTeamLab",
+			Handler:    _Daemon_CheckpointTeamLab_Handler,
+		},
+		{
+			MethodName: "RestoreTeamLab",
+			Handler:    _Daemon_RestoreTeamLab_Handler,
+		},
 		{
 			MethodName: "UpdateTeamPassword",
 			Handler:    _Daemon_UpdateTeamPassword_Handler,
@@ -1567,6 +2267,22 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEventTeams",
 			Handler:    _Daemon_ListEventTeams_Handler,
 		},
+		{
+			MethodName: "GetEventIdlePolicy",
+			Handler:    _Daemon_GetEventIdlePolicy_Handler,
+		},
+		{
+			MethodName: "SetEventIdlePolicy",
+			Handler:    _Daemon_SetEventIdlePolicy_Handler,
+		},
+		{
+			MethodName: "GetEventDNSRecords",
+			Handler:    _Daemon_GetEventDNSRecords_Handler,
+		},
+		{
+			MethodName: "SetEventDNSRecords",
+			Handler:    _Daemon_SetEventDNSRecords_Handler,
+		},
 		{
 			MethodName: "SolveChallenge",
 			Handler:    _Daemon_SolveChallenge_Handler,
@@ -1579,10 +2295,6 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTeamChals",
 			Handler:    _Daemon_GetTeamChals_Handler,
 		},
-		{
-			MethodName: "StressEvent",
-			Handler:    _Daemon_StressEvent_Handler,
-		},
 		{
 			MethodName: "ListExercises",
 			Handler:    _Daemon_ListExercises_Handler,
@@ -1607,6 +2319,30 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTeamInfo",
 			Handler:    _Daemon_GetTeamInfo_Handler,
 		},
+		{
+			MethodName: "GetLabHealth",
+			Handler:    _Daemon_GetLabHealth_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Daemon_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "ListRecordings",
+			Handler:    _Daemon_ListRecordings_Handler,
+		},
+		{
+			MethodName: "ReplayRecording",
+			Handler:    _Daemon_ReplayRecording_Handler,
+		},
+		{
+			MethodName: "PruneImages",
+			Handler:    _Daemon_PruneImages_Handler,
+		},
+		{
+			MethodName: "ListSubnets",
+			Handler:    _Daemon_ListSubnets_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Daemon_Version_Handler,
@@ -1619,6 +2355,34 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProfiles",
 			Handler:    _Daemon_ListProfiles_Handler,
 		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _Daemon_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Daemon_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Daemon_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _Daemon_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _Daemon_ListTemplates_Handler,
+		},
+		{
+			MethodName: "ExportTemplates",
+			Handler:    _Daemon_ExportTemplates_Handler,
+		},
+		{
+			MethodName: "ImportTemplates",
+			Handler:    _Daemon_ImportTemplates_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1661,11 +2425,21 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_ResetFrontends_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DownloadCapture",
+			Handler:       _Daemon_DownloadCapture_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "MonitorHost",
 			Handler:       _Daemon_MonitorHost_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PrepareEvent",
+			Handler:       _Daemon_PrepareEvent_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SaveProfile",
 			Handler:       _Daemon_SaveProfile_Handler,
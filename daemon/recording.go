@@ -0,0 +1,233 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/guacamole"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// recordingsPath is served on the domains of events
+	recordingsPath = "/recordings/"
+	// replayTokenTTL bounds the use of the address of a replay
+	replayTokenTTL = time.Hour
+
+	replayEventKey     = "ev"
+	replayRecordingKey = "rec"
+	// replayAudience is the audience of replay tokens,
+	// tokens with an audience are rejected on login
+	replayAudience = "replay"
+)
+
+var (
+	NoPrivilegeToRecordings = errors.New("No privilege to replay the recordings of an event created by others !")
+	NoRecordingsErr         = errors.New("Connections of the event are not recorded")
+	InvalidReplayTokenErr   = errors.New("invalid or expired replay token")
+)
+
+// recordings returns the recordings of an event which
+// the user is allowed to replay
+func (d *daemon) recordings(ctx context.Context, tag string) (guacamole.Event, guacamole.RecordingPool, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	evtag, err := store.NewTag(tag)
+	if err != nil {
+		return nil, nil, err
+	}
+	ev, err := d.eventPool.GetEvent(evtag)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !user.SuperUser && ev.GetConfig().CreatedBy != user.Username {
+		return nil, nil, NoPrivilegeToRecordings
+	}
+
+	rp := ev.GetRecordings()
+	if rp == nil {
+		return nil, nil, NoRecordingsErr
+	}
+
+	return ev, rp, nil
+}
+
+// ListRecordings lists the recorded guacamole connections of the teams of an event
+func (d *daemon) ListRecordings(ctx context.Context, req *pb.ListRecordingsRequest) (*pb.ListRecordingsResponse, error) {
+	ev, rp, err := d.recordings(ctx, req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	recordings, err := rp.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*pb.ListRecordingsResponse_Recording
+	for _, r := range recordings {
+		var teamName string
+		if t, err := ev.GetTeamById(r.Team); err == nil {
+			teamName = t.Name()
+		}
+		res = append(res, &pb.ListRecordingsResponse_Recording{
+			Id:         r.ID,
+			TeamId:     r.Team,
+			TeamName:   teamName,
+			StartedAt:  r.StartedAt.Format(displayTimeFormat),
+			FinishedAt: r.FinishedAt.Format(displayTimeFormat),
+			Size:       r.Size,
+		})
+	}
+
+	return &pb.ListRecordingsResponse{Recordings: res}, nil
+}
+
+// ReplayRecording returns the address of the player of a recording, the
+// address is valid for a limited time and does not require a login
+func (d *daemon) ReplayRecording(ctx context.Context, req *pb.ReplayRecordingRequest) (*pb.ReplayRecordingResponse, error) {
+	_, rp, err := d.recordings(ctx, req.EventTag)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := rp.Open(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+
+	token, err := replayToken(replaySigningKey(d.conf.SigningKey), req.EventTag, req.Id, replayTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("event", req.EventTag).
+		Str("recording", req.Id).
+		Msg("Replaying recording")
+
+	u := d.eventURL(req.EventTag)
+	u.Path = recordingsPath + "replay"
+	u.RawQuery = url.Values{"token": {token}}.Encode()
+
+	return &pb.ReplayRecordingResponse{Url: u.String()}, nil
+}
+
+// eventURL returns the address of amigo of the event
+func (d *daemon) eventURL(tag string) url.URL {
+	host := fmt.Sprintf("%s.%s", tag, d.conf.Host.Http)
+	if d.conf.Certs.Enabled {
+		if d.conf.Port.Secure != 0 && d.conf.Port.Secure != 443 {
+			host = fmt.Sprintf("%s:%d", host, d.conf.Port.Secure)
+		}
+		return url.URL{Scheme: "https", Host: host}
+	}
+
+	if d.conf.Port.InSecure != 0 && d.conf.Port.InSecure != 80 {
+		host = fmt.Sprintf("%s:%d", host, d.conf.Port.InSecure)
+	}
+	return url.URL{Scheme: "http", Host: host}
+}
+
+// replaySigningKey derives the key of replay tokens from the signing
+// key of the daemon, such that they are not signed by the login key
+func replaySigningKey(signingKey string) []byte {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(replayAudience))
+	return mac.Sum(nil)
+}
+
+func replayToken(key []byte, tag, id string, ttl time.Duration) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		replayEventKey:     tag,
+		replayRecordingKey: id,
+		"aud":              replayAudience,
+		"exp":              time.Now().Add(ttl).Unix(),
+	})
+
+	return token.SignedString(key)
+}
+
+// parseReplayToken returns the event and the recording of a replay token
+func parseReplayToken(key []byte, token string) (string, string, error) {
+	jwtToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return key, nil
+	})
+	if err != nil {
+		return "", "", InvalidReplayTokenErr
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok || !jwtToken.Valid || !claims.VerifyAudience(replayAudience, true) {
+		return "", "", InvalidReplayTokenErr
+	}
+
+	tag, _ := claims[replayEventKey].(string)
+	id, _ := claims[replayRecordingKey].(string)
+	if tag == "" || id == "" {
+		return "", "", InvalidReplayTokenErr
+	}
+
+	return tag, id, nil
+}
+
+// serveRecording serves the player of a recording and the recording
+// itself to organizers holding a replay token of the event
+func (ep *eventPool) serveRecording(w http.ResponseWriter, r *http.Request, ev guacamole.Event) {
+	tag, id, err := parseReplayToken(ep.replayKey, r.URL.Query().Get("token"))
+	if err != nil || tag != string(ev.GetConfig().Tag) {
+		http.Error(w, InvalidReplayTokenErr.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	switch r.URL.Path {
+	case recordingsPath + "replay":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(replayPage))
+	case recordingsPath + "file":
+		rp := ev.GetRecordings()
+		if rp == nil {
+			http.Error(w, NoRecordingsErr.Error(), http.StatusNotFound)
+			return
+		}
+		f, err := rp.Open(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		defer f.Close()
+
+		// recordings are stored compressed and
+		// decompressed by the browser
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Encoding", "gzip")
+		if _, err := io.Copy(w, f); err != nil {
+			log.Warn().Str("recording", id).Msgf("Unable to serve recording: %v", err)
+		}
+	default:
+		ep.notFoundHandler.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,82 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReplayToken(t *testing.T) {
+	key := replaySigningKey("some-signing-key")
+
+	token, err := replayToken(key, "xyz", "abc", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tag, id, err := parseReplayToken(key, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag != "xyz" || id != "abc" {
+		t.Fatalf("expected event xyz and recording abc, got %s and %s", tag, id)
+	}
+
+	if _, _, err := parseReplayToken([]byte("some-signing-key"), token); err != InvalidReplayTokenErr {
+		t.Fatalf("expected error %v with the login key, got %v", InvalidReplayTokenErr, err)
+	}
+
+	expired, err := replayToken(key, "xyz", "abc", -time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := parseReplayToken(key, expired); err != InvalidReplayTokenErr {
+		t.Fatalf("expected error %v for expired token, got %v", InvalidReplayTokenErr, err)
+	}
+
+	noAudience, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		replayEventKey:     "xyz",
+		replayRecordingKey: "abc",
+	}).SignedString(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := parseReplayToken(key, noAudience); err != InvalidReplayTokenErr {
+		t.Fatalf("expected error %v for token without audience, got %v", InvalidReplayTokenErr, err)
+	}
+}
+
+func TestReplayTokenLogin(t *testing.T) {
+	u, err := store.NewUser("tkp", "", "", "", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error when creating user: %s", err)
+	}
+	auth := NewAuthenticator(store.NewUserStore([]store.User{u}), "some-signing-key")
+
+	// a replay token signed by the login key with the claims of a login
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		USERNAME_KEY:    "tkp",
+		VALID_UNTIL_KEY: time.Now().Add(time.Hour).Unix(),
+		"aud":           replayAudience,
+	}).SignedString([]byte("some-signing-key"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", token))
+	if _, err := auth.AuthenticateContext(ctx); err != InvalidTokenFormatErr {
+		t.Fatalf("expected error %v for token with audience, got %v", InvalidTokenFormatErr, err)
+	}
+
+	token, err = auth.TokenForUser("tkp", "tkptkp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", token))
+	if _, err := auth.AuthenticateContext(ctx); err != nil {
+		t.Fatalf("expected login token to be accepted, got %v", err)
+	}
+}
@@ -125,6 +125,93 @@ const (
 </g>
 </svg>
 
+</body>
+</html>`
+
+	// replayPage plays the recording of a guacamole connection,
+	// the recording is fetched with the token of the page and the
+	// player is loaded from the vendored assets of amigo
+	replayPage = `
+<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Replay &mdash; Haaukins</title>
+  <style>
+    html, body {
+      margin: 0;
+      background-color: #211a52;
+      color: #fff;
+      font-family: 'Ubuntu', sans-serif;
+    }
+    #controls {
+      display: flex;
+      align-items: center;
+      padding: 10px;
+    }
+    #seek {
+      flex-grow: 1;
+      margin: 0 10px;
+    }
+    #display {
+      margin: 0 10px;
+    }
+  </style>
+</head>
+<body>
+<div id="controls">
+  <button id="play" disabled>Play</button>
+  <input id="seek" type="range" min="0" max="0" value="0" disabled>
+  <span id="position">Loading...</span>
+</div>
+<div id="display"></div>
+<script type="module">
+  import Guacamole from '/assets/vendor/guacamole-common-js/guacamole-common.js';
+
+  const play = document.getElementById('play');
+  const seek = document.getElementById('seek');
+  const position = document.getElementById('position');
+
+  const format = (ms) => {
+    const s = Math.floor(ms / 1000);
+    return Math.floor(s / 60) + ':' + String(s % 60).padStart(2, '0');
+  };
+
+  const resp = await fetch('/recordings/file' + location.search);
+  if (!resp.ok) {
+    position.textContent = await resp.text();
+    throw new Error(resp.statusText);
+  }
+
+  const recording = new Guacamole.SessionRecording(await resp.blob());
+  document.getElementById('display').appendChild(recording.getDisplay().getElement());
+
+  const update = () => {
+    seek.value = recording.getPosition();
+    position.textContent = format(recording.getPosition()) + ' / ' + format(recording.getDuration());
+  };
+
+  recording.onload = () => {
+    seek.max = recording.getDuration();
+    play.disabled = false;
+    seek.disabled = false;
+    update();
+  };
+  recording.onseek = update;
+  recording.onplay = () => { play.textContent = 'Pause'; };
+  recording.onpause = () => { play.textContent = 'Play'; };
+
+  play.onclick = () => {
+    if (recording.isPlaying()) {
+      recording.pause();
+    } else {
+      recording.play();
+    }
+  };
+  seek.oninput = () => recording.seek(parseInt(seek.value, 10));
+
+  recording.connect();
+</script>
 </body>
 </html>`
 )
@@ -0,0 +1,54 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/network/subnet"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+// releaseStaleVPNSubnets releases the VPN subnets of events which are
+// not running, e.g. events which were closed while the daemon was down
+func (d *daemon) releaseStaleVPNSubnets() {
+	for _, al := range docker.Subnets.Allocations() {
+		if al.Kind != subnet.VPN {
+			continue
+		}
+		if _, err := d.eventPool.GetEvent(store.Tag(al.Owner)); err == nil {
+			continue
+		}
+		log.Info().Str("subnet", al.Subnet).Str("event", al.Owner).Msg("Releasing VPN subnet of stopped event")
+		docker.Subnets.Release(al.Subnet)
+	}
+}
+
+func (d *daemon) ListSubnets(ctx context.Context, req *pb.Empty) (*pb.ListSubnetsResponse, error) {
+	usr, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !usr.SuperUser {
+		return nil, fmt.Errorf("No priviledge to list subnets")
+	}
+
+	var subnets []*pb.ListSubnetsResponse_Subnet
+	for _, al := range docker.Subnets.Allocations() {
+		subnets = append(subnets, &pb.ListSubnetsResponse_Subnet{
+			Subnet:    al.Subnet,
+			Kind:      string(al.Kind),
+			Owner:     al.Owner,
+			CreatedAt: al.CreatedAt.Format(displayTimeFormat),
+			Conflict:  al.Conflict,
+		})
+	}
+
+	return &pb.ListSubnetsResponse{Subnets: subnets}, nil
+}
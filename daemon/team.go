@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -13,14 +13,9 @@ import (
 	"github.com/aau-network-security/haaukins/lab"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/svcs/guacamole"
-	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	INACTIVITY_DURATION = 8 // in hours
-)
-
 var (
 	NoFlagMngtPrivErr   = errors.New("No privilege to see/solve challenges on an event created by others !")
 	LabIsNotAssignedErr = errors.New("Lab is not assigned yet ! ")
@@ -51,8 +46,130 @@ func (d *daemon) GetTeamInfo(ctx context.Context, in *pb.GetTeamInfoRequest) (*p
 		}
 		instances = append(instances, instance)
 	}
-	return &pb.GetTeamInfoResponse{Instances: instances}, nil
 
+	resp := &pb.GetTeamInfoResponse{Instances: instances}
+	if team, err := ev.GetTeamById(in.TeamId); err == nil {
+		lastActivity, source := team.LastActivity()
+		resp.LastActivity = lastActivity.Format(displayTimeFormat)
+		resp.ActivitySource = source
+		if d, ok := team.IdleDecision(); ok {
+			resp.IdleDecision = d.String()
+		}
+		if u, ok := team.Usage(); ok {
+			resp.Usage = &pb.GetTeamInfoResponse_Usage{
+				CpuPercent:      u.Last.CPUPercent,
+				MemoryBytes:     u.Last.MemoryBytes,
+				Pids:            u.Last.Pids,
+				NetRxBytes:      u.Last.NetRxBytes,
+				NetTxBytes:      u.Last.NetTxBytes,
+				BlockRead:       u.Last.BlockRead,
+				BlockWrite:      u.Last.BlockWrite,
+				SampledAt:       u.SampledAt.Format(displayTimeFormat),
+				PeakCPUPercent:  u.PeakCPUPercent,
+				PeakMemoryBytes: u.PeakMemory,
+			}
+		}
+	}
+
+	return resp, nil
+
+}
+
+func (d *daemon) GetLabHealth(ctx context.Context, in *pb.GetLabHealthRequest) (*pb.GetLabHealthResponse, error) {
+	t, err := store.NewTag(in.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(t)
+	if err != nil {
+		return nil, err
+	}
+	lab, ok := ev.GetLabByTeam(in.TeamId)
+	if !ok {
+		return nil, UnknownTeamErr
+	}
+
+	h := lab.Health()
+	resp := &pb.GetLabHealthResponse{
+		LabTag:    h.Tag,
+		Healthy:   h.Healthy(),
+		Dns:       h.Environment.DNS,
+		Dhcp:      h.Environment.DHCP,
+		CheckedAt: h.CheckedAt.Format(displayTimeFormat),
+		Repairs:   int32(h.Repairs),
+	}
+	if !h.LastRepair.IsZero() {
+		resp.LastRepairAt = h.LastRepair.Format(displayTimeFormat)
+	}
+
+	for _, e := range h.Environment.Exercises {
+		exercise := &pb.GetLabHealthResponse_Exercise{
+			Tag:      string(e.Tag),
+			Healthy:  e.Healthy(),
+			Disabled: e.Disabled,
+		}
+		for _, i := range e.Instances {
+			exercise.Instances = append(exercise.Instances, &pb.GetTeamInfoResponse_Instance{
+				Image: i.Image,
+				Type:  i.Type,
+				Id:    i.Id,
+				State: int32(i.State),
+			})
+		}
+		resp.Exercises = append(resp.Exercises, exercise)
+	}
+
+	for _, f := range h.Frontends {
+		resp.Frontends = append(resp.Frontends, &pb.GetLabHealthResponse_Frontend{
+			Port:     uint32(f.Port),
+			State:    int32(f.State),
+			RdpAlive: f.RDPAlive,
+		})
+	}
+
+	return resp, nil
+}
+
+// ListSnapshots lists the frontend snapshots taken by a team,
+// or by all teams of the event when no team is given
+func (d *daemon) ListSnapshots(ctx context.Context, in *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
+	t, err := store.NewTag(in.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := d.eventPool.GetEvent(t)
+	if err != nil {
+		return nil, err
+	}
+
+	labs := ev.GetAssignedLabs()
+	if in.TeamId != "" {
+		l, ok := ev.GetLabByTeam(in.TeamId)
+		if !ok {
+			return nil, UnknownTeamErr
+		}
+		labs = map[string]lab.Lab{in.TeamId: l}
+	}
+
+	resp := &pb.ListSnapshotsResponse{}
+	for teamId, l := range labs {
+		for _, s := range l.Snapshots() {
+			resp.Snapshots = append(resp.Snapshots, &pb.ListSnapshotsResponse_Snapshot{
+				TeamId:    teamId,
+				Name:      s.Name,
+				CreatedAt: s.CreatedAt.Format(displayTimeFormat),
+				SizeBytes: s.Size,
+			})
+		}
+	}
+	sort.Slice(resp.Snapshots, func(i, j int) bool {
+		if resp.Snapshots[i].TeamId != resp.Snapshots[j].TeamId {
+			return resp.Snapshots[i].TeamId < resp.Snapshots[j].TeamId
+		}
+		return resp.Snapshots[i].CreatedAt < resp.Snapshots[j].CreatedAt
+	})
+
+	return resp, nil
 }
 
 func (d *daemon) SetTeamSuspend(ctx context.Context, in *pb.SetTeamSuspendRequest) (*pb.Empty, error) {
@@ -81,6 +198,48 @@ func (d *daemon) SetTeamSuspend(ctx context.Context, in *pb.SetTeamSuspendReques
 	return &pb.Empty{}, err
 }
 
+// CheckpointTeamLab saves the state of the exercise containers of the
+// lab of a team, such that it can be restored after the lab is replaced
+func (d *daemon) CheckpointTeamLab(ctx context.Context, in *pb.TeamCheckpointRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).Info().Str("team", in.TeamId).Msg("checkpoint of team lab")
+
+	ev, t, err := d.checkpointTeam(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, ev.CheckpointTeamLab(ctx, t)
+}
+
+// RestoreTeamLab restores the lab of a team from its latest checkpoint
+func (d *daemon) RestoreTeamLab(ctx context.Context, in *pb.TeamCheckpointRequest) (*pb.Empty, error) {
+	log.Ctx(ctx).Info().Str("team", in.TeamId).Msg("restoring team lab from checkpoint")
+
+	ev, t, err := d.checkpointTeam(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, ev.RestoreTeamLab(ctx, t)
+}
+
+func (d *daemon) checkpointTeam(in *pb.TeamCheckpointRequest) (guacamole.Event, *store.Team, error) {
+	tag, err := store.NewTag(in.EventTag)
+	if err != nil {
+		return nil, nil, err
+	}
+	ev, err := d.eventPool.GetEvent(tag)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, err := ev.GetTeamById(in.TeamId)
+	if err != nil {
+		return nil, nil, UnknownTeamErr
+	}
+
+	return ev, t, nil
+}
+
 func (d *daemon) RestartTeamLab(req *pb.RestartTeamLabRequest, resp pb.Daemon_RestartTeamLabServer) error {
 	log.Ctx(resp.Context()).
 		Info().
@@ -250,51 +409,95 @@ func (d *daemon) DeleteTeam(req *pb.DeleteTeamRequest, srv pb.Daemon_DeleteTeamS
 	return nil
 }
 
-func checkTeamLab(ch chan guacamole.Event, wg *sync.WaitGroup) {
-	now := time.Now().UTC()
-	defer wg.Done()
-	suspendLab := func(ev guacamole.Event) {
-		for _, t := range ev.GetTeams() {
-			difference := math.Round(now.Sub(t.LastAccessTime().UTC()).Hours()) // get in rounded format in hours
-			if difference > INACTIVITY_DURATION {
-				lab, ok := ev.GetLabByTeam(t.ID())
-				if !ok {
-					log.Error().Str("Team ID", t.ID()).
-						Str("Team Name", t.Name()).
-						Msgf("Error on team lab suspend/GetLabByTeam")
+// checkIdleTeams applies the idle policy of running events,
+// each event is checked within the interval of its own policy
+func (d *daemon) checkIdleTeams() error {
+	var wg sync.WaitGroup
+	now := time.Now()
+	if d.idleChecks == nil {
+		d.idleChecks = map[store.Tag]time.Time{}
+	}
+	for _, ev := range d.eventPool.GetAllEvents() {
+		if ev.GetStatus() != Running {
+			continue
+		}
+		tag := ev.GetConfig().Tag
+		if last, ok := d.idleChecks[tag]; ok && now.Sub(last) < ev.GetIdlePolicy().CheckInterval {
+			continue
+		}
+		d.idleChecks[tag] = now
+
+		wg.Add(1)
+		go func(ev guacamole.Event) {
+			defer wg.Done()
+			ev.CheckIdleTeams(context.Background())
+		}(ev)
+	}
+	wg.Wait()
+	return nil
+}
+
+// checkLabsHealth probes the labs assigned to teams in running events
+// and repairs the ones which are unhealthy, labs which cannot be
+// repaired are replaced by a lab from the hub
+func (d *daemon) checkLabsHealth() error {
+	var wg sync.WaitGroup
+	for _, ev := range d.eventPool.GetAllEvents() {
+		if ev.GetStatus() != Running {
+			continue
+		}
+		for teamId, l := range ev.GetAssignedLabs() {
+			// labs of idle teams are stopped on purpose
+			if t, err := ev.GetTeamById(teamId); err == nil && t.IsIdle() {
+				continue
+			}
+			wg.Add(1)
+			go func(ev guacamole.Event, teamId string, l lab.Lab) {
+				defer wg.Done()
+				if l.Health().Healthy() {
+					return
 				}
-				log.Info().Msgf("Suspending resources for team %s", t.Name())
-				// check if lab might be nil !
-				if lab != nil {
-					// check if it is already suspended or not.
-					for _, instanceInfo := range lab.InstanceInfo() {
-						if instanceInfo.State != virtual.Suspended {
-							if err := lab.Suspend(context.Background()); err != nil {
-								log.Error().Msgf("Error on team lab suspend: %v", err)
-							}
-						}
+				log.Warn().Str("team", teamId).Str("lab", l.Tag()).Msg("Lab is unhealthy, repairing")
+				if _, err := l.Repair(context.Background()); err != nil {
+					log.Error().Str("team", teamId).Str("lab", l.Tag()).Msgf("Unable to repair lab, replacing it: %v", err)
+					t, err := ev.GetTeamById(teamId)
+					if err != nil {
+						return
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), labReplaceTimeout)
+					defer cancel()
+					if err := ev.ReplaceLab(ctx, t); err != nil {
+						log.Error().Str("team", teamId).Str("lab", l.Tag()).Msgf("Unable to replace lab: %v", err)
 					}
 				}
-			}
+			}(ev, teamId, l)
 		}
 	}
-	select {
-	case ev := <-ch:
-		suspendLab(ev)
-	}
+	wg.Wait()
+	return nil
 }
 
-// suspendTeams function will check all teams in all events
-// then suspend their resources if lastAccessTime is higher than INACTIVITY_DURATION
-func (d *daemon) suspendTeams() error {
+// sampleLabsUsage stores the resources used by the
+// assigned labs of running events on their teams
+func (d *daemon) sampleLabsUsage() error {
 	var wg sync.WaitGroup
-	log.Info().Msg("Suspend teams called ! ")
-	events := d.eventPool.GetAllEvents()
-	ch := make(chan guacamole.Event, len(events))
-	for _, ev := range events {
-		go processEvent(ev, ch)
-		wg.Add(1)
-		go checkTeamLab(ch, &wg)
+	for _, ev := range d.eventPool.GetAllEvents() {
+		if ev.GetStatus() != Running {
+			continue
+		}
+		for teamId, l := range ev.GetAssignedLabs() {
+			t, err := ev.GetTeamById(teamId)
+			if err != nil {
+				continue
+			}
+			wg.Add(1)
+			go func(t *store.Team, l lab.Lab) {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), labUsageInterval)
+				defer cancel()
+				t.RecordUsage(l.Usage(ctx), time.Now())
+			}(t, l)
+		}
 	}
 	wg.Wait()
 	return nil
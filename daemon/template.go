@@ -0,0 +1,330 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	NoPrivilegeToCreateTemplate = errors.New("You don't have the privilege to create templates")
+	NoPrivilegeToChangeTemplate = errors.New("No privilege to change a template created by others !")
+)
+
+func (d *daemon) CreateTemplate(ctx context.Context, req *pb.EventTemplate) (*pb.TemplateResponse, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return &pb.TemplateResponse{}, NoUserInformation
+	}
+	if user.NPUser {
+		return &pb.TemplateResponse{}, NoPrivilegeToCreateTemplate
+	}
+
+	t := templateFromProto(req)
+	t.CreatedBy = user.Username
+	if err := d.templates.CreateTemplate(t); err != nil {
+		return &pb.TemplateResponse{}, err
+	}
+
+	log.Info().Str("template", t.Name).Str("user", user.Username).Msg("Created event template")
+	return &pb.TemplateResponse{Name: t.Name, Message: "Template created"}, nil
+}
+
+func (d *daemon) UpdateTemplate(ctx context.Context, req *pb.EventTemplate) (*pb.TemplateResponse, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return &pb.TemplateResponse{}, NoUserInformation
+	}
+	if err := d.canChangeTemplate(user, req.Name); err != nil {
+		return &pb.TemplateResponse{}, err
+	}
+
+	t := templateFromProto(req)
+	if err := d.templates.UpdateTemplate(t); err != nil {
+		return &pb.TemplateResponse{}, err
+	}
+
+	log.Info().Str("template", t.Name).Str("user", user.Username).Msg("Updated event template")
+	return &pb.TemplateResponse{Name: t.Name, Message: "Template updated"}, nil
+}
+
+func (d *daemon) DeleteTemplate(ctx context.Context, req *pb.TemplateRequest) (*pb.TemplateResponse, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return &pb.TemplateResponse{}, NoUserInformation
+	}
+	if err := d.canChangeTemplate(user, req.Name); err != nil {
+		return &pb.TemplateResponse{}, err
+	}
+
+	if err := d.templates.DeleteTemplate(req.Name); err != nil {
+		return &pb.TemplateResponse{}, err
+	}
+
+	log.Info().Str("template", req.Name).Str("user", user.Username).Msg("Deleted event template")
+	return &pb.TemplateResponse{Name: req.Name, Message: "Template deleted"}, nil
+}
+
+func (d *daemon) GetTemplate(ctx context.Context, req *pb.TemplateRequest) (*pb.EventTemplate, error) {
+	if _, err := getUserFromIncomingContext(ctx); err != nil {
+		return &pb.EventTemplate{}, NoUserInformation
+	}
+
+	t, err := d.templates.GetTemplate(req.Name)
+	if err != nil {
+		return &pb.EventTemplate{}, err
+	}
+
+	return templateToProto(t), nil
+}
+
+func (d *daemon) ListTemplates(ctx context.Context, req *pb.Empty) (*pb.ListTemplatesResponse, error) {
+	if _, err := getUserFromIncomingContext(ctx); err != nil {
+		return &pb.ListTemplatesResponse{}, NoUserInformation
+	}
+
+	var templates []*pb.EventTemplate
+	for _, t := range d.templates.ListTemplates() {
+		templates = append(templates, templateToProto(t))
+	}
+
+	return &pb.ListTemplatesResponse{Templates: templates}, nil
+}
+
+// ExportTemplates returns templates as yaml, information on
+// who created the templates and when is left out
+func (d *daemon) ExportTemplates(ctx context.Context, req *pb.ExportTemplatesRequest) (*pb.ExportTemplatesResponse, error) {
+	if _, err := getUserFromIncomingContext(ctx); err != nil {
+		return &pb.ExportTemplatesResponse{}, NoUserInformation
+	}
+
+	var templates []store.EventTemplate
+	if len(req.Names) == 0 {
+		templates = d.templates.ListTemplates()
+	}
+	for _, name := range req.Names {
+		t, err := d.templates.GetTemplate(name)
+		if err != nil {
+			return &pb.ExportTemplatesResponse{}, fmt.Errorf("%s: %v", name, err)
+		}
+		templates = append(templates, t)
+	}
+	if len(templates) == 0 {
+		return &pb.ExportTemplatesResponse{}, store.NoTemplatesErr
+	}
+
+	for i := range templates {
+		templates[i] = templates[i].Export()
+	}
+	b, err := store.MarshalTemplates(templates...)
+	if err != nil {
+		return &pb.ExportTemplatesResponse{}, err
+	}
+
+	return &pb.ExportTemplatesResponse{Yaml: string(b)}, nil
+}
+
+// ImportTemplates creates the templates given as yaml, existing
+// templates are only updated when overwrite is requested
+func (d *daemon) ImportTemplates(ctx context.Context, req *pb.ImportTemplatesRequest) (*pb.ImportTemplatesResponse, error) {
+	user, err := getUserFromIncomingContext(ctx)
+	if err != nil {
+		return &pb.ImportTemplatesResponse{}, NoUserInformation
+	}
+	if user.NPUser {
+		return &pb.ImportTemplatesResponse{}, NoPrivilegeToCreateTemplate
+	}
+
+	templates, err := store.UnmarshalTemplates([]byte(req.Yaml))
+	if err != nil {
+		return &pb.ImportTemplatesResponse{}, err
+	}
+
+	// validate all templates before storing any of them
+	for _, t := range templates {
+		if err := t.Validate(); err != nil {
+			return &pb.ImportTemplatesResponse{}, fmt.Errorf("%s: %v", t.Name, err)
+		}
+		if _, err := d.templates.GetTemplate(t.Name); err == nil {
+			if !req.Overwrite {
+				return &pb.ImportTemplatesResponse{}, fmt.Errorf("%s: %v", t.Name, store.TemplateExistsErr)
+			}
+			if err := d.canChangeTemplate(user, t.Name); err != nil {
+				return &pb.ImportTemplatesResponse{}, fmt.Errorf("%s: %v", t.Name, err)
+			}
+		}
+	}
+
+	resp := &pb.ImportTemplatesResponse{}
+	for _, t := range templates {
+		t.CreatedBy = user.Username
+		if prev, err := d.templates.GetTemplate(t.Name); err == nil {
+			// overwriting a template does not take it over
+			t.CreatedBy = prev.CreatedBy
+			if err := d.templates.UpdateTemplate(t); err != nil {
+				return resp, fmt.Errorf("%s: %v", t.Name, err)
+			}
+			resp.Updated = append(resp.Updated, t.Name)
+			continue
+		}
+		if err := d.templates.CreateTemplate(t); err != nil {
+			return resp, fmt.Errorf("%s: %v", t.Name, err)
+		}
+		resp.Created = append(resp.Created, t.Name)
+	}
+
+	log.Info().Strs("created", resp.Created).Strs("updated", resp.Updated).
+		Str("user", user.Username).Msg("Imported event templates")
+	return resp, nil
+}
+
+// canChangeTemplate allows super users and the creator
+// of the template to change it
+func (d *daemon) canChangeTemplate(user *store.User, name string) error {
+	t, err := d.templates.GetTemplate(name)
+	if err != nil {
+		return err
+	}
+	if user.NPUser || (!user.SuperUser && t.CreatedBy != user.Username) {
+		return NoPrivilegeToChangeTemplate
+	}
+	return nil
+}
+
+// applyTemplate fills the fields of the request which
+// are not set with the values of the template
+func (d *daemon) applyTemplate(req *pb.CreateEventRequest) error {
+	t, err := d.templates.GetTemplate(req.Template)
+	if err != nil {
+		return fmt.Errorf("%s: %v", req.Template, err)
+	}
+
+	if req.Name == "" {
+		req.Name = t.EventName
+	}
+	if len(req.Frontends) == 0 {
+		req.Frontends = t.Frontends
+	}
+	if len(req.Exercises) == 0 {
+		req.Exercises = t.Exercises
+	}
+	if len(req.DisableExercises) == 0 {
+		req.DisableExercises = t.DisabledExercises
+	}
+	if req.Available == 0 {
+		req.Available = int32(t.Available)
+	}
+	if req.Capacity == 0 {
+		req.Capacity = int32(t.Capacity)
+	}
+	if req.OnlyVPN == 0 {
+		req.OnlyVPN = t.OnlyVPN
+	}
+	if req.SecretEvent == "" {
+		req.SecretEvent = t.SecretKey
+	}
+	if p := req.IdlePolicy; p == nil || (p.ThresholdMinutes == 0 &&
+		p.CheckIntervalMinutes == 0 && p.Action == "" && len(p.ExemptTeams) == 0) {
+		req.IdlePolicy = idlePolicyToProto(t.IdlePolicy)
+	}
+	if req.Backend == "" {
+		req.Backend = t.Backend
+	}
+	if req.VpnProfiles == 0 {
+		req.VpnProfiles = int32(t.VPNProfiles)
+	}
+	if p := req.EgressPolicy; p == nil || (!p.BlockInternet && !p.Isolate && len(p.Allow) == 0) {
+		req.EgressPolicy = egressPolicyToProto(t.Egress)
+	}
+	if !req.Ipv6 {
+		req.Ipv6 = t.IPv6
+	}
+	if !req.PortForward {
+		req.PortForward = t.PortForward
+	}
+	if p := req.RecordingPolicy; p == nil || (!p.Enabled && p.RetentionHours == 0) {
+		req.RecordingPolicy = recordingPolicyToProto(t.Recording)
+	}
+	if !req.Capture {
+		req.Capture = t.Capture
+	}
+	if len(req.TerminalExercises) == 0 {
+		// only exercises which are part of the event
+		// can be offered in the terminal
+		for _, e := range t.TerminalExercises {
+			if stringIn(e, req.Exercises) {
+				req.TerminalExercises = append(req.TerminalExercises, e)
+			}
+		}
+	}
+
+	return nil
+}
+
+func templateFromProto(t *pb.EventTemplate) store.EventTemplate {
+	return store.EventTemplate{
+		Name:              t.Name,
+		Description:       t.Description,
+		EventName:         t.EventName,
+		Frontends:         t.Frontends,
+		Exercises:         t.Exercises,
+		DisabledExercises: t.DisableExercises,
+		Available:         int(t.Available),
+		Capacity:          int(t.Capacity),
+		OnlyVPN:           t.OnlyVPN,
+		SecretKey:         t.SecretEvent,
+		IdlePolicy:        idlePolicyFromProto(t.IdlePolicy),
+		Backend:           t.Backend,
+		VPNProfiles:       int(t.VpnProfiles),
+		Egress:            egressPolicyFromProto(t.EgressPolicy),
+		IPv6:              t.Ipv6,
+		PortForward:       t.PortForward,
+		TerminalExercises: t.TerminalExercises,
+		Recording:         recordingPolicyFromProto(t.RecordingPolicy),
+		Capture:           t.Capture,
+	}
+}
+
+func templateToProto(t store.EventTemplate) *pb.EventTemplate {
+	return &pb.EventTemplate{
+		Name:              t.Name,
+		Description:       t.Description,
+		EventName:         t.EventName,
+		Frontends:         t.Frontends,
+		Exercises:         t.Exercises,
+		DisableExercises:  t.DisabledExercises,
+		Available:         int32(t.Available),
+		Capacity:          int32(t.Capacity),
+		OnlyVPN:           t.OnlyVPN,
+		SecretEvent:       t.SecretKey,
+		IdlePolicy:        idlePolicyToProto(t.IdlePolicy),
+		Backend:           t.Backend,
+		VpnProfiles:       int32(t.VPNProfiles),
+		EgressPolicy:      egressPolicyToProto(t.Egress),
+		Ipv6:              t.IPv6,
+		PortForward:       t.PortForward,
+		TerminalExercises: t.TerminalExercises,
+		RecordingPolicy:   recordingPolicyToProto(t.Recording),
+		Capture:           t.Capture,
+		CreatedBy:         t.CreatedBy,
+		CreatedAt:         t.CreatedAt.Format(displayTimeFormat),
+		UpdatedAt:         t.UpdatedAt.Format(displayTimeFormat),
+	}
+}
+
+func stringIn(s string, ss []string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
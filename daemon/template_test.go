@@ -0,0 +1,232 @@
+package daemon
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/daemon/proto"
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	d := &daemon{
+		templates: store.NewTemplateStore([]store.EventTemplate{
+			{
+				Name:              "course-intro",
+				EventName:         "Introduction Course",
+				Frontends:         []string{"kali"},
+				Exercises:         []string{"scan", "sql"},
+				DisabledExercises: []string{"sql"},
+				Available:         5,
+				Capacity:          20,
+				OnlyVPN:           VPNBrowser,
+				IdlePolicy:        store.IdlePolicy{Action: store.IdleStop},
+				Backend:           store.KubernetesBackend,
+				VPNProfiles:       2,
+				Egress:            store.EgressPolicy{BlockInternet: true, Allow: []string{"github.com"}},
+				IPv6:              true,
+				PortForward:       true,
+				TerminalExercises: []string{"scan"},
+				Recording:         store.RecordingPolicy{Enabled: true, Retention: 48 * time.Hour},
+				Capture:           true,
+			},
+		}),
+	}
+
+	tt := []struct {
+		name     string
+		req      *pb.CreateEventRequest
+		expected *pb.CreateEventRequest
+		err      bool
+	}{
+		{
+			name: "Template only",
+			req:  &pb.CreateEventRequest{Tag: "xyz", Template: "course-intro"},
+			expected: &pb.CreateEventRequest{
+				Name:              "Introduction Course",
+				Tag:               "xyz",
+				Frontends:         []string{"kali"},
+				Exercises:         []string{"scan", "sql"},
+				DisableExercises:  []string{"sql"},
+				Available:         5,
+				Capacity:          20,
+				OnlyVPN:           VPNBrowser,
+				IdlePolicy:        &pb.IdlePolicy{Action: string(store.IdleStop)},
+				Template:          "course-intro",
+				Backend:           store.KubernetesBackend,
+				VpnProfiles:       2,
+				EgressPolicy:      &pb.EgressPolicy{BlockInternet: true, Allow: []string{"github.com"}},
+				Ipv6:              true,
+				PortForward:       true,
+				TerminalExercises: []string{"scan"},
+				RecordingPolicy:   &pb.RecordingPolicy{Enabled: true, RetentionHours: 48},
+				Capture:           true,
+			},
+		},
+		{
+			name: "Request overrides template",
+			req: &pb.CreateEventRequest{
+				Name:            "Spring Course",
+				Tag:             "xyz",
+				Capacity:        40,
+				Exercises:       []string{"hb"},
+				IdlePolicy:      &pb.IdlePolicy{ThresholdMinutes: 60},
+				Template:        "course-intro",
+				Backend:         store.DockerBackend,
+				VpnProfiles:     1,
+				EgressPolicy:    &pb.EgressPolicy{Isolate: true},
+				RecordingPolicy: &pb.RecordingPolicy{Enabled: true},
+			},
+			expected: &pb.CreateEventRequest{
+				Name:             "Spring Course",
+				Tag:              "xyz",
+				Frontends:        []string{"kali"},
+				Exercises:        []string{"hb"},
+				DisableExercises: []string{"sql"},
+				Available:        5,
+				Capacity:         40,
+				OnlyVPN:          VPNBrowser,
+				IdlePolicy:       &pb.IdlePolicy{ThresholdMinutes: 60},
+				Template:         "course-intro",
+				Backend:          store.DockerBackend,
+				VpnProfiles:      1,
+				EgressPolicy:     &pb.EgressPolicy{Isolate: true},
+				Ipv6:             true,
+				PortForward:      true,
+				RecordingPolicy:  &pb.RecordingPolicy{Enabled: true},
+				Capture:          true,
+			},
+		},
+		{
+			name: "Unknown template",
+			req:  &pb.CreateEventRequest{Tag: "xyz", Template: "unknown"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.req
+			err := d.applyTemplate(req)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error (%t), but got: %v", tc.err, err)
+			}
+			if tc.err {
+				return
+			}
+
+			if req.Name != tc.expected.Name ||
+				req.Available != tc.expected.Available ||
+				req.Capacity != tc.expected.Capacity ||
+				req.OnlyVPN != tc.expected.OnlyVPN ||
+				req.Backend != tc.expected.Backend ||
+				req.VpnProfiles != tc.expected.VpnProfiles ||
+				req.Ipv6 != tc.expected.Ipv6 ||
+				req.PortForward != tc.expected.PortForward ||
+				req.Capture != tc.expected.Capture ||
+				!reflect.DeepEqual(req.Frontends, tc.expected.Frontends) ||
+				!reflect.DeepEqual(req.Exercises, tc.expected.Exercises) ||
+				!reflect.DeepEqual(req.DisableExercises, tc.expected.DisableExercises) ||
+				!reflect.DeepEqual(req.TerminalExercises, tc.expected.TerminalExercises) {
+				t.Fatalf("expected request %v, but got %v", tc.expected.String(), req.String())
+			}
+			if req.IdlePolicy.ThresholdMinutes != tc.expected.IdlePolicy.ThresholdMinutes ||
+				req.IdlePolicy.Action != tc.expected.IdlePolicy.Action {
+				t.Fatalf("expected idle policy %v, but got %v", tc.expected.IdlePolicy, req.IdlePolicy)
+			}
+			if !reflect.DeepEqual(egressPolicyFromProto(req.EgressPolicy), egressPolicyFromProto(tc.expected.EgressPolicy)) {
+				t.Fatalf("expected egress policy %v, but got %v", tc.expected.EgressPolicy, req.EgressPolicy)
+			}
+			if req.RecordingPolicy.Enabled != tc.expected.RecordingPolicy.Enabled ||
+				req.RecordingPolicy.RetentionHours != tc.expected.RecordingPolicy.RetentionHours {
+				t.Fatalf("expected recording policy %v, but got %v", tc.expected.RecordingPolicy, req.RecordingPolicy)
+			}
+		})
+	}
+}
+
+func TestTemplateProto(t *testing.T) {
+	tmpl := store.EventTemplate{
+		Name:              "course-intro",
+		Description:       "Introduction to security",
+		EventName:         "Introduction Course",
+		Frontends:         []string{"kali"},
+		Exercises:         []string{"scan", "sql"},
+		DisabledExercises: []string{"sql"},
+		Available:         5,
+		Capacity:          20,
+		OnlyVPN:           VPNBrowser,
+		SecretKey:         "secret",
+		IdlePolicy:        store.IdlePolicy{Threshold: time.Hour, Action: store.IdleStop},
+		Backend:           store.KubernetesBackend,
+		VPNProfiles:       2,
+		Egress:            store.EgressPolicy{BlockInternet: true, Allow: []string{"github.com"}, Isolate: true},
+		IPv6:              true,
+		PortForward:       true,
+		TerminalExercises: []string{"scan"},
+		Recording:         store.RecordingPolicy{Enabled: true, Retention: 48 * time.Hour},
+		Capture:           true,
+	}
+
+	if got := templateFromProto(templateToProto(tmpl)); !reflect.DeepEqual(got, tmpl) {
+		t.Fatalf("expected template %+v, but got %+v", tmpl, got)
+	}
+}
+
+func TestImportTemplates(t *testing.T) {
+	d := &daemon{
+		templates: store.NewTemplateStore([]store.EventTemplate{
+			{Name: "course-intro", Capacity: 10, CreatedBy: "other"},
+		}),
+	}
+	ctx := context.WithValue(context.Background(), us{}, store.User{Username: "tkp"})
+	input := `
+templates:
+- name: course-intro
+  capacity: 30
+- name: ctf
+  capacity: 50
+`
+
+	if _, err := d.ImportTemplates(ctx, &pb.ImportTemplatesRequest{Yaml: input}); err == nil {
+		t.Fatalf("expected error when importing existing template without overwrite")
+	}
+	if _, err := d.templates.GetTemplate("ctf"); err == nil {
+		t.Fatalf("expected no templates to be imported when import fails")
+	}
+
+	_, err := d.ImportTemplates(ctx, &pb.ImportTemplatesRequest{Yaml: input, Overwrite: true})
+	if err == nil || !strings.Contains(err.Error(), NoPrivilegeToChangeTemplate.Error()) {
+		t.Fatalf("expected privilege error when overwriting template of others, got: %v", err)
+	}
+
+	ctx = context.WithValue(context.Background(), us{}, store.User{Username: "tkp", SuperUser: true})
+	resp, err := d.ImportTemplates(ctx, &pb.ImportTemplatesRequest{Yaml: input, Overwrite: true})
+	if err != nil {
+		t.Fatalf("expected no error on import, got: %v", err)
+	}
+	if !reflect.DeepEqual(resp.Created, []string{"ctf"}) || !reflect.DeepEqual(resp.Updated, []string{"course-intro"}) {
+		t.Fatalf("unexpected import result: %v", resp)
+	}
+	if tmpl, _ := d.templates.GetTemplate("course-intro"); tmpl.CreatedBy != "other" || tmpl.Capacity != 30 {
+		t.Fatalf("expected overwritten template to keep its creator, got: %+v", tmpl)
+	}
+	if tmpl, _ := d.templates.GetTemplate("ctf"); tmpl.CreatedBy != "tkp" {
+		t.Fatalf("expected imported template to be created by importer, got: %+v", tmpl)
+	}
+
+	exported, err := d.ExportTemplates(ctx, &pb.ExportTemplatesRequest{Names: []string{"ctf"}})
+	if err != nil {
+		t.Fatalf("expected no error on export, got: %v", err)
+	}
+	templates, err := store.UnmarshalTemplates([]byte(exported.Yaml))
+	if err != nil {
+		t.Fatalf("unable to read exported templates: %v", err)
+	}
+	if len(templates) != 1 || templates[0].Capacity != 50 || templates[0].CreatedBy != "" {
+		t.Fatalf("unexpected exported templates: %+v", templates)
+	}
+}
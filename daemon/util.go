@@ -6,6 +6,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/aau-network-security/haaukins/bundle"
+	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/rs/zerolog"
@@ -119,6 +121,14 @@ func (d *daemon) ReloadConfig(confFile *string) error {
 		return err
 	}
 	d.conf = conf
+
+	// exercises of bundles imported since the start become available
+	if ec, ok := d.exClient.(*bundle.ExerciseClient); ok {
+		if _, err := ec.UpdateStatus(context.Background(), &eproto.Empty{}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
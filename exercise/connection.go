@@ -0,0 +1,92 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package exercise
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	defaultSSHUser = "hkn"
+	sshKeyBits     = 2048
+)
+
+// Connection is an SSH or VNC server of an exercise instance
+// together with the credentials generated for the team of the lab
+type Connection struct {
+	Tag        store.Tag
+	Protocol   string
+	IP         string
+	Port       uint
+	Username   string
+	Password   string
+	PrivateKey string
+
+	authorizedKey string
+}
+
+func newConnection(tag store.Tag, conf store.ConnectionConfig) (*Connection, error) {
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &Connection{
+		Tag:      tag,
+		Protocol: conf.Protocol,
+		Port:     conf.Port,
+		Password: strings.Replace(uuid.New().String(), "-", "", -1),
+	}
+
+	if conf.Protocol != store.SSHProtocol {
+		return c, nil
+	}
+
+	c.Username = conf.Username
+	if c.Username == "" {
+		c.Username = defaultSSHUser
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, sshKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	c.PrivateKey = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+	c.authorizedKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+
+	return c, nil
+}
+
+// envVars are handed to the instance, such that
+// it can set up the credentials of the connection
+func (c *Connection) envVars() map[string]string {
+	if c.Protocol == store.SSHProtocol {
+		return map[string]string{
+			"HKN_SSH_USER":           c.Username,
+			"HKN_SSH_PASSWORD":       c.Password,
+			"HKN_SSH_AUTHORIZED_KEY": c.authorizedKey,
+		}
+	}
+
+	return map[string]string{
+		"HKN_VNC_PASSWORD": c.Password,
+	}
+}
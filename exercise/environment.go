@@ -8,11 +8,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"strings"
 	"sync"
 
+	"github.com/aau-network-security/haaukins/network/capture"
 	"github.com/aau-network-security/haaukins/network/dhcp"
 	"github.com/aau-network-security/haaukins/network/dns"
+	"github.com/aau-network-security/haaukins/network/firewall"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
@@ -26,23 +29,43 @@ type Environment interface {
 	ResetByTag(context.Context, string) error
 	Reset(context.Context) error
 	NetworkInterface() string
+	Network() docker.Network
+	LabRoutes() []docker.Route
+	InstanceAddrs(except []store.Tag) []string
 	LabSubnet() string
+	LabSubnet6() string
 	LabDNS() string
 	DNSRecords() []*DNSRecord
+	Connections() []Connection
+	Services() []Service
 	Challenges() []store.Challenge
 	InstanceInfo() []virtual.InstanceInfo
+	Usage(context.Context) virtual.Usage
+	Health() Health
+	SetCapture(capture.Config)
+	CaptureConfig() *capture.Config
+	StartCapture() error
+	EnableIPv6()
+	SetLabSubnet(string)
+	InstanceEgress() []firewall.InstanceRule
+	SetDNSRecords(context.Context, []store.RecordConfig) error
 	Start(context.Context) error
 	StartByTag(context.Context, string) error
 	StopByTag(string) error
 	Stop() error
 	Suspend(ctx context.Context) error
 	Resume(ctx context.Context) error
+	Checkpoint(ctx context.Context) (store.Checkpoint, error)
+	Restore(ctx context.Context, c store.Checkpoint) error
 	SetDisabledExercises([]store.Tag)
 	io.Closer
 }
 
 type DNSRecord struct {
 	Record map[string]string
+	// Network is the network of the address of the record,
+	// which is the lab network or a segment of an exercise
+	Network string
 }
 
 type environment struct {
@@ -50,12 +73,19 @@ type environment struct {
 	exercises         []*exercise
 	disabledExercises []store.Tag // this is parent tag of exercises to be disabled
 	dnsrecords        []*DNSRecord
+	customRecords     []store.RecordConfig
 	network           docker.Network
 	dnsServer         *dns.Server
 	dhcpServer        *dhcp.Server
+	advertiser        *dhcp.Advertiser
 	dnsAddr           string
 	lib               vbox.Library
 	isVPN             bool
+	ipv6              bool
+	labSubnet         string
+	captureConf       *capture.Config
+	capture           *capture.Capture
+	labRoutes         []docker.Route
 }
 
 func NewEnvironment(lib vbox.Library) Environment {
@@ -70,13 +100,62 @@ func (ee *environment) SetDisabledExercises(disabledExs []store.Tag) {
 	ee.disabledExercises = disabledExs
 }
 
+// SetCapture enables the capture of the lab network traffic
+// into the directory of the config once it is started
+func (ee *environment) SetCapture(conf capture.Config) {
+	ee.captureConf = &conf
+}
+
+// StartCapture starts the capture of the lab network traffic when
+// it is enabled, labs are only captured once they are assigned to
+// a team and the capture is bounded by its maximum duration, hence
+// it is not restarted when the lab is restarted
+func (ee *environment) StartCapture() error {
+	if ee.captureConf == nil || ee.capture != nil {
+		return nil
+	}
+
+	c, err := capture.Start(ee.network.Interface(), *ee.captureConf)
+	if err != nil {
+		return err
+	}
+	ee.capture = c
+
+	return nil
+}
+
+// CaptureConfig is the config of the capture of the lab
+// network, which is nil when the traffic is not captured
+func (ee *environment) CaptureConfig() *capture.Config {
+	return ee.captureConf
+}
+
+// EnableIPv6 makes the lab network dual-stack when
+// it is called before the environment is created
+func (ee *environment) EnableIPv6() {
+	ee.ipv6 = true
+}
+
+// SetLabSubnet makes the lab network use the given subnet instead of
+// an allocated one, when it is called before the environment is created
+func (ee *environment) SetLabSubnet(subnet string) {
+	ee.labSubnet = subnet
+}
+
 func (ee *environment) Create(ctx context.Context, isVPN int32) error {
-	network, err := docker.NewNetwork(isVPN)
+	var network docker.Network
+	var err error
+	if ee.labSubnet != "" {
+		network, err = docker.NewNetworkWithSubnet(isVPN, ee.ipv6, ee.labSubnet)
+	} else {
+		network, err = docker.NewNetwork(isVPN, ee.ipv6)
+	}
 	if err != nil {
 		return fmt.Errorf("docker new network err %v", err)
 	}
 	ee.network = network
 	ee.network.SetIsVPN(isVPN)
+	ee.isVPN = isVPN == docker.OnlyVPN || isVPN == docker.VPNBrowser
 	ee.dnsAddr = ee.network.FormatIP(dns.PreferedIP)
 
 	return nil
@@ -104,13 +183,19 @@ func (ee *environment) Add(ctx context.Context, confs ...store.Exercise) error {
 				Bool("Is Secret", conf.Secret).
 				Msgf("Configuring the static challenge")
 		} else {
+			if err := conf.Validate(); err != nil {
+				return err
+			}
 			e = NewExercise(conf, dockerHost{}, ee.lib, ee.network, ee.dnsAddr)
+			if err := e.createSegments(conf.Networks); err != nil {
+				return err
+			}
 			if err := e.Create(ctx); err != nil {
 				return err
 			}
-			ip := strings.Split(e.dnsAddr, ".")
 
 			for i, c := range e.containerOpts {
+				network := primaryNetwork(c)
 				for _, r := range c.Records {
 					if strings.Contains(c.DockerConf.Image, "client") {
 						continue
@@ -118,8 +203,11 @@ func (ee *environment) Add(ctx context.Context, confs ...store.Exercise) error {
 					if r.Type == "A" {
 						aRecord = r.Name
 						ee.dnsrecords = append(ee.dnsrecords, &DNSRecord{Record: map[string]string{
-							fmt.Sprintf("%s.%s.%s.%d", ip[0], ip[1], ip[2], e.ips[i]): aRecord,
-						}})
+							e.network(network).FormatIP(e.ips[i]): aRecord,
+						}, Network: network})
+						if ip6 := e.network(network).FormatIPv6(e.ips[i]); ip6 != "" {
+							ee.dnsrecords = append(ee.dnsrecords, &DNSRecord{Record: map[string]string{ip6: aRecord}, Network: network})
+						}
 					}
 				}
 			}
@@ -128,18 +216,136 @@ func (ee *environment) Add(ctx context.Context, confs ...store.Exercise) error {
 		ee.exercises = append(ee.exercises, e)
 	}
 
+	prev := ee.labRoutes
+	ee.updateRoutes()
+
+	// records of exercises added to a running lab
+	// are served without restarting its DNS server
+	if ee.dnsServer != nil {
+		if !sameRoutes(prev, ee.labRoutes) {
+			if err := ee.setLabRoutes(ctx); err != nil {
+				log.Warn().Msgf("Unable to add routes into segments of new exercises: %v", err)
+			}
+		}
+		return ee.dnsServer.Update(ee.records())
+	}
+
 	return nil
 }
 
+// updateRoutes collects the routes of the lab network into
+// the segments of all exercises, which are added to the
+// containers and virtual machines on the lab network
+func (ee *environment) updateRoutes() {
+	var routes []docker.Route
+	for _, e := range ee.exercises {
+		routes = append(routes, e.segmentRoutes()...)
+	}
+
+	ee.labRoutes = routes
+	for _, e := range ee.exercises {
+		e.labRoutes = routes
+	}
+}
+
+// setLabRoutes adds the routes into the segments of new exercises
+// to the running instances of the lab, virtual machines receive them
+// from the DHCP server which is started again with the new routes
+func (ee *environment) setLabRoutes(ctx context.Context) error {
+	for _, e := range ee.exercises {
+		if err := e.setRoutes(); err != nil {
+			return err
+		}
+	}
+	if err := ee.setDNSRoutes(); err != nil {
+		return err
+	}
+
+	return ee.refreshDHCP(ctx)
+}
+
+func sameRoutes(a, b []docker.Route) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (ee *environment) setDNSRoutes() error {
+	if len(ee.labRoutes) == 0 {
+		return nil
+	}
+
+	return docker.SetRoutes(ee.dnsServer.Container(), ee.labRoutes)
+}
+
 func (ee *environment) NetworkInterface() string {
 	return ee.network.Interface()
 }
+
+func (ee *environment) Network() docker.Network {
+	return ee.network
+}
+
+// LabRoutes returns the routes of the lab network
+// into the segments of the exercises of the lab
+func (ee *environment) LabRoutes() []docker.Route {
+	return ee.labRoutes
+}
+
+// InstanceAddrs returns the addresses on the lab network of the
+// containers of all exercises except the given exercises
+func (ee *environment) InstanceAddrs(except []store.Tag) []string {
+	var addrs []string
+	for _, e := range ee.exercises {
+		if contains(except, e.tag) {
+			continue
+		}
+		addrs = append(addrs, e.labAddrs()...)
+	}
+	return addrs
+}
+
 func (ee *environment) LabSubnet() string {
 	return ee.dhcpServer.LabSubnet()
 }
 
+// LabSubnet6 returns the IPv6 subnet of the lab,
+// which is empty when the lab is IPv4 only
+func (ee *environment) LabSubnet6() string {
+	prefix := net.ParseIP(ee.network.FormatIPv6(0))
+	if prefix == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/64", prefix)
+}
+
 func (ee *environment) DNSRecords() []*DNSRecord {
-	return ee.dnsrecords
+	records := ee.dnsrecords
+	for _, r := range ee.customRecords {
+		if r.Type == "A" {
+			records = append(records, &DNSRecord{Record: map[string]string{r.RData: r.Name}})
+		}
+	}
+
+	return records
+}
+
+// SetDNSRecords replaces the records added to the records
+// of the exercises, such as fake domains of an event
+func (ee *environment) SetDNSRecords(ctx context.Context, records []store.RecordConfig) error {
+	ee.customRecords = records
+	if ee.dnsServer == nil {
+		return nil
+	}
+
+	return ee.dnsServer.Update(ee.records())
 }
 
 func (ee *environment) LabDNS() string {
@@ -163,18 +369,20 @@ func (ee *environment) Start(ctx context.Context) error {
 		return err
 	}
 
-	var err error
-	ee.dhcpServer, err = dhcp.New(ee.network.FormatIP)
-	if err != nil {
-		return err
-	}
-
-	if err := ee.dhcpServer.Run(ctx); err != nil {
+	// closes previous dhcp server when an environment
+	// is started again after being stopped
+	if err := ee.refreshDHCP(ctx); err != nil {
 		return err
 	}
 
-	if _, err := ee.network.Connect(ee.dhcpServer.Container(), 2); err != nil {
-		return err
+	// virtual machines of dual-stack labs configure
+	// their IPv6 address from router advertisements
+	if ee.ipv6 && ee.advertiser == nil {
+		a, err := dhcp.Advertise(ee.network.Interface(), ee.LabSubnet6(), ee.network.FormatIPv6(dns.PreferedIP), ee.isVPN)
+		if err != nil {
+			return err
+		}
+		ee.advertiser = a
 	}
 
 	var res error
@@ -202,6 +410,13 @@ func (ee *environment) Stop() error {
 		return err
 	}
 
+	if ee.advertiser != nil {
+		if err := ee.advertiser.Close(); err != nil {
+			return err
+		}
+		ee.advertiser = nil
+	}
+
 	for _, e := range ee.exercises {
 		if err := e.Stop(); err != nil {
 			return err
@@ -217,7 +432,7 @@ func (ee *environment) Reset(ctx context.Context) error {
 		return err
 	}
 
-	if err := ee.refreshDNS(ctx); err != nil {
+	if err := ee.updateDNS(ctx); err != nil {
 		return err
 	}
 	for _, e := range ee.exercises {
@@ -254,6 +469,52 @@ func (ee *environment) Resume(ctx context.Context) error {
 	return nil
 }
 
+// Checkpoint saves the filesystem state of the exercise containers,
+// such that the environment can be stopped or closed to free resources
+// and later be brought back with Restore without losing progress.
+// The images of the checkpoint are kept until they are removed by the caller.
+func (ee *environment) Checkpoint(ctx context.Context) (store.Checkpoint, error) {
+	c := store.Checkpoint{}
+	for _, e := range ee.exercises {
+		if err := e.Checkpoint(ctx); err != nil {
+			return nil, err
+		}
+		if len(e.checkpoints) > 0 {
+			c[string(e.tag)] = e.checkpoints
+		}
+	}
+
+	return c, nil
+}
+
+// Restore recreates the exercises from the given checkpoint, which
+// may be taken by another environment of the same exercises, and
+// starts the environment again
+func (ee *environment) Restore(ctx context.Context, c store.Checkpoint) error {
+	var restored int
+	for _, e := range ee.exercises {
+		images, ok := c[string(e.tag)]
+		if !ok {
+			continue
+		}
+		if len(images) != len(e.containerOpts) {
+			return fmt.Errorf("checkpoint of exercise %s does not match its containers", e.tag)
+		}
+		e.checkpoints = images
+
+		if err := e.Restore(ctx); err != nil {
+			return err
+		}
+		restored++
+	}
+
+	if restored == 0 {
+		return NoCheckpointErr
+	}
+
+	return ee.Start(ctx)
+}
+
 func (ee *environment) Close() error {
 	var wg sync.WaitGroup
 
@@ -270,6 +531,14 @@ func (ee *environment) Close() error {
 		closers = append(closers, e)
 	}
 
+	if ee.capture != nil {
+		closers = append(closers, ee.capture)
+	}
+
+	if ee.advertiser != nil {
+		closers = append(closers, ee.advertiser)
+	}
+
 	for _, closer := range closers {
 		wg.Add(1)
 		go func(c io.Closer) {
@@ -282,6 +551,10 @@ func (ee *environment) Close() error {
 	}
 	wg.Wait()
 
+	for _, e := range ee.exercises {
+		e.closeSegments()
+	}
+
 	if err := ee.network.Close(); err != nil {
 		log.Warn().Msgf("error while closing environment: %s", err)
 	}
@@ -346,6 +619,24 @@ func (ee *environment) Challenges() []store.Challenge {
 	return challenges
 }
 
+func (ee *environment) Connections() []Connection {
+	var conns []Connection
+	for _, e := range ee.exercises {
+		conns = append(conns, e.Connections()...)
+	}
+	return conns
+}
+
+// Services returns the services of the exercises
+// of the lab which are forwarded to the team
+func (ee *environment) Services() []Service {
+	var services []Service
+	for _, e := range ee.exercises {
+		services = append(services, e.services()...)
+	}
+	return uniqueServices(services)
+}
+
 func (ee *environment) InstanceInfo() []virtual.InstanceInfo {
 	var instances []virtual.InstanceInfo
 	for _, e := range ee.exercises {
@@ -354,13 +645,32 @@ func (ee *environment) InstanceInfo() []virtual.InstanceInfo {
 	return instances
 }
 
-func (ee *environment) refreshDNS(ctx context.Context) error {
+func (ee *environment) Usage(ctx context.Context) virtual.Usage {
+	var machines []virtual.Instance
+	for _, e := range ee.exercises {
+		machines = append(machines, e.machines...)
+	}
 
-	if ee.dnsServer != nil {
-		if err := ee.dnsServer.Close(); err != nil {
-			return err
-		}
+	u, err := virtual.SampleUsage(ctx, machines...)
+	if err != nil {
+		log.Warn().Msgf("Unable to sample usage of all exercise instances: %v", err)
+	}
+
+	return u
+}
+
+// InstanceEgress returns the egress of the exercise instances which
+// need to reach destinations beyond the egress policy of the event
+func (ee *environment) InstanceEgress() []firewall.InstanceRule {
+	var rules []firewall.InstanceRule
+	for _, e := range ee.exercises {
+		rules = append(rules, e.egress()...)
 	}
+
+	return rules
+}
+
+func (ee *environment) records() []dns.RR {
 	var rrSet []dns.RR
 	for _, e := range ee.exercises {
 
@@ -368,8 +678,32 @@ func (ee *environment) refreshDNS(ctx context.Context) error {
 			rrSet = append(rrSet, dns.RR{Name: record.Name, Type: record.Type, RData: record.RData})
 		}
 	}
+	for _, r := range ee.customRecords {
+		rrSet = append(rrSet, dns.RR{Name: r.Name, Type: r.Type, RData: r.RData})
+	}
+
+	return rrSet
+}
+
+// updateDNS replaces the records of the DNS server, the
+// server is only created when the lab has none yet
+func (ee *environment) updateDNS(ctx context.Context) error {
+	if ee.dnsServer == nil {
+		return ee.refreshDNS(ctx)
+	}
+
+	return ee.dnsServer.Update(ee.records())
+}
 
-	serv, err := dns.New(rrSet)
+func (ee *environment) refreshDNS(ctx context.Context) error {
+
+	if ee.dnsServer != nil {
+		if err := ee.dnsServer.Close(); err != nil {
+			return err
+		}
+	}
+
+	serv, err := dns.New(ee.records())
 	if err != nil {
 		return err
 	}
@@ -383,7 +717,7 @@ func (ee *environment) refreshDNS(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return ee.setDNSRoutes()
 }
 
 func (ee *environment) refreshDHCP(ctx context.Context) error {
@@ -393,7 +727,7 @@ func (ee *environment) refreshDHCP(ctx context.Context) error {
 		}
 	}
 
-	serv, err := dhcp.New(ee.network.FormatIP)
+	serv, err := dhcp.New(ee.network.FormatIP, ee.labRoutes...)
 	if err != nil {
 		return err
 	}
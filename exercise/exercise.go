@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/aau-network-security/haaukins/network/firewall"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
@@ -22,6 +23,7 @@ var (
 	DuplicateTagErr = errors.New("Tag already exists")
 	MissingTagsErr  = errors.New("No tags, need atleast one tag")
 	UnknownTagErr   = errors.New("Unknown tag")
+	NoCheckpointErr = errors.New("No checkpoint to restore from")
 	RegistryLink    = "registry.gitlab.com"
 	tagRawRegexp    = `^[a-z0-9][a-z0-9-]*[a-z0-9]$`
 	tagRegex        = regexp.MustCompile(tagRawRegexp)
@@ -30,6 +32,8 @@ var (
 
 type DockerHost interface {
 	CreateContainer(ctx context.Context, conf docker.ContainerConfig) (docker.Container, error)
+	RemoveImage(name string) error
+	SetRoutes(c docker.Container, routes []docker.Route) error
 }
 
 type dockerHost struct{}
@@ -41,6 +45,14 @@ func (dockerHost) CreateContainer(ctx context.Context, conf docker.ContainerConf
 	return c, err
 }
 
+func (dockerHost) RemoveImage(name string) error {
+	return docker.RemoveImage(name)
+}
+
+func (dockerHost) SetRoutes(c docker.Container, routes []docker.Route) error {
+	return docker.SetRoutes(c, routes)
+}
+
 type exercise struct {
 	containerOpts []store.ContainerOptions
 	vboxOpts      []store.ExerciseInstanceConfig
@@ -56,6 +68,25 @@ type exercise struct {
 
 	ips      []int
 	machines []virtual.Instance
+
+	// images committed from the containers by the latest
+	// checkpoint, in the same order as containerOpts
+	checkpoints []string
+
+	// connections of the containers by index in containerOpts,
+	// kept across resets such that credentials stay valid
+	conns map[int]*Connection
+
+	// segments of the exercise by name, created by the environment
+	segments map[string]docker.Network
+
+	// addresses of the containers by index in containerOpts on each
+	// of their networks, kept across resets such that routes stay valid
+	addrs map[int]map[string]int
+
+	// routes of the lab network into the segments of all exercises
+	// of the lab, which are added to containers on the lab network
+	labRoutes []docker.Route
 }
 
 func NewExercise(conf store.Exercise, dhost DockerHost, vlib vbox.Library, net docker.Network, dnsAddr string) *exercise {
@@ -96,41 +127,59 @@ func (e *exercise) Create(ctx context.Context) error {
 	var machines []virtual.Instance
 	var newIps []int
 	for i, opt := range e.containerOpts {
+		if e.checkpoints != nil {
+			opt.DockerConf.Image = e.checkpoints[i]
+		}
 		opt.DockerConf.DNS = []string{e.dnsAddr}
 		opt.DockerConf.Labels = map[string]string{
 			"hkn": "lab_exercise",
 		}
 
-		c, err := e.dhost.CreateContainer(ctx, opt.DockerConf)
+		conn, err := e.connection(i, opt)
 		if err != nil {
 			return err
 		}
-
-		var lastDigit int
-		// Example: 216
-
-		if e.ips != nil {
-			// Containers need specific ips
-			lastDigit, err = e.net.Connect(c, e.ips[i])
-			if err != nil {
-				return err
+		if conn != nil {
+			env := map[string]string{}
+			for k, v := range opt.DockerConf.EnvVars {
+				env[k] = v
 			}
-		} else {
-			// Let network assign ips
-			lastDigit, err = e.net.Connect(c)
-			if err != nil {
-				return err
+			for k, v := range conn.envVars() {
+				env[k] = v
 			}
+			opt.DockerConf.EnvVars = env
+		}
+
+		c, err := e.dhost.CreateContainer(ctx, opt.DockerConf)
+		if err != nil {
+			return err
+		}
 
+		// Example: 216
+		lastDigit, err := e.connect(i, c, opt)
+		if err != nil {
+			return err
+		}
+		if e.ips == nil {
 			newIps = append(newIps, lastDigit)
 		}
 
-		ipaddr := e.net.FormatIP(lastDigit)
+		net := e.network(primaryNetwork(opt))
+		ipaddr := net.FormatIP(lastDigit)
 		// Example: 172.16.5.216
 
+		if conn != nil {
+			conn.IP = ipaddr
+		}
+
 		for _, record := range opt.Records {
 			if record.RData == "" {
 				record.RData = ipaddr
+				// names of containers in dual-stack labs
+				// resolve to their IPv6 address as well
+				if ip6addr := net.FormatIPv6(lastDigit); ip6addr != "" && record.Type == "A" {
+					e.dnsRecords = append(e.dnsRecords, store.RecordConfig{Name: record.Name, Type: "AAAA", RData: ip6addr})
+				}
 			}
 			e.dnsRecords = append(e.dnsRecords, record)
 		}
@@ -180,8 +229,11 @@ func (e *exercise) Start(ctx context.Context) error {
 		}(m)
 	}
 	wg.Wait()
+	if res != nil {
+		return res
+	}
 
-	return res
+	return e.setRoutes()
 }
 
 func (e *exercise) Suspend(ctx context.Context) error {
@@ -226,6 +278,8 @@ func (e *exercise) Close() error {
 }
 
 func (e *exercise) Reset(ctx context.Context) error {
+	e.checkpoints = nil
+
 	if err := e.Close(); err != nil {
 		return err
 	}
@@ -241,6 +295,331 @@ func (e *exercise) Reset(ctx context.Context) error {
 	return nil
 }
 
+// Checkpoint commits the filesystem of every container of the
+// exercise to an image, which replaces any previous checkpoint.
+// The images of previous checkpoints are left for the owner of
+// the checkpoint to remove. Virtual machines are not part of it.
+func (e *exercise) Checkpoint(ctx context.Context) error {
+	var images []string
+	for _, m := range e.machines {
+		c, ok := m.(docker.Container)
+		if !ok {
+			continue
+		}
+
+		img, err := c.Commit(ctx)
+		if err != nil {
+			for _, img := range images {
+				if err := e.dhost.RemoveImage(img); err != nil {
+					log.Warn().Msgf("error while removing checkpoint image: %s", err)
+				}
+			}
+			return err
+		}
+		images = append(images, img)
+	}
+
+	if len(images) == 0 {
+		return nil
+	}
+
+	e.checkpoints = images
+
+	return nil
+}
+
+// Restore recreates the containers of the exercise from the images
+// of the latest checkpoint, keeping their IP addresses.
+// The recreated instances are not started.
+func (e *exercise) Restore(ctx context.Context) error {
+	if len(e.checkpoints) == 0 || len(e.checkpoints) != len(e.containerOpts) {
+		return NoCheckpointErr
+	}
+
+	if err := e.Close(); err != nil {
+		return err
+	}
+
+	e.dnsRecords = nil
+	return e.Create(ctx)
+}
+
+// connection returns the connection of the container with the
+// given index, credentials are generated the first time
+func (e *exercise) connection(i int, opt store.ContainerOptions) (*Connection, error) {
+	if opt.Connection == nil {
+		return nil, nil
+	}
+
+	if conn, ok := e.conns[i]; ok {
+		return conn, nil
+	}
+
+	conn, err := newConnection(e.tag, *opt.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.conns == nil {
+		e.conns = map[int]*Connection{}
+	}
+	e.conns[i] = conn
+
+	return conn, nil
+}
+
+// connect attaches the container to its networks and returns the host
+// part of its address on its primary network, the addresses are kept
+// across resets
+func (e *exercise) connect(i int, c docker.Container, opt store.ContainerOptions) (int, error) {
+	if e.addrs == nil {
+		e.addrs = map[int]map[string]int{}
+	}
+	addrs, ok := e.addrs[i]
+	if !ok {
+		addrs = map[string]int{}
+		e.addrs[i] = addrs
+	}
+
+	for _, a := range attachments(opt) {
+		ip, ok := addrs[a.Network]
+		if !ok && a.IP != 0 {
+			ip, ok = a.IP, true
+		}
+
+		var err error
+		if ok {
+			ip, err = e.network(a.Network).Connect(c, ip)
+		} else {
+			ip, err = e.network(a.Network).Connect(c)
+		}
+		if err != nil {
+			return 0, err
+		}
+		addrs[a.Network] = ip
+	}
+
+	return addrs[primaryNetwork(opt)], nil
+}
+
+// createSegments creates the network segments of the exercise,
+// which are kept until the environment is closed
+func (e *exercise) createSegments(networks []store.NetworkConfig) error {
+	for _, n := range networks {
+		seg, err := docker.NewSegmentNetwork()
+		if err != nil {
+			e.closeSegments()
+			return err
+		}
+		if e.segments == nil {
+			e.segments = map[string]docker.Network{}
+		}
+		e.segments[n.Name] = seg
+	}
+
+	return nil
+}
+
+func (e *exercise) closeSegments() {
+	for name, seg := range e.segments {
+		if err := seg.Close(); err != nil {
+			log.Warn().Str("segment", name).Msgf("error while closing exercise segment: %s", err)
+		}
+	}
+	e.segments = nil
+}
+
+// network returns the lab network or a segment of the exercise
+func (e *exercise) network(name string) docker.Network {
+	if name == store.LabNetwork {
+		return e.net
+	}
+	return e.segments[name]
+}
+
+// attachments returns the networks of a container, which is
+// only attached to the lab network unless configured otherwise
+func attachments(opt store.ContainerOptions) []store.NetworkAttachment {
+	if len(opt.Networks) == 0 {
+		return []store.NetworkAttachment{{Network: store.LabNetwork}}
+	}
+	return opt.Networks
+}
+
+// primaryNetwork returns the network of the address used for the
+// records and connection of a container, which is the lab network
+// when the container is attached to it
+func primaryNetwork(opt store.ContainerOptions) string {
+	nets := attachments(opt)
+	for _, a := range nets {
+		if a.Network == store.LabNetwork {
+			return a.Network
+		}
+	}
+	return nets[0].Network
+}
+
+// routes returns the routes of a container attached to the given networks
+// into the other networks of the exercise, containers off the lab network
+// get a default route towards it. The next hop towards each network is the
+// first router found by a breadth-first search from the networks of the
+// container, the container itself is skipped when it is a router.
+func (e *exercise) routes(self int, attached []string) []docker.Route {
+	type hop struct {
+		network string
+		gateway string
+	}
+
+	var queue []hop
+	visited := map[string]bool{}
+	for _, n := range attached {
+		visited[n] = true
+		queue = append(queue, hop{network: n})
+	}
+
+	var routes []docker.Route
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+
+		for r, opt := range e.containerOpts {
+			ip, ok := e.addrs[r][h.network]
+			if !opt.Router || r == self || !ok {
+				continue
+			}
+
+			gw := h.gateway
+			if gw == "" {
+				gw = e.network(h.network).FormatIP(ip)
+			}
+			for _, a := range attachments(opt) {
+				if visited[a.Network] {
+					continue
+				}
+				visited[a.Network] = true
+				queue = append(queue, hop{network: a.Network, gateway: gw})
+
+				var dst string
+				if a.Network != store.LabNetwork {
+					dst = subnet(e.network(a.Network))
+				}
+				routes = append(routes, docker.Route{Destination: dst, Gateway: gw})
+			}
+		}
+	}
+
+	return routes
+}
+
+// segmentRoutes returns the routes of the lab network
+// into the segments of the exercise
+func (e *exercise) segmentRoutes() []docker.Route {
+	return e.routes(-1, []string{store.LabNetwork})
+}
+
+// setRoutes adds the routes into the segments of the lab to the
+// containers of the exercise, which are lost when a container stops
+// and are therefore added again whenever the exercise is started
+func (e *exercise) setRoutes() error {
+	for i, opt := range e.containerOpts {
+		if i >= len(e.machines) {
+			break
+		}
+		c, ok := e.machines[i].(docker.Container)
+		if !ok || c.Info().State != virtual.Running {
+			continue
+		}
+
+		var attached []string
+		known := map[string]bool{}
+		self := map[string]bool{}
+		for _, a := range attachments(opt) {
+			attached = append(attached, a.Network)
+			n := e.network(a.Network)
+			known[subnet(n)] = true
+			self[n.FormatIP(e.addrs[i][a.Network])] = true
+		}
+
+		routes := e.routes(i, attached)
+		for _, r := range routes {
+			known[r.Destination] = true
+		}
+		if primaryNetwork(opt) == store.LabNetwork {
+			for _, r := range e.labRoutes {
+				if known[r.Destination] || self[r.Gateway] {
+					continue
+				}
+				known[r.Destination] = true
+				routes = append(routes, r)
+			}
+		}
+		if len(routes) == 0 {
+			continue
+		}
+
+		if err := e.dhost.SetRoutes(c, routes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// labAddrs returns the addresses of the containers
+// of the exercise which are attached to the lab network
+func (e *exercise) labAddrs() []string {
+	var addrs []string
+	n := e.network(store.LabNetwork)
+	for i, opt := range e.containerOpts {
+		for _, a := range attachments(opt) {
+			ip, ok := e.addrs[i][a.Network]
+			if a.Network != store.LabNetwork || !ok {
+				continue
+			}
+			addrs = append(addrs, n.FormatIP(ip)+"/32")
+			if ip6 := n.FormatIPv6(ip); ip6 != "" {
+				addrs = append(addrs, ip6+"/128")
+			}
+		}
+	}
+	return addrs
+}
+
+// subnet returns the IPv4 subnet of a network
+func subnet(n docker.Network) string {
+	return n.FormatIP(0) + "/24"
+}
+
+// Connections returns the SSH and VNC connections of the exercise
+func (e *exercise) Connections() []Connection {
+	var conns []Connection
+	for i := range e.containerOpts {
+		if conn, ok := e.conns[i]; ok {
+			conns = append(conns, *conn)
+		}
+	}
+	return conns
+}
+
+// egress returns the egress of the containers of the exercise,
+// containers are identified by the addresses kept across resets
+func (e *exercise) egress() []firewall.InstanceRule {
+	var rules []firewall.InstanceRule
+	for i, opt := range e.containerOpts {
+		if opt.Egress == nil || i >= len(e.ips) {
+			continue
+		}
+		net := e.network(primaryNetwork(opt))
+		rules = append(rules, firewall.InstanceRule{
+			IP:       net.FormatIP(e.ips[i]),
+			IP6:      net.FormatIPv6(e.ips[i]),
+			Internet: opt.Egress.Internet,
+			Allow:    opt.Egress.Allow,
+		})
+	}
+	return rules
+}
+
 func (e *exercise) Challenges() []store.Challenge {
 	var challenges []store.Challenge
 
@@ -6,7 +6,11 @@ package exercise
 
 import (
 	"context"
+	"fmt"
+	"testing"
 
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 )
 
@@ -69,6 +73,10 @@ func (tn testNetwork) FormatIP(num int) string {
 	return "1.2.3.4"
 }
 
+func (tn testNetwork) FormatIPv6(num int) string {
+	return ""
+}
+
 //func TestExerciseCreate(t *testing.T) {
 //	firstRecords := []store.RecordConfig{
 //		{
@@ -107,3 +115,282 @@ func (tn testNetwork) FormatIP(num int) string {
 //		t.Fatalf("Expected rData '1.2.3.4', but got '%s'", e.dnsRecords[0].RData)
 //	}
 //}
+
+type checkpointContainer struct {
+	docker.Container
+	image   string
+	env     map[string]string
+	commits int
+	closed  bool
+}
+
+func (c *checkpointContainer) Commit(context.Context) (string, error) {
+	c.commits++
+	return fmt.Sprintf("%s:%s-%d", docker.CheckpointRepo, c.image, c.commits), nil
+}
+
+func (c *checkpointContainer) Info() virtual.InstanceInfo {
+	return virtual.InstanceInfo{State: virtual.Running}
+}
+
+func (c *checkpointContainer) Close() error {
+	c.closed = true
+	return nil
+}
+
+type checkpointDockerHost struct {
+	DockerHost
+	created []*checkpointContainer
+	removed []string
+}
+
+func (h *checkpointDockerHost) CreateContainer(ctx context.Context, conf docker.ContainerConfig) (docker.Container, error) {
+	c := &checkpointContainer{image: conf.Image, env: conf.EnvVars}
+	h.created = append(h.created, c)
+	return c, nil
+}
+
+func (h *checkpointDockerHost) RemoveImage(name string) error {
+	h.removed = append(h.removed, name)
+	return nil
+}
+
+type checkpointNetwork struct {
+	docker.Network
+	next      int
+	requested []int
+}
+
+func (n *checkpointNetwork) Connect(c docker.Container, ip ...int) (int, error) {
+	if len(ip) > 0 {
+		n.requested = append(n.requested, ip[0])
+		return ip[0], nil
+	}
+	n.next++
+	return n.next, nil
+}
+
+func (n *checkpointNetwork) FormatIP(num int) string {
+	return fmt.Sprintf("1.2.3.%d", num)
+}
+
+func (n *checkpointNetwork) FormatIPv6(num int) string {
+	return fmt.Sprintf("fd00:1:2:3::%d", num)
+}
+
+func TestExerciseCheckpointRestore(t *testing.T) {
+	conf := store.Exercise{
+		Tag: "sql",
+		Instance: []store.ExerciseInstanceConfig{
+			{Image: "web"},
+			{Image: "db"},
+		},
+	}
+	dhost := &checkpointDockerHost{}
+	net := &checkpointNetwork{}
+	e := NewExercise(conf, dhost, nil, net, "")
+	ctx := context.Background()
+
+	if err := e.Restore(ctx); err != NoCheckpointErr {
+		t.Fatalf("expected no checkpoint error, got: %v", err)
+	}
+	if err := e.Create(ctx); err != nil {
+		t.Fatalf("unexpected error when creating exercise: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := e.Checkpoint(ctx); err != nil {
+			t.Fatalf("unexpected error when taking checkpoint: %v", err)
+		}
+	}
+	if len(dhost.removed) != 0 {
+		t.Fatalf("expected images of previous checkpoints to be kept for their owner, got removed: %v", dhost.removed)
+	}
+
+	if err := e.Restore(ctx); err != nil {
+		t.Fatalf("unexpected error when restoring exercise: %v", err)
+	}
+	for _, c := range dhost.created[:2] {
+		if !c.closed {
+			t.Fatalf("expected original container (%s) to be closed", c.image)
+		}
+	}
+
+	var images []string
+	for _, c := range dhost.created[2:] {
+		images = append(images, c.image)
+	}
+	expectedImages := []string{"hkn-checkpoint:web-2", "hkn-checkpoint:db-2"}
+	if fmt.Sprint(images) != fmt.Sprint(expectedImages) {
+		t.Fatalf("expected containers to be restored from %v, got: %v", expectedImages, images)
+	}
+	if fmt.Sprint(net.requested) != fmt.Sprint([]int{1, 2}) {
+		t.Fatalf("expected restored containers to keep their ips, got: %v", net.requested)
+	}
+
+	if err := e.Reset(ctx); err != nil {
+		t.Fatalf("unexpected error when resetting exercise: %v", err)
+	}
+	if e.checkpoints != nil {
+		t.Fatalf("expected checkpoints to be discarded on reset, got: %v", e.checkpoints)
+	}
+	if img := dhost.created[len(dhost.created)-1].image; img != "db" {
+		t.Fatalf("expected reset to recreate containers from original image, got: %s", img)
+	}
+}
+
+func TestExerciseConnections(t *testing.T) {
+	conf := store.Exercise{
+		Tag: "ssh",
+		Instance: []store.ExerciseInstanceConfig{
+			{Image: "web"},
+			{
+				Image:      "shell",
+				Connection: &store.ConnectionConfig{Protocol: store.SSHProtocol, Port: 22},
+			},
+		},
+	}
+	dhost := &checkpointDockerHost{}
+	e := NewExercise(conf, dhost, nil, &checkpointNetwork{}, "")
+	ctx := context.Background()
+
+	if err := e.Create(ctx); err != nil {
+		t.Fatalf("unexpected error when creating exercise: %v", err)
+	}
+
+	conns := e.Connections()
+	if len(conns) != 1 {
+		t.Fatalf("expected one connection, got: %d", len(conns))
+	}
+	conn := conns[0]
+	if conn.IP != "1.2.3.2" || conn.Port != 22 || conn.Username != defaultSSHUser {
+		t.Fatalf("unexpected connection: %s@%s:%d", conn.Username, conn.IP, conn.Port)
+	}
+	if conn.PrivateKey == "" {
+		t.Fatalf("expected private key to be generated")
+	}
+
+	if len(dhost.created[0].env) != 0 {
+		t.Fatalf("expected no credentials for instance without connection, got: %v", dhost.created[0].env)
+	}
+	env := dhost.created[1].env
+	if env["HKN_SSH_PASSWORD"] != conn.Password || env["HKN_SSH_USER"] != conn.Username {
+		t.Fatalf("expected credentials of connection in environment, got: %v", env)
+	}
+	if env["HKN_SSH_AUTHORIZED_KEY"] == "" {
+		t.Fatalf("expected authorized key in environment")
+	}
+
+	if err := e.Reset(ctx); err != nil {
+		t.Fatalf("unexpected error when resetting exercise: %v", err)
+	}
+	if pass := dhost.created[3].env["HKN_SSH_PASSWORD"]; pass != conn.Password {
+		t.Fatalf("expected credentials to be kept on reset")
+	}
+}
+
+type segmentNetwork struct {
+	docker.Network
+	prefix string
+	next   int
+}
+
+func (n *segmentNetwork) Connect(c docker.Container, ip ...int) (int, error) {
+	if len(ip) > 0 {
+		return ip[0], nil
+	}
+	n.next++
+	return 29 + n.next, nil
+}
+
+func (n *segmentNetwork) FormatIP(num int) string {
+	return fmt.Sprintf("%s.%d", n.prefix, num)
+}
+
+func (n *segmentNetwork) FormatIPv6(num int) string {
+	return ""
+}
+
+type routeDockerHost struct {
+	checkpointDockerHost
+	routes map[string][]docker.Route
+}
+
+func (h *routeDockerHost) SetRoutes(c docker.Container, routes []docker.Route) error {
+	h.routes[c.(*checkpointContainer).image] = routes
+	return nil
+}
+
+func TestExerciseSegmentRoutes(t *testing.T) {
+	conf := store.Exercise{
+		Tag:      "topology",
+		Networks: []store.NetworkConfig{{Name: "dmz"}, {Name: "internal"}},
+		Instance: []store.ExerciseInstanceConfig{
+			{
+				Image:    "edge",
+				Router:   true,
+				Networks: []store.NetworkAttachment{{Network: store.LabNetwork, IP: 10}, {Network: "dmz", IP: 10}},
+			},
+			{
+				Image:    "firewall",
+				Router:   true,
+				Networks: []store.NetworkAttachment{{Network: "dmz", IP: 11}, {Network: "internal", IP: 10}},
+			},
+			{
+				Image:    "web",
+				Networks: []store.NetworkAttachment{{Network: "dmz"}},
+				Records:  []store.RecordConfig{{Type: "A", Name: "web.dmz"}},
+			},
+			{Image: "db", Networks: []store.NetworkAttachment{{Network: "internal"}}},
+			{Image: "kali"},
+		},
+	}
+	if err := conf.Validate(); err != nil {
+		t.Fatalf("unexpected error when validating exercise: %v", err)
+	}
+
+	dhost := &routeDockerHost{routes: map[string][]docker.Route{}}
+	e := NewExercise(conf, dhost, nil, &segmentNetwork{prefix: "10.0.1"}, "")
+	e.segments = map[string]docker.Network{
+		"dmz":      &segmentNetwork{prefix: "10.0.2"},
+		"internal": &segmentNetwork{prefix: "10.0.3"},
+	}
+	ctx := context.Background()
+	if err := e.Create(ctx); err != nil {
+		t.Fatalf("unexpected error when creating exercise: %v", err)
+	}
+
+	if rdata := e.dnsRecords[0].RData; rdata != "10.0.2.30" {
+		t.Fatalf("expected record of web on the dmz, got: %s", rdata)
+	}
+
+	e.labRoutes = e.segmentRoutes()
+	expectedLab := []docker.Route{
+		{Destination: "10.0.2.0/24", Gateway: "10.0.1.10"},
+		{Destination: "10.0.3.0/24", Gateway: "10.0.1.10"},
+	}
+	if fmt.Sprint(e.labRoutes) != fmt.Sprint(expectedLab) {
+		t.Fatalf("expected lab routes %v, got: %v", expectedLab, e.labRoutes)
+	}
+
+	if err := e.Start(ctx); err != nil {
+		t.Fatalf("unexpected error when starting exercise: %v", err)
+	}
+	expected := map[string][]docker.Route{
+		"edge":     {{Destination: "10.0.3.0/24", Gateway: "10.0.2.11"}},
+		"firewall": {{Gateway: "10.0.2.10"}},
+		"web":      {{Gateway: "10.0.2.10"}, {Destination: "10.0.3.0/24", Gateway: "10.0.2.11"}},
+		"db":       {{Destination: "10.0.2.0/24", Gateway: "10.0.3.10"}, {Gateway: "10.0.3.10"}},
+		"kali":     expectedLab,
+	}
+	for image, routes := range expected {
+		if fmt.Sprint(dhost.routes[image]) != fmt.Sprint(routes) {
+			t.Fatalf("expected routes %v of %s, got: %v", routes, image, dhost.routes[image])
+		}
+	}
+
+	// only edge and kali are reachable on the lab network
+	if addrs := e.labAddrs(); fmt.Sprint(addrs) != "[10.0.1.10/32 10.0.1.30/32]" {
+		t.Fatalf("unexpected lab addresses: %v", addrs)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package exercise
+
+import (
+	"github.com/aau-network-security/haaukins/network/dns"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/rs/zerolog/log"
+)
+
+// ExerciseHealth contains the state of the instances of a single exercise
+type ExerciseHealth struct {
+	Tag       store.Tag
+	Disabled  bool
+	Instances []virtual.InstanceInfo
+}
+
+// Healthy returns false when one of the instances of an enabled
+// exercise is stopped or in error state, suspended instances are
+// considered to be healthy as they are suspended on purpose
+func (eh ExerciseHealth) Healthy() bool {
+	if eh.Disabled {
+		return true
+	}
+	for _, i := range eh.Instances {
+		if i.State == virtual.Stopped || i.State == virtual.Error {
+			return false
+		}
+	}
+	return true
+}
+
+// Health is the result of probing an environment
+type Health struct {
+	DNS       bool
+	DHCP      bool
+	Exercises []ExerciseHealth
+}
+
+func (h Health) Healthy() bool {
+	if !h.DNS || !h.DHCP {
+		return false
+	}
+	return len(h.FailedExercises()) == 0
+}
+
+// FailedExercises returns tags of the exercises which are not healthy
+func (h Health) FailedExercises() []string {
+	var tags []string
+	for _, e := range h.Exercises {
+		if !e.Healthy() {
+			tags = append(tags, string(e.Tag))
+		}
+	}
+	return tags
+}
+
+// Health probes the DNS and DHCP servers and checks the state of
+// all exercise instances within the environment
+func (ee *environment) Health() Health {
+	var h Health
+	if ee.dnsServer != nil && ee.dnsServer.Container().Info().State == virtual.Running {
+		err := ee.dnsServer.Probe(ee.network.FormatIP(dns.PreferedIP))
+		if err != nil {
+			log.Debug().Str("dns", ee.network.FormatIP(dns.PreferedIP)).Msgf("DNS probe failed: %v", err)
+		}
+		h.DNS = err == nil
+	}
+	if ee.dhcpServer != nil && ee.dhcpServer.Container().Info().State == virtual.Running {
+		err := ee.dhcpServer.Probe()
+		if err != nil {
+			log.Debug().Str("subnet", ee.dhcpServer.LabSubnet()).Msgf("DHCP probe failed: %v", err)
+		}
+		h.DHCP = err == nil
+	}
+
+	for _, e := range ee.exercises {
+		h.Exercises = append(h.Exercises, ExerciseHealth{
+			Tag:       e.tag,
+			Disabled:  contains(ee.disabledExercises, e.tag),
+			Instances: e.InstanceInfo(),
+		})
+	}
+
+	return h
+}
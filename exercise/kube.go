@@ -0,0 +1,498 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package exercise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/kube"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	UnsupportedByBackendErr = errors.New("not supported by the kubernetes backend")
+)
+
+// kubeEnvironment runs the exercises of a lab as workloads within
+// a namespace of a Kubernetes cluster, the namespace isolates the lab
+// and records are served by a CoreDNS workload instead of dns.Server,
+// addresses are handed out by the cluster network instead of dhcp.Server
+type kubeEnvironment struct {
+	cluster           *kube.Cluster
+	ns                *kube.Namespace
+	tags              map[store.Tag]*kubeExercise
+	exercises         []*kubeExercise
+	disabledExercises []store.Tag
+	customRecords     []store.RecordConfig
+}
+
+type kubeExercise struct {
+	tag        store.Tag
+	opts       []store.ContainerOptions
+	workloads  []*kube.Workload
+	challenges []store.Challenge
+}
+
+func NewKubeEnvironment(cluster *kube.Cluster) Environment {
+	return &kubeEnvironment{
+		cluster: cluster,
+		tags:    make(map[store.Tag]*kubeExercise),
+	}
+}
+
+func (ke *kubeEnvironment) SetDisabledExercises(disabledExs []store.Tag) {
+	ke.disabledExercises = disabledExs
+}
+
+func (ke *kubeEnvironment) Create(ctx context.Context, isVPN int32) error {
+	ns, err := ke.cluster.CreateNamespace(ctx)
+	if err != nil {
+		return fmt.Errorf("kubernetes new namespace err %v", err)
+	}
+	ke.ns = ns
+
+	return nil
+}
+
+func (ke *kubeEnvironment) Add(ctx context.Context, confs ...store.Exercise) error {
+	for _, conf := range confs {
+		if conf.Tag == "" {
+			return MissingTagsErr
+		}
+
+		if _, ok := ke.tags[conf.Tag]; ok {
+			return DuplicateTagErr
+		}
+
+		e := &kubeExercise{tag: conf.Tag}
+		for _, i := range conf.Instance {
+			if strings.Contains(i.Image, OvaSuffix) {
+				return UnsupportedByBackendErr
+			}
+		}
+		e.opts = conf.ContainerOpts()
+		for _, opt := range e.opts {
+			e.challenges = append(e.challenges, opt.Challenges...)
+		}
+
+		if !conf.Static {
+			for i, opt := range e.opts {
+				w := ke.ns.NewWorkload(fmt.Sprintf("%s-%d", conf.Tag, i), workloadConfig(opt.DockerConf))
+				if err := w.Create(ctx); err != nil {
+					return err
+				}
+				e.workloads = append(e.workloads, w)
+			}
+		}
+
+		ke.tags[conf.Tag] = e
+		ke.exercises = append(ke.exercises, e)
+	}
+
+	return nil
+}
+
+func workloadConfig(conf docker.ContainerConfig) kube.WorkloadConfig {
+	wc := kube.WorkloadConfig{
+		Image:   conf.Image,
+		EnvVars: conf.EnvVars,
+		Labels:  conf.Labels,
+		Cmd:     conf.Cmd,
+	}
+
+	if conf.Resources != nil {
+		wc.MemoryMB = conf.Resources.MemoryMB
+		wc.CPU = conf.Resources.CPU
+	}
+
+	return wc
+}
+
+func (ke *kubeEnvironment) records() []kube.Record {
+	var records []kube.Record
+	for _, e := range ke.exercises {
+		for i, opt := range e.opts {
+			if i >= len(e.workloads) {
+				break
+			}
+
+			for _, r := range opt.Records {
+				if r.Type != "A" {
+					continue
+				}
+
+				records = append(records, kube.Record{
+					Name:     r.Name,
+					Workload: e.workloads[i].Name(),
+					IP:       r.RData,
+				})
+			}
+		}
+	}
+	// the hosts plugin only serves addresses
+	for _, r := range ke.customRecords {
+		if r.Type == "A" {
+			records = append(records, kube.Record{Name: r.Name, IP: r.RData})
+		}
+	}
+
+	return records
+}
+
+// SetDNSRecords replaces the records added to the records of the
+// exercises, only A records are served by the kubernetes backend
+func (ke *kubeEnvironment) SetDNSRecords(ctx context.Context, records []store.RecordConfig) error {
+	ke.customRecords = records
+
+	return ke.ns.RunDNS(ctx, ke.records())
+}
+
+// NetworkInterface is empty, as the lab is not
+// attached to an interface of the daemon host
+func (ke *kubeEnvironment) NetworkInterface() string {
+	return ""
+}
+
+func (ke *kubeEnvironment) Network() docker.Network {
+	return nil
+}
+
+// LabRoutes is empty, the Kubernetes backend ignores segments
+func (ke *kubeEnvironment) LabRoutes() []docker.Route {
+	return nil
+}
+
+// InstanceAddrs is empty, pods are not reached from a terminal
+func (ke *kubeEnvironment) InstanceAddrs([]store.Tag) []string {
+	return nil
+}
+
+func (ke *kubeEnvironment) LabSubnet() string {
+	return ke.cluster.PodSubnet()
+}
+
+func (ke *kubeEnvironment) LabSubnet6() string {
+	return ""
+}
+
+func (ke *kubeEnvironment) LabDNS() string {
+	return ke.ns.DNS()
+}
+
+func (ke *kubeEnvironment) DNSRecords() []*DNSRecord {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var records []*DNSRecord
+	for _, e := range ke.exercises {
+		for i, opt := range e.opts {
+			if i >= len(e.workloads) || strings.Contains(opt.DockerConf.Image, "client") {
+				continue
+			}
+
+			ip := e.workloads[i].IP(ctx)
+			if ip == "" {
+				continue
+			}
+
+			for _, r := range opt.Records {
+				if r.Type == "A" {
+					records = append(records, &DNSRecord{Record: map[string]string{ip: r.Name}})
+				}
+			}
+		}
+	}
+	for _, r := range ke.customRecords {
+		if r.Type == "A" {
+			records = append(records, &DNSRecord{Record: map[string]string{r.RData: r.Name}})
+		}
+	}
+
+	return records
+}
+
+// Connections are not available, as guacamole
+// cannot reach the pod network of the cluster
+func (ke *kubeEnvironment) Connections() []Connection {
+	return nil
+}
+
+// Services are reached on the addresses of the pods, which
+// requires the daemon host to be allowed by the network policy
+func (ke *kubeEnvironment) Services() []Service {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var services []Service
+	for _, e := range ke.exercises {
+		for i, opt := range e.opts {
+			if i >= len(e.workloads) {
+				continue
+			}
+			ip := e.workloads[i].IP(ctx)
+			if ip == "" {
+				continue
+			}
+			services = append(services, exposed(e.tag, ip, opt.Records)...)
+		}
+	}
+
+	return uniqueServices(services)
+}
+
+func (ke *kubeEnvironment) Challenges() []store.Challenge {
+	var challenges []store.Challenge
+	for _, e := range ke.exercises {
+		challenges = append(challenges, e.challenges...)
+	}
+
+	return challenges
+}
+
+func (ke *kubeEnvironment) InstanceInfo() []virtual.InstanceInfo {
+	var instances []virtual.InstanceInfo
+	for _, e := range ke.exercises {
+		instances = append(instances, e.instanceInfo()...)
+	}
+
+	return instances
+}
+
+// Usage is not sampled for namespaces, consumption of the
+// pods is available from the metrics of the cluster
+func (ke *kubeEnvironment) Usage(context.Context) virtual.Usage {
+	return virtual.Usage{}
+}
+
+// SetCapture is ignored, the traffic between pods does
+// not pass an interface of the daemon host
+func (ke *kubeEnvironment) SetCapture(capture.Config) {}
+
+func (ke *kubeEnvironment) CaptureConfig() *capture.Config {
+	return nil
+}
+
+func (ke *kubeEnvironment) StartCapture() error {
+	return nil
+}
+
+// EnableIPv6 is ignored, the address families
+// of pods are given by the network of the cluster
+func (ke *kubeEnvironment) EnableIPv6() {}
+
+// SetLabSubnet is ignored, the addresses of
+// pods are given by the network of the cluster
+func (ke *kubeEnvironment) SetLabSubnet(string) {}
+
+// InstanceEgress is empty, egress of pods is
+// restricted by the network policy of the namespace
+func (ke *kubeEnvironment) InstanceEgress() []firewall.InstanceRule {
+	return nil
+}
+
+func (ke *kubeEnvironment) Health() Health {
+	h := Health{
+		DNS: ke.ns.DNSReady(),
+		// addresses are assigned by the cluster network
+		DHCP: true,
+	}
+
+	for _, e := range ke.exercises {
+		h.Exercises = append(h.Exercises, ExerciseHealth{
+			Tag:       e.tag,
+			Disabled:  contains(ke.disabledExercises, e.tag),
+			Instances: e.instanceInfo(),
+		})
+	}
+
+	return h
+}
+
+func (ke *kubeEnvironment) Start(ctx context.Context) error {
+	if err := ke.ns.RunDNS(ctx, ke.records()); err != nil {
+		log.Error().Err(err).Msg("Refreshing DNS error")
+		return err
+	}
+
+	var res error
+	var m sync.Mutex
+	var wg sync.WaitGroup
+	for _, ex := range ke.exercises {
+		if contains(ke.disabledExercises, ex.tag) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(e *kubeExercise) {
+			defer wg.Done()
+			if err := e.start(ctx); err != nil {
+				m.Lock()
+				if res == nil {
+					res = err
+				}
+				m.Unlock()
+			}
+		}(ex)
+	}
+	wg.Wait()
+
+	return res
+}
+
+func (ke *kubeEnvironment) StartByTag(ctx context.Context, tag string) error {
+	e, err := ke.byTag(tag)
+	if err != nil {
+		return err
+	}
+
+	return e.start(ctx)
+}
+
+func (ke *kubeEnvironment) StopByTag(tag string) error {
+	e, err := ke.byTag(tag)
+	if err != nil {
+		return err
+	}
+
+	return e.stop()
+}
+
+func (ke *kubeEnvironment) Stop() error {
+	for _, e := range ke.exercises {
+		if err := e.stop(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ke *kubeEnvironment) Reset(ctx context.Context) error {
+	if err := ke.ns.RunDNS(ctx, ke.records()); err != nil {
+		return err
+	}
+
+	for _, e := range ke.exercises {
+		if err := e.reset(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ke *kubeEnvironment) ResetByTag(ctx context.Context, tag string) error {
+	e, err := ke.byTag(tag)
+	if err != nil {
+		return err
+	}
+
+	return e.reset(ctx)
+}
+
+// Suspend scales the workloads to zero, pods cannot
+// be paused so exercises are fresh when resumed
+func (ke *kubeEnvironment) Suspend(ctx context.Context) error {
+	for _, e := range ke.exercises {
+		for _, w := range e.workloads {
+			if err := w.Suspend(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ke *kubeEnvironment) Resume(ctx context.Context) error {
+	return ke.Start(ctx)
+}
+
+func (ke *kubeEnvironment) Checkpoint(ctx context.Context) (store.Checkpoint, error) {
+	return nil, UnsupportedByBackendErr
+}
+
+func (ke *kubeEnvironment) Restore(ctx context.Context, c store.Checkpoint) error {
+	return UnsupportedByBackendErr
+}
+
+// Close deletes the namespace of the lab,
+// which deletes all of the workloads within it
+func (ke *kubeEnvironment) Close() error {
+	if ke.ns == nil {
+		return nil
+	}
+
+	if err := ke.ns.Close(); err != nil {
+		log.Warn().Msgf("error while closing environment: %s", err)
+	}
+
+	return nil
+}
+
+func (ke *kubeEnvironment) byTag(s string) (*kubeExercise, error) {
+	t, err := store.NewTag(s)
+	if err != nil {
+		return nil, err
+	}
+
+	e, ok := ke.tags[t]
+	if !ok {
+		return nil, UnknownTagErr
+	}
+
+	return e, nil
+}
+
+func (e *kubeExercise) start(ctx context.Context) error {
+	for _, w := range e.workloads {
+		if err := w.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *kubeExercise) stop() error {
+	for _, w := range e.workloads {
+		if err := w.Stop(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reset replaces the pods of the exercise, the deployments
+// create new pods from the images such that state is lost
+func (e *kubeExercise) reset(ctx context.Context) error {
+	for _, w := range e.workloads {
+		if err := w.Restart(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *kubeExercise) instanceInfo() []virtual.InstanceInfo {
+	var instances []virtual.InstanceInfo
+	for _, w := range e.workloads {
+		instances = append(instances, w.Info())
+	}
+
+	return instances
+}
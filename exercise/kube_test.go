@@ -0,0 +1,78 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package exercise
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestKubeEnvironment(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	env := NewKubeEnvironment(kube.NewClusterFromClient(client, kube.Config{PodSubnet: "10.42.0.0/16"}))
+	ctx := context.Background()
+
+	if err := env.Create(ctx, 0); err != nil {
+		t.Fatalf("unexpected error when creating environment: %v", err)
+	}
+
+	exercises := []store.Exercise{
+		{
+			Tag: "csrf",
+			Instance: []store.ExerciseInstanceConfig{{
+				Image:   "aau/csrf",
+				Records: []store.RecordConfig{{Type: "A", Name: "formalbank.com"}},
+			}},
+		},
+		{
+			Tag:      "sql",
+			Instance: []store.ExerciseInstanceConfig{{Image: "aau/sql"}},
+		},
+	}
+	if err := env.Add(ctx, exercises...); err != nil {
+		t.Fatalf("unexpected error when adding exercises: %v", err)
+	}
+	if err := env.Add(ctx, store.Exercise{Tag: "vm", Instance: []store.ExerciseInstanceConfig{{Image: "win.ova"}}}); err != UnsupportedByBackendErr {
+		t.Fatalf("expected virtual machines to be unsupported, got: %v", err)
+	}
+
+	env.SetDisabledExercises([]store.Tag{"sql"})
+	if err := env.Start(ctx); err != nil {
+		t.Fatalf("unexpected error when starting environment: %v", err)
+	}
+
+	ns := env.(*kubeEnvironment).ns.Name()
+	for name, expected := range map[string]int32{"csrf-0": 1, "sql-0": 0} {
+		d, err := client.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected deployment %s: %v", name, err)
+		}
+		if *d.Spec.Replicas != expected {
+			t.Fatalf("expected %d replicas of %s, got: %d", expected, name, *d.Spec.Replicas)
+		}
+	}
+
+	if !env.Health().Healthy() {
+		t.Fatalf("expected environment to be healthy, failed: %v", env.Health().FailedExercises())
+	}
+	if subnet := env.LabSubnet(); subnet != "10.42.0.0/16" {
+		t.Fatalf("expected pod subnet as lab subnet, got: %s", subnet)
+	}
+	if _, err := env.Checkpoint(ctx); err != UnsupportedByBackendErr {
+		t.Fatalf("expected checkpoints to be unsupported, got: %v", err)
+	}
+
+	if err := env.Close(); err != nil {
+		t.Fatalf("unexpected error when closing environment: %v", err)
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{}); err == nil {
+		t.Fatalf("expected namespace to be deleted")
+	}
+}
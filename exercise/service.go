@@ -0,0 +1,72 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package exercise
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+// Service is a service of an exercise instance which
+// is forwarded to the team of the lab by the daemon
+type Service struct {
+	Tag      store.Tag
+	Name     string
+	Protocol string
+	// Address is the host and port of the service within the lab
+	Address string
+}
+
+// services returns the exposed services of the containers of the
+// exercise, which the daemon reaches on the lab network
+func (e *exercise) services() []Service {
+	var services []Service
+	for i, opt := range e.containerOpts {
+		if i >= len(e.ips) || primaryNetwork(opt) != store.LabNetwork {
+			continue
+		}
+		ip := e.net.FormatIP(e.ips[i])
+		services = append(services, exposed(e.tag, ip, opt.Records)...)
+	}
+
+	return services
+}
+
+func exposed(tag store.Tag, ip string, records []store.RecordConfig) []Service {
+	var services []Service
+	for _, r := range records {
+		for _, ex := range r.Expose {
+			services = append(services, Service{
+				Tag:      tag,
+				Name:     ex.Name,
+				Protocol: ex.ServiceProtocol(),
+				Address:  net.JoinHostPort(ip, strconv.Itoa(int(ex.Port))),
+			})
+		}
+	}
+
+	return services
+}
+
+// uniqueServices drops services with a name taken by a service
+// of an exercise added earlier to the lab, as names are only
+// unique within an exercise
+func uniqueServices(services []Service) []Service {
+	var res []Service
+	names := map[string]bool{}
+	for _, s := range services {
+		if names[s.Name] {
+			log.Warn().Str("service", s.Name).Str("exercise", string(s.Tag)).Msg("Service name is taken by another exercise, service is not forwarded")
+			continue
+		}
+		names[s.Name] = true
+		res = append(res, s)
+	}
+
+	return res
+}
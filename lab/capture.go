@@ -0,0 +1,28 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aau-network-security/haaukins/network/capture"
+)
+
+var (
+	ErrNoCapture = errors.New("traffic of the lab is not captured")
+)
+
+// Capture writes the captured traffic of the lab as a gzipped tarball
+// of pcap files, nothing is written when there is no capture
+func (l *lab) Capture(ctx context.Context, w io.Writer) error {
+	conf := l.environment.CaptureConfig()
+	if conf == nil {
+		return ErrNoCapture
+	}
+
+	return capture.WriteArchive(conf.Directory, w)
+}
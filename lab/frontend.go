@@ -0,0 +1,203 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aau-network-security/haaukins/network/dns"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/libvirt"
+	"github.com/aau-network-security/haaukins/virtual/vbox"
+)
+
+const (
+	VBoxFrontend    = "vbox"
+	DockerFrontend  = "docker"
+	LibvirtFrontend = "libvirt"
+
+	// ports of the remote desktop servers inside docker frontends
+	dockerRDPPort = "3389/tcp"
+	dockerVNCPort = "5900/tcp"
+)
+
+var (
+	ErrUnsupportedProtocol = errors.New("frontend provider does not support the protocol")
+	ErrNoLabNetwork        = errors.New("lab has no docker network to attach the frontend to")
+)
+
+type UnknownProviderErr struct {
+	Provider string
+}
+
+func (err UnknownProviderErr) Error() string {
+	return fmt.Sprintf("unknown frontend provider: %s", err.Provider)
+}
+
+// Frontend is a machine of a lab which teams
+// access through the RDP connections of guacamole
+type Frontend interface {
+	virtual.Instance
+}
+
+// FrontendConn is the remote desktop connection of a frontend
+type FrontendConn struct {
+	Port     uint
+	Protocol string
+}
+
+func frontendProtocol(conf store.InstanceConfig) string {
+	if conf.Protocol == "" {
+		return store.RDPProtocol
+	}
+	return conf.Protocol
+}
+
+// FrontendSpec describes how a frontend is attached to the lab,
+// providers pick the fields which apply to their kind of machine
+type FrontendSpec struct {
+	// Bridge is the host interface of the lab network
+	Bridge string
+	// Network is the docker network of the lab
+	Network docker.Network
+	// DNS is the address of the DNS server of the lab
+	DNS string
+	// RDPHost and RDPPort is the address on the host where the
+	// remote desktop (RDP or VNC) of the frontend must be reachable
+	RDPHost string
+	RDPPort uint
+}
+
+// FrontendProvider creates frontends which are
+// not started yet from an instance configuration
+type FrontendProvider interface {
+	NewFrontend(context.Context, store.InstanceConfig, FrontendSpec) (Frontend, error)
+}
+
+// FrontendProviders maps the provider names of instance
+// configurations to providers, an empty name is VirtualBox
+type FrontendProviders map[string]FrontendProvider
+
+func NewFrontendProviders(vlib vbox.Library, llib libvirt.Library) FrontendProviders {
+	return FrontendProviders{
+		VBoxFrontend:    &vboxProvider{lib: vlib},
+		DockerFrontend:  &dockerProvider{},
+		LibvirtFrontend: &libvirtProvider{lib: llib},
+	}
+}
+
+func (fp FrontendProviders) get(name string) (FrontendProvider, error) {
+	if name == "" {
+		name = VBoxFrontend
+	}
+
+	p, ok := fp[name]
+	if !ok {
+		return nil, UnknownProviderErr{name}
+	}
+
+	return p, nil
+}
+
+type vboxProvider struct {
+	lib vbox.Library
+}
+
+func (p *vboxProvider) NewFrontend(ctx context.Context, conf store.InstanceConfig, spec FrontendSpec) (Frontend, error) {
+	// the remote display of VirtualBox only speaks RDP
+	if frontendProtocol(conf) != store.RDPProtocol {
+		return nil, ErrUnsupportedProtocol
+	}
+
+	return p.lib.GetCopy(
+		ctx,
+		conf,
+		vbox.SetBridge(spec.Bridge),
+		vbox.SetLocalRDP(spec.RDPHost, spec.RDPPort),
+		vbox.SetRAM(conf.MemoryMB),
+	)
+}
+
+type libvirtProvider struct {
+	lib libvirt.Library
+}
+
+func (p *libvirtProvider) NewFrontend(ctx context.Context, conf store.InstanceConfig, spec FrontendSpec) (Frontend, error) {
+	display := libvirt.SetLocalRDP(spec.RDPHost, spec.RDPPort)
+	if frontendProtocol(conf) == store.VNCProtocol {
+		display = libvirt.SetLocalVNC(spec.RDPHost, spec.RDPPort)
+	}
+
+	return p.lib.GetCopy(
+		ctx,
+		conf,
+		libvirt.SetBridge(spec.Bridge),
+		display,
+	)
+}
+
+// dockerProvider runs desktop containers (e.g. Kali with xrdp)
+// which publish their RDP or VNC server on the host and are
+// connected to the lab network next to the exercises
+type dockerProvider struct{}
+
+func (p *dockerProvider) NewFrontend(ctx context.Context, conf store.InstanceConfig, spec FrontendSpec) (Frontend, error) {
+	// the desktop has to join the docker network of the lab
+	if spec.Network == nil {
+		return nil, ErrNoLabNetwork
+	}
+
+	guestPort := dockerRDPPort
+	if frontendProtocol(conf) == store.VNCProtocol {
+		guestPort = dockerVNCPort
+	}
+
+	c := docker.NewContainer(docker.ContainerConfig{
+		Image: conf.Image,
+		PortBindings: map[string]string{
+			guestPort: fmt.Sprintf("%s:%d", spec.RDPHost, spec.RDPPort),
+		},
+		Labels: map[string]string{
+			"hkn": "lab_frontend",
+		},
+		Resources: &docker.Resources{
+			MemoryMB: conf.MemoryMB,
+			CPU:      conf.CPU,
+		},
+		DNS: []string{spec.DNS},
+		// the RDP port is published through the default bridge
+		UseBridge: true,
+	})
+
+	if err := c.Create(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := spec.Network.Connect(c); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (l *lab) frontendSpec(rdpHost string, rdpPort uint) FrontendSpec {
+	spec := FrontendSpec{
+		Bridge:  l.environment.NetworkInterface(),
+		RDPHost: rdpHost,
+		RDPPort: rdpPort,
+	}
+
+	if net := l.environment.Network(); net != nil {
+		spec.Network = net
+		spec.DNS = net.FormatIP(dns.PreferedIP)
+	}
+
+	return spec
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	rdpDialTimeout = 2 * time.Second
+)
+
+var (
+	ErrUnrepairableLab = errors.New("Lab is still unhealthy after repair")
+)
+
+// FrontendHealth contains the state of a frontend and
+// whether its RDP port accepts connections
+type FrontendHealth struct {
+	Port     uint
+	State    virtual.State
+	RDPAlive bool
+}
+
+// Healthy returns true for running frontends with a listening
+// RDP port and for frontends which are suspended on purpose
+func (fh FrontendHealth) Healthy() bool {
+	switch fh.State {
+	case virtual.Running:
+		return fh.RDPAlive
+	case virtual.Suspended:
+		return true
+	}
+	return false
+}
+
+// Health is the result of probing a lab
+type Health struct {
+	Tag         string
+	CheckedAt   time.Time
+	Environment exercise.Health
+	Frontends   []FrontendHealth
+	Repairs     int
+	LastRepair  time.Time
+}
+
+func (h Health) Healthy() bool {
+	if !h.Environment.Healthy() {
+		return false
+	}
+	for _, f := range h.Frontends {
+		if !f.Healthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// Health probes the exercise environment, the frontends and
+// the RDP ports of the frontends which are running
+func (l *lab) Health() Health {
+	l.m.Lock()
+	h := Health{
+		Tag:        l.tag,
+		CheckedAt:  time.Now(),
+		Repairs:    l.repairs,
+		LastRepair: l.lastRepair,
+	}
+	l.m.Unlock()
+
+	h.Environment = l.environment.Health()
+
+	hostIp, err := l.rdpHostIP()
+	if err != nil {
+		log.Warn().Msgf("Unable to get rdp host ip for health check of lab %s: %v", l.tag, err)
+	}
+	for port, fconf := range l.frontends {
+		fh := FrontendHealth{
+			Port:  port,
+			State: fconf.vm.Info().State,
+		}
+		if fh.State == virtual.Running && hostIp != "" {
+			fh.RDPAlive = isPortAlive(hostIp, port)
+		}
+		h.Frontends = append(h.Frontends, fh)
+	}
+
+	return h
+}
+
+// Repair restarts failed exercises and frontends of the lab, when
+// the lab is still unhealthy (or DNS/DHCP servers are down) the
+// entire lab is restarted which recreates all of its containers
+// while keeping the network and RDP ports of the lab
+func (l *lab) Repair(ctx context.Context) (Health, error) {
+	h := l.Health()
+	if h.Healthy() {
+		return h, nil
+	}
+
+	l.m.Lock()
+	l.repairs += 1
+	l.lastRepair = time.Now()
+	l.m.Unlock()
+
+	if h.Environment.DNS && h.Environment.DHCP {
+		for _, tag := range h.Environment.FailedExercises() {
+			log.Info().Str("lab", l.tag).Str("exercise", tag).Msg("Restarting unhealthy exercise")
+			if err := l.environment.ResetByTag(ctx, tag); err != nil {
+				log.Error().Msgf("Error while restarting exercise %s in lab %s: %v", tag, l.tag, err)
+			}
+		}
+
+		for _, fh := range h.Frontends {
+			if fh.Healthy() {
+				continue
+			}
+			log.Info().Str("lab", l.tag).Uint("port", fh.Port).Msg("Restarting unhealthy frontend")
+			if err := restartVM(ctx, l.frontends[fh.Port].vm); err != nil {
+				log.Error().Msgf("Error while restarting frontend on port %d in lab %s: %v", fh.Port, l.tag, err)
+			}
+		}
+
+		if h = l.Health(); h.Healthy() {
+			return h, nil
+		}
+	}
+
+	log.Info().Str("lab", l.tag).Msg("Restarting entire lab")
+	if err := l.Restart(ctx); err != nil {
+		return l.Health(), err
+	}
+
+	if h = l.Health(); !h.Healthy() {
+		return h, ErrUnrepairableLab
+	}
+
+	return h, nil
+}
+
+func isPortAlive(host string, port uint) bool {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), rdpDialTimeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
@@ -7,15 +7,29 @@ package lab
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	// creating a lab is retried with an exponential backoff,
+	// starting at the interval and capped at the max interval
+	labRetryInterval    = 30 * time.Second
+	labMaxRetryInterval = 10 * time.Minute
+	labRetryLimit       = 5
+	// after giving up, creating the lab is tried again at this
+	// interval, such that the hub recovers from an outage
+	labRecoverInterval = 10 * time.Minute
+)
+
 var (
 	ErrBufferSize = errors.New("Buffer cannot be larger than capacity")
 	ErrNoLabByTag = errors.New("Could not find lab by the specified tag")
+	ErrHubClosed  = errors.New("Lab hub is closed")
 )
 
 type Hub interface {
@@ -24,6 +38,7 @@ type Hub interface {
 	Suspend(context.Context) error
 	Resume(context.Context) error
 	Update(labTag <-chan Lab)
+	Discard(Lab)
 	Labs() map[string]Lab
 	UpdateExercises(exercises []store.Exercise)
 }
@@ -33,6 +48,7 @@ type hub struct {
 	queue           <-chan Lab
 	update          <-chan Lab
 	deallocatedLabs chan<- Lab
+	discarded       chan<- Lab
 	labs            map[string]Lab
 	stop            chan struct{}
 }
@@ -47,21 +63,86 @@ func NewHub(creator Creator, buffer int, cap int, isVPN int32) (*hub, error) {
 	labs := make(chan Lab, buffer-workerAmount)
 	queue := make(chan Lab, buffer-workerAmount)
 	deallocated := make(chan Lab, cap)
+	discarded := make(chan Lab, cap)
+	replaced := make(chan Lab)
+
+	// newLab creates and starts a lab, labs which are still
+	// unhealthy after repair are closed instead of being queued
+	newLab := func(ctx context.Context) (Lab, error) {
+		l, err := creator.NewLab(ctx, isVPN)
+		if err != nil {
+			return nil, fmt.Errorf("creating new lab: %v", err)
+		}
+
+		if err := l.Start(ctx); err != nil {
+			log.Error().Msgf("Error while starting lab %s", err.Error())
+		}
+
+		if _, err := l.Repair(ctx); err != nil {
+			if err := l.Close(); err != nil {
+				log.Error().Msgf("Error while closing unhealthy lab %s", err.Error())
+			}
+			return nil, fmt.Errorf("repairing lab %s: %v", l.Tag(), err)
+		}
+
+		return l, nil
+	}
+
+	// createLab retries to create a lab with a growing interval,
+	// the error is returned when the retry limit is reached or
+	// when the hub is closed
+	createLab := func(ctx context.Context) (Lab, error) {
+		interval := labRetryInterval
+		l, err := newLab(ctx)
+		for attempt := 1; err != nil; attempt++ {
+			if attempt >= labRetryLimit {
+				return nil, fmt.Errorf("giving up after %d attempts: %v", attempt, err)
+			}
+			log.Error().Msgf("Error while creating new lab, retrying in %s: %s", interval, err.Error())
+			select {
+			case <-time.After(interval):
+				l, err = newLab(ctx)
+			case <-stop:
+				return nil, ErrHubClosed
+			}
+
+			interval *= 2
+			if interval > labMaxRetryInterval {
+				interval = labMaxRetryInterval
+			}
+		}
+
+		return l, nil
+	}
+
+	// keepCreating creates a lab until it succeeds or the hub is closed,
+	// the place of the lab is kept while its creation keeps failing
+	keepCreating := func(ctx context.Context) (Lab, error) {
+		l, err := createLab(ctx)
+		for err != nil && err != ErrHubClosed {
+			log.Error().Msgf("Error while creating new lab, trying again in %s: %s", labRecoverInterval, err.Error())
+			select {
+			case <-time.After(labRecoverInterval):
+				l, err = createLab(ctx)
+			case <-stop:
+				return nil, ErrHubClosed
+			}
+		}
+
+		return l, err
+	}
 
 	var wg sync.WaitGroup
 	worker := func() {
 		ctx := context.Background()
 		for range ready {
 			wg.Add(1)
-			// todo: handle this in case of error
-			l, err := creator.NewLab(ctx, isVPN)
+			l, err := keepCreating(ctx)
 			if err != nil {
-				log.Error().Msgf("Error while creating new lab %s", err.Error())
+				wg.Done()
+				continue
 			}
 
-			if err := l.Start(ctx); err != nil {
-				log.Error().Msgf("Error while starting lab %s", err.Error())
-			}
 			select {
 			case labs <- l:
 				wg.Done()
@@ -77,6 +158,22 @@ func NewHub(creator Creator, buffer int, cap int, isVPN int32) (*hub, error) {
 		}
 	}
 
+	// replace creates a lab which takes the place of a discarded lab
+	replace := func() {
+		l, err := keepCreating(context.Background())
+		if err != nil {
+			return
+		}
+
+		select {
+		case replaced <- l:
+		case <-stop:
+			if err := l.Close(); err != nil {
+				log.Error().Msgf("Error while closing lab %s", err.Error())
+			}
+		}
+	}
+
 	for i := 0; i < workerAmount; i++ {
 		go worker()
 		ready <- struct{}{}
@@ -119,6 +216,17 @@ func NewHub(creator Creator, buffer int, cap int, isVPN int32) (*hub, error) {
 
 				ready <- struct{}{}
 
+			case l := <-discarded:
+				delete(startedLabs, l.Tag())
+				go replace()
+
+			case l := <-replaced:
+				startedLabs[l.Tag()] = l
+				select {
+				case queue <- l:
+				case <-stop:
+				}
+
 			case <-stop:
 				// wait for workers to finish starting labs
 				wg.Wait()
@@ -155,6 +263,7 @@ func NewHub(creator Creator, buffer int, cap int, isVPN int32) (*hub, error) {
 		stop:            stop,
 		labs:            startedLabs,
 		deallocatedLabs: deallocated,
+		discarded:       discarded,
 	}, nil
 }
 
@@ -172,6 +281,19 @@ func (h *hub) Update(lab <-chan Lab) {
 	h.deallocatedLabs <- lb
 }
 
+// Discard closes a lab which cannot be used anymore, e.g. as it
+// could not be repaired, and creates a lab to take its place
+func (h *hub) Discard(l Lab) {
+	if err := l.Close(); err != nil {
+		log.Error().Msgf("Error while closing discarded lab %s", err.Error())
+	}
+
+	select {
+	case h.discarded <- l:
+	case <-h.stop:
+	}
+}
+
 func (h *hub) Suspend(ctx context.Context) error {
 	var suspendError error
 	var wg sync.WaitGroup
@@ -6,6 +6,8 @@ package lab
 
 import (
 	"context"
+	"errors"
+	"io"
 	"math"
 	"sync"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	"github.com/aau-network-security/haaukins/exercise"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/google/uuid"
 )
 
@@ -60,10 +63,42 @@ func (tl *testLab) Environment() exercise.Environment {
 	return nil
 }
 
-func (tl *testLab) ResetFrontends(context.Context) error {
+func (tl *testLab) ResetFrontends(ctx context.Context, eventTag, teamId string) error {
 	return nil
 }
 
+func (tl *testLab) Health() Health {
+	return Health{}
+}
+
+func (tl *testLab) Repair(context.Context) (Health, error) {
+	return Health{}, nil
+}
+
+func (tl *testLab) TakeSnapshot(context.Context, string) (Snapshot, error) {
+	return Snapshot{}, nil
+}
+
+func (tl *testLab) RestoreSnapshot(ctx context.Context, name, eventTag, teamId string) error {
+	return nil
+}
+
+func (tl *testLab) DeleteSnapshot(context.Context, string) error {
+	return nil
+}
+
+func (tl *testLab) Snapshots() []Snapshot {
+	return nil
+}
+
+func (tl *testLab) Terminal(context.Context) (docker.Shell, error) {
+	return nil, ErrNoTerminal
+}
+
+func (tl *testLab) Capture(context.Context, io.Writer) error {
+	return ErrNoCapture
+}
+
 func (tl *testLab) Tag() string {
 	return uuid.New().String()
 }
@@ -72,10 +107,18 @@ func (tl *testLab) InstanceInfo() []virtual.InstanceInfo {
 	return nil
 }
 
+func (tl *testLab) Usage(context.Context) virtual.Usage {
+	return virtual.Usage{}
+}
+
 func (tl *testLab) RdpConnPorts() []uint {
 	return nil
 }
 
+func (tl *testLab) FrontendConns() []FrontendConn {
+	return nil
+}
+
 type testCreator struct {
 	m       sync.Mutex
 	lab     Lab
@@ -175,3 +218,125 @@ func readAmountChan(c <-chan bool, amount int, wait time.Duration) int {
 func MinInt(i, j int) int {
 	return int(math.Min(float64(i), float64(j)))
 }
+
+type failingCreator struct {
+	testCreator
+	failures int
+}
+
+func (c *failingCreator) NewLab(ctx context.Context, isVPN int32) (Lab, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.failures > 0 {
+		c.failures -= 1
+		c.started += 1
+		return nil, errors.New("unable to create lab")
+	}
+	c.started += 1
+	return c.lab, nil
+}
+
+func (c *failingCreator) attempts() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.started
+}
+
+func TestHubRetry(t *testing.T) {
+	interval, max, recover := labRetryInterval, labMaxRetryInterval, labRecoverInterval
+	labRetryInterval, labMaxRetryInterval, labRecoverInterval = time.Millisecond, 4*time.Millisecond, 50*time.Millisecond
+	defer func() {
+		labRetryInterval, labMaxRetryInterval, labRecoverInterval = interval, max, recover
+	}()
+
+	tt := []struct {
+		name     string
+		failures int
+		attempts int
+		ready    bool
+	}{
+		{name: "Recovers", failures: labRetryLimit - 1, attempts: labRetryLimit, ready: true},
+		{name: "Gives up", failures: 1000, attempts: labRetryLimit},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			closed := make(chan bool, 1000)
+			c := &failingCreator{
+				testCreator: testCreator{lab: &testLab{make(chan bool, 1000), nil, nil, closed}},
+				// the hub starts two labs at once
+				failures: 2 * tc.failures,
+			}
+			h, err := NewHub(c, 2, 2, 0)
+			if err != nil {
+				t.Fatalf("unable to create hub: %s", err)
+			}
+			defer h.Close()
+
+			select {
+			case <-h.Queue():
+				if !tc.ready {
+					t.Fatalf("expected no lab to be created")
+				}
+			case <-time.After(500 * time.Millisecond):
+				if tc.ready {
+					t.Fatalf("expected lab to be created after retrying")
+				}
+			}
+
+			if attempts := c.attempts(); attempts < tc.attempts || (tc.ready && attempts > 2*tc.attempts) {
+				t.Fatalf("expected %d attempts per lab, got %d in total", tc.attempts, attempts)
+			}
+
+			// the hub keeps trying after giving up, both
+			// workers produce labs once the failure cleared
+			c.m.Lock()
+			c.failures = 0
+			c.m.Unlock()
+			labs := 2
+			if tc.ready {
+				labs = 1
+			}
+			for i := 0; i < labs; i++ {
+				select {
+				case <-h.Queue():
+				case <-time.After(time.Second):
+					t.Fatalf("expected labs to be created once creating labs succeeds again")
+				}
+			}
+		})
+	}
+}
+
+func TestHubDiscard(t *testing.T) {
+	started := make(chan bool, 1000)
+	closed := make(chan bool, 1000)
+	c := &testCreator{lab: &testLab{started, nil, nil, closed}}
+	h, err := NewHub(c, 2, 2, 0)
+	if err != nil {
+		t.Fatalf("unable to create hub: %s", err)
+	}
+	defer h.Close()
+
+	if n := readAmountChan(started, 2, time.Second); n != 2 {
+		t.Fatalf("expected 2 labs to be started, but %d are started", n)
+	}
+
+	l := <-h.Queue()
+	h.Discard(l)
+	if n := readAmountChan(closed, 1, time.Second); n != 1 {
+		t.Fatalf("expected discarded lab to be closed")
+	}
+
+	<-h.Queue()
+	// the lab is replaced even though the capacity is reached
+	if n := readAmountChan(started, 1, time.Second); n != 1 {
+		t.Fatalf("expected discarded lab to be replaced")
+	}
+	select {
+	case <-h.Queue():
+	case <-time.After(time.Second):
+		t.Fatalf("expected replacement to be queued")
+	}
+}
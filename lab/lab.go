@@ -7,14 +7,19 @@ package lab
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
+	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/network/capture"
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/kube"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
 	"github.com/docker/docker/pkg/namesgenerator"
 	"github.com/rs/zerolog/log"
@@ -28,6 +33,21 @@ type Config struct {
 	Frontends         []store.InstanceConfig
 	Exercises         []store.Exercise
 	DisabledExercises []store.Tag
+	Snapshots         SnapshotPolicy
+	// Capture records the traffic of the lab network
+	// into a directory per lab when set
+	Capture *capture.Config
+	// IPv6 makes the lab network dual-stack
+	IPv6 bool
+	// Subnet is the subnet of the lab network, which is allocated
+	// by the daemon for labs on workers and locally when empty
+	Subnet string
+	// Terminal adds an attacker container to the lab
+	// which teams reach through their browser
+	Terminal *TerminalConfig
+	// TerminalExercises are the exercises reachable from the
+	// terminal, the instances of other exercises are hidden
+	TerminalExercises []store.Tag
 }
 
 func (conf Config) Flags() []store.ChildrenChalConfig {
@@ -61,8 +81,15 @@ type Creator interface {
 }
 
 type LabHost struct {
-	Vlib vbox.Library
-	Conf Config
+	Vlib      vbox.Library
+	Providers FrontendProviders
+	// Cluster runs the exercises on Kubernetes
+	// instead of the local docker daemon when set
+	Cluster *kube.Cluster
+	// RDPHost is the address the remote desktops of frontends
+	// are bound to, the docker host address is used when empty
+	RDPHost string
+	Conf    Config
 }
 
 func (lh *LabHost) UpdateExercises(newExercises []store.Exercise) {
@@ -72,6 +99,15 @@ func (lh *LabHost) UpdateExercises(newExercises []store.Exercise) {
 
 func (lh *LabHost) NewLab(ctx context.Context, isVPN int32) (Lab, error) {
 	env := newEnvironment(lh.Vlib)
+	if lh.Cluster != nil {
+		env = exercise.NewKubeEnvironment(lh.Cluster)
+	}
+	if lh.Conf.IPv6 {
+		env.EnableIPv6()
+	}
+	if lh.Conf.Subnet != "" {
+		env.SetLabSubnet(lh.Conf.Subnet)
+	}
 	if err := env.Create(ctx, isVPN); err != nil {
 		return nil, fmt.Errorf("new environment create err %v ", err)
 	}
@@ -86,13 +122,27 @@ func (lh *LabHost) NewLab(ctx context.Context, isVPN int32) (Lab, error) {
 
 	env.SetDisabledExercises(lh.Conf.DisabledExercises)
 
+	tag := generateTag()
+	if lh.Conf.Capture != nil {
+		conf := *lh.Conf.Capture
+		conf.Directory = filepath.Join(conf.Directory, tag)
+		env.SetCapture(conf)
+	}
+
+	providers := lh.Providers
+	if providers == nil {
+		providers = FrontendProviders{VBoxFrontend: &vboxProvider{lib: lh.Vlib}}
+	}
+
 	dockerHost := docker.NewHost()
 	l := &lab{
-		tag:         generateTag(),
-		lib:         lh.Vlib,
-		environment: env,
-		dockerHost:  dockerHost,
-		frontends:   map[uint]frontendConf{},
+		tag:            tag,
+		providers:      providers,
+		environment:    env,
+		dockerHost:     dockerHost,
+		frontends:      map[uint]frontendConf{},
+		snapshotPolicy: lh.Conf.Snapshots,
+		rdpHost:        lh.RDPHost,
 	}
 
 	for _, f := range lh.Conf.Frontends {
@@ -102,6 +152,13 @@ func (lh *LabHost) NewLab(ctx context.Context, isVPN int32) (Lab, error) {
 		}
 	}
 
+	if lh.Conf.Terminal != nil {
+		if err := l.addTerminal(ctx, *lh.Conf.Terminal); err != nil {
+			return nil, fmt.Errorf("new terminal container err %v ", err)
+		}
+		l.terminalExercises = lh.Conf.TerminalExercises
+	}
+
 	return l, nil
 }
 
@@ -114,38 +171,58 @@ type Lab interface {
 	Environment() exercise.Environment
 	ResetFrontends(ctx context.Context, eventTag, teamId string) error
 	RdpConnPorts() []uint
+	FrontendConns() []FrontendConn
 	Tag() string
 	AddChallenge(ctx context.Context, confs ...store.Exercise) error
 	InstanceInfo() []virtual.InstanceInfo
+	Usage(context.Context) virtual.Usage
+	Health() Health
+	Repair(context.Context) (Health, error)
+	TakeSnapshot(ctx context.Context, name string) (Snapshot, error)
+	RestoreSnapshot(ctx context.Context, name, eventTag, teamId string) error
+	DeleteSnapshot(ctx context.Context, name string) error
+	Snapshots() []Snapshot
+	Terminal(context.Context) (docker.Shell, error)
+	Capture(ctx context.Context, w io.Writer) error
 	Close() error
 }
 
 type lab struct {
-	tag         string
-	lib         vbox.Library
-	environment exercise.Environment
-	frontends   map[uint]frontendConf
-	dockerHost  docker.Host
+	m              sync.Mutex
+	tag            string
+	providers      FrontendProviders
+	environment    exercise.Environment
+	frontends      map[uint]frontendConf
+	dockerHost     docker.Host
+	repairs        int
+	lastRepair     time.Time
+	snapM          sync.Mutex
+	snapshots      []Snapshot
+	snapshotPolicy SnapshotPolicy
+	rdpHost        string
+	terminal       docker.Container
+	terminalConf   TerminalConfig
+	// terminalExercises are reachable from the terminal
+	terminalExercises []store.Tag
 }
 
 type frontendConf struct {
-	vm   vbox.VM
+	vm   Frontend
 	conf store.InstanceConfig
 }
 
-func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPort uint) (vbox.VM, error) {
-	hostIp, err := l.dockerHost.GetDockerHostIP()
+func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPort uint) (Frontend, error) {
+	provider, err := l.providers.get(conf.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	hostIp, err := l.rdpHostIP()
 	if err != nil {
 		return nil, err
 	}
 
-	vm, err := l.lib.GetCopy(
-		ctx,
-		conf,
-		vbox.SetBridge(l.environment.NetworkInterface()),
-		vbox.SetLocalRDP(hostIp, rdpPort),
-		vbox.SetRAM(conf.MemoryMB),
-	)
+	vm, err := provider.NewFrontend(ctx, conf, l.frontendSpec(hostIp, rdpPort))
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +237,15 @@ func (l *lab) addFrontend(ctx context.Context, conf store.InstanceConfig, rdpPor
 	return vm, nil
 }
 
+// rdpHostIP is the address which remote desktops of the frontends are bound to
+func (l *lab) rdpHostIP() (string, error) {
+	if l.rdpHost != "" {
+		return l.rdpHost, nil
+	}
+
+	return l.dockerHost.GetDockerHostIP()
+}
+
 func (l *lab) AddChallenge(ctx context.Context, confs ...store.Exercise) error {
 	var waitGroup sync.WaitGroup
 	var startByTagError error
@@ -182,8 +268,12 @@ func (l *lab) AddChallenge(ctx context.Context, confs ...store.Exercise) error {
 		}()
 		waitGroup.Wait()
 	}
+	if startByTagError != nil {
+		return startByTagError
+	}
 
-	return startByTagError
+	// the exercises may add segments to the lab
+	return l.setTerminalRoutes()
 }
 
 func (l *lab) Environment() exercise.Environment {
@@ -193,6 +283,7 @@ func (l *lab) Environment() exercise.Environment {
 func (l *lab) ResetFrontends(ctx context.Context, eventTag, teamId string) error {
 	var errs []error
 	for p, vmConf := range l.frontends {
+		l.deleteSnapshots(ctx, vmConf.vm)
 		err := vmConf.vm.Close()
 		if err != nil {
 			errs = append(errs, err)
@@ -211,12 +302,17 @@ func (l *lab) ResetFrontends(ctx context.Context, eventTag, teamId string) error
 			continue
 		}
 
-		err = vbox.CreateFolderLink(vm.Info().Id, eventTag, teamId)
+		err = createFolderLink(vm, eventTag, teamId)
 		if err != nil {
 			log.Logger.Debug().Msgf("Error creating shared folder link after vm reset: %s", err)
 		}
 	}
 
+	// snapshots belong to the frontends which are thrown away
+	l.snapM.Lock()
+	l.snapshots = nil
+	l.snapM.Unlock()
+
 	if len(errs) > 0 {
 		return errs[0]
 	}
@@ -224,6 +320,16 @@ func (l *lab) ResetFrontends(ctx context.Context, eventTag, teamId string) error
 	return nil
 }
 
+// createFolderLink links the shared folder of the team
+// into frontends which are VirtualBox VMs
+func createFolderLink(vm Frontend, eventTag, teamId string) error {
+	info := vm.Info()
+	if info.Type != vbox.InstanceType {
+		return nil
+	}
+	return vbox.CreateFolderLink(info.Id, eventTag, teamId)
+}
+
 func (l *lab) Start(ctx context.Context) error {
 	if err := l.environment.Start(ctx); err != nil {
 		return err
@@ -233,7 +339,9 @@ func (l *lab) Start(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+	return l.terminalAction(func(c virtual.Instance) error {
+		return c.Start(ctx)
+	})
 }
 
 func (l *lab) Stop() error {
@@ -247,7 +355,9 @@ func (l *lab) Stop() error {
 		}
 	}
 
-	return nil
+	return l.terminalAction(func(c virtual.Instance) error {
+		return c.Stop()
+	})
 }
 
 func (l *lab) Restart(ctx context.Context) error {
@@ -257,36 +367,46 @@ func (l *lab) Restart(ctx context.Context) error {
 	}
 
 	for _, fconf := range l.frontends {
-		switch fconf.vm.Info().State {
-		case virtual.Running:
-			if err := fconf.vm.Stop(); err != nil {
-				return err
-			}
-			if err := fconf.vm.Start(ctx); err != nil {
-				return err
-			}
-		case virtual.Stopped:
-			if err := fconf.vm.Start(ctx); err != nil {
-				return err
-			}
-		case virtual.Suspended:
-			if err := fconf.vm.Start(ctx); err != nil {
-				return err
-			}
-			if err := fconf.vm.Stop(); err != nil {
-				return err
-			}
-			if err := fconf.vm.Start(ctx); err != nil {
-				return err
-			}
+		if err := restartVM(ctx, fconf.vm); err != nil {
+			return err
+		}
+	}
 
-		case virtual.Error:
-			if err := fconf.vm.Create(ctx); err != nil {
-				return err
-			}
-			if err := fconf.vm.Start(ctx); err != nil {
-				return err
-			}
+	return l.terminalAction(func(c virtual.Instance) error {
+		return restartVM(ctx, c)
+	})
+}
+
+func restartVM(ctx context.Context, vm Frontend) error {
+	switch vm.Info().State {
+	case virtual.Running:
+		if err := vm.Stop(); err != nil {
+			return err
+		}
+		if err := vm.Start(ctx); err != nil {
+			return err
+		}
+	case virtual.Stopped:
+		if err := vm.Start(ctx); err != nil {
+			return err
+		}
+	case virtual.Suspended:
+		if err := vm.Start(ctx); err != nil {
+			return err
+		}
+		if err := vm.Stop(); err != nil {
+			return err
+		}
+		if err := vm.Start(ctx); err != nil {
+			return err
+		}
+
+	case virtual.Error:
+		if err := vm.Create(ctx); err != nil {
+			return err
+		}
+		if err := vm.Start(ctx); err != nil {
+			return err
 		}
 	}
 
@@ -298,9 +418,10 @@ func (l *lab) Close() error {
 
 	for _, lab := range l.frontends {
 		wg.Add(1)
-		go func(vm vbox.VM) {
+		go func(vm Frontend) {
 			// closing VMs....
 			defer wg.Done()
+			l.deleteSnapshots(context.Background(), vm)
 			if err := vm.Close(); err != nil {
 				log.Error().Msgf("Error on Close function in lab.go %s", err)
 			}
@@ -315,7 +436,21 @@ func (l *lab) Close() error {
 		}
 
 	}(l.environment)
+	if l.terminal != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.terminal.Close(); err != nil {
+				log.Error().Msgf("Error while closing terminal container %s", err)
+			}
+		}()
+	}
 	wg.Wait()
+
+	l.snapM.Lock()
+	l.snapshots = nil
+	l.snapM.Unlock()
+
 	return nil
 }
 
@@ -328,6 +463,23 @@ func (l *lab) RdpConnPorts() []uint {
 	return ports
 }
 
+// FrontendConns returns the remote desktop
+// connections of the frontends ordered by port
+func (l *lab) FrontendConns() []FrontendConn {
+	var conns []FrontendConn
+	for p, fconf := range l.frontends {
+		conns = append(conns, FrontendConn{
+			Port:     p,
+			Protocol: frontendProtocol(fconf.conf),
+		})
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].Port < conns[j].Port
+	})
+
+	return conns
+}
+
 func (l *lab) Tag() string {
 	return l.tag
 }
@@ -337,10 +489,32 @@ func (l *lab) InstanceInfo() []virtual.InstanceInfo {
 	for _, fconf := range l.frontends {
 		instances = append(instances, fconf.vm.Info())
 	}
+	if l.terminal != nil {
+		instances = append(instances, l.terminal.Info())
+	}
 	instances = append(instances, l.environment.InstanceInfo()...)
 	return instances
 }
 
+// Usage samples the resources consumed by the frontends
+// and exercises of the lab
+func (l *lab) Usage(ctx context.Context) virtual.Usage {
+	var vms []virtual.Instance
+	for _, fconf := range l.frontends {
+		vms = append(vms, fconf.vm)
+	}
+	if l.terminal != nil {
+		vms = append(vms, l.terminal)
+	}
+
+	u, err := virtual.SampleUsage(ctx, vms...)
+	if err != nil {
+		log.Warn().Str("lab", l.tag).Msgf("Unable to sample usage of frontends: %v", err)
+	}
+
+	return u.Add(l.environment.Usage(ctx))
+}
+
 func generateTag() string {
 	// seed for our GetRandomName
 	rand.Seed(time.Now().UnixNano())
@@ -361,7 +535,12 @@ func (l *lab) Suspend(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return l.terminalAction(func(c virtual.Instance) error {
+		if c.Info().State != virtual.Running {
+			return nil
+		}
+		return c.Suspend(ctx)
+	})
 }
 
 func (l *lab) Resume(ctx context.Context) error {
@@ -377,5 +556,10 @@ func (l *lab) Resume(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return l.terminalAction(func(c virtual.Instance) error {
+		if c.Info().State != virtual.Suspended {
+			return nil
+		}
+		return c.Start(ctx)
+	})
 }
@@ -10,9 +10,10 @@ import (
 	"testing"
 
 	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
-	"github.com/aau-network-security/haaukins/store"
 )
 
 type testDockerHost struct {
@@ -44,11 +45,26 @@ func (ee *testEnvironment) NetworkInterface() string {
 	return ""
 }
 
+func (ee *testEnvironment) Network() docker.Network {
+	return nil
+}
+
+type fakeProvider struct {
+	confs []store.InstanceConfig
+	specs []FrontendSpec
+}
+
+func (fp *fakeProvider) NewFrontend(ctx context.Context, conf store.InstanceConfig, spec FrontendSpec) (Frontend, error) {
+	fp.confs = append(fp.confs, conf)
+	fp.specs = append(fp.specs, spec)
+	return &testVM{}, nil
+}
+
 func TestAddFrontend(t *testing.T) {
 	lab := lab{
 		dockerHost: &testDockerHost{},
-		lib: &testVboxLibrary{
-			vm: &testVM{},
+		providers: FrontendProviders{
+			VBoxFrontend: &vboxProvider{lib: &testVboxLibrary{vm: &testVM{}}},
 		},
 		environment: &testEnvironment{},
 		frontends:   map[uint]frontendConf{},
@@ -59,3 +75,99 @@ func TestAddFrontend(t *testing.T) {
 		t.Fatalf("Expected %d frontend, but is %d", len(lab.frontends), 1)
 	}
 }
+
+func TestAddFrontendProvider(t *testing.T) {
+	vboxP, dockerP := &fakeProvider{}, &fakeProvider{}
+	lab := lab{
+		dockerHost: &testDockerHost{},
+		providers: FrontendProviders{
+			VBoxFrontend:   vboxP,
+			DockerFrontend: dockerP,
+		},
+		environment: &testEnvironment{},
+		frontends:   map[uint]frontendConf{},
+	}
+	ctx := context.Background()
+
+	confs := []store.InstanceConfig{
+		{Image: "kali"},
+		{Image: "kali-xrdp", Provider: DockerFrontend},
+	}
+	for i, conf := range confs {
+		if _, err := lab.addFrontend(ctx, conf, uint(5000+i)); err != nil {
+			t.Fatalf("unexpected error when adding frontend: %v", err)
+		}
+	}
+
+	if len(vboxP.confs) != 1 || vboxP.confs[0].Image != "kali" {
+		t.Fatalf("expected frontend without provider to be created by vbox provider, got: %v", vboxP.confs)
+	}
+	if len(dockerP.confs) != 1 || dockerP.confs[0].Image != "kali-xrdp" {
+		t.Fatalf("expected docker frontend to be created by docker provider, got: %v", dockerP.confs)
+	}
+	if spec := dockerP.specs[0]; spec.RDPHost != "1.2.3.4" || spec.RDPPort != 5001 {
+		t.Fatalf("expected frontend to be exposed on 1.2.3.4:5001, got: %s:%d", spec.RDPHost, spec.RDPPort)
+	}
+
+	_, err := lab.addFrontend(ctx, store.InstanceConfig{Image: "kali", Provider: LibvirtFrontend}, 5002)
+	if _, ok := err.(UnknownProviderErr); !ok {
+		t.Fatalf("expected unknown provider error, got: %v", err)
+	}
+	if len(lab.frontends) != 2 {
+		t.Fatalf("expected 2 frontends, but got %d", len(lab.frontends))
+	}
+}
+
+func TestLabHealthy(t *testing.T) {
+	healthyEnv := exercise.Health{
+		DNS:  true,
+		DHCP: true,
+		Exercises: []exercise.ExerciseHealth{
+			{Tag: "sql", Instances: []virtual.InstanceInfo{{State: virtual.Running}}},
+			{Tag: "xss", Disabled: true, Instances: []virtual.InstanceInfo{{State: virtual.Stopped}}},
+		},
+	}
+
+	tt := []struct {
+		name    string
+		health  Health
+		healthy bool
+	}{
+		{
+			name:    "Normal",
+			health:  Health{Environment: healthyEnv, Frontends: []FrontendHealth{{State: virtual.Running, RDPAlive: true}}},
+			healthy: true,
+		},
+		{
+			name:    "Suspended frontend",
+			health:  Health{Environment: healthyEnv, Frontends: []FrontendHealth{{State: virtual.Suspended}}},
+			healthy: true,
+		},
+		{
+			name:   "RDP not listening",
+			health: Health{Environment: healthyEnv, Frontends: []FrontendHealth{{State: virtual.Running}}},
+		},
+		{
+			name:   "DNS down",
+			health: Health{Environment: exercise.Health{DHCP: true}},
+		},
+		{
+			name: "Stopped exercise",
+			health: Health{Environment: exercise.Health{
+				DNS:  true,
+				DHCP: true,
+				Exercises: []exercise.ExerciseHealth{
+					{Tag: "sql", Instances: []virtual.InstanceInfo{{State: virtual.Running}, {State: virtual.Error}}},
+				},
+			}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if healthy := tc.health.Healthy(); healthy != tc.healthy {
+				t.Fatalf("expected healthy to be %t, but is %t", tc.healthy, healthy)
+			}
+		})
+	}
+}
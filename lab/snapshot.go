@@ -0,0 +1,255 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// snapshots of teams are prefixed to keep them apart
+	// from the snapshots used for cloning frontends
+	snapshotPrefix = "team-"
+)
+
+var (
+	ErrSnapshotLimit     = errors.New("Maximum amount of snapshots has been reached, delete one first")
+	ErrSnapshotExists    = errors.New("Snapshot with the given name already exists")
+	ErrSnapshotNotFound  = errors.New("Snapshot not found")
+	ErrSnapshotTooLarge  = errors.New("Snapshot exceeds the allowed disk usage")
+	ErrNoFrontends       = errors.New("Lab has no frontends to take snapshots of")
+	ErrNoSnapshotSupport = errors.New("Frontends of the lab do not support snapshots")
+
+	snapshotNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9 _.-]{0,31}$`)
+)
+
+// SnapshotPolicy bounds the amount of snapshots a team can
+// take of its frontends and the disk usage of each snapshot,
+// zero values are unbounded
+type SnapshotPolicy struct {
+	MaxCount int
+	MaxSize  int64
+}
+
+// Snapshot is a named snapshot of all frontends of a lab, its size is
+// the size of the differencing disks holding the changes since the
+// previous one
+type Snapshot struct {
+	Name      string
+	CreatedAt time.Time
+	Size      int64
+}
+
+// snapshotter is implemented by frontends which support snapshots
+type snapshotter interface {
+	Frontend
+	Snapshot(string) error
+	RestoreSnapshot(context.Context, string) error
+	DeleteSnapshot(context.Context, string) error
+	SnapshotSize(context.Context) (int64, error)
+}
+
+// snapshotters returns the frontends of the lab,
+// given that all of them support snapshots
+func (l *lab) snapshotters() ([]snapshotter, error) {
+	var res []snapshotter
+	for _, fconf := range l.frontends {
+		s, ok := fconf.vm.(snapshotter)
+		if !ok {
+			return nil, ErrNoSnapshotSupport
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+func validSnapshotName(name string) error {
+	if !snapshotNameRegex.MatchString(name) {
+		return fmt.Errorf("Invalid snapshot name %q, use up to 32 letters, digits, spaces, '.', '_' or '-'", name)
+	}
+	return nil
+}
+
+func vmSnapshotName(name string) string {
+	return snapshotPrefix + name
+}
+
+// TakeSnapshot takes a snapshot of every frontend of the lab, the
+// snapshot is deleted again when it exceeds the allowed disk usage
+func (l *lab) TakeSnapshot(ctx context.Context, name string) (Snapshot, error) {
+	if err := validSnapshotName(name); err != nil {
+		return Snapshot{}, err
+	}
+
+	l.snapM.Lock()
+	defer l.snapM.Unlock()
+
+	if len(l.frontends) == 0 {
+		return Snapshot{}, ErrNoFrontends
+	}
+	if _, ok := l.findSnapshot(name); ok {
+		return Snapshot{}, ErrSnapshotExists
+	}
+	if max := l.snapshotPolicy.MaxCount; max > 0 && len(l.snapshots) >= max {
+		return Snapshot{}, ErrSnapshotLimit
+	}
+
+	vms, err := l.snapshotters()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var taken []snapshotter
+	var size int64
+	for _, vm := range vms {
+		if err := vm.Snapshot(vmSnapshotName(name)); err != nil {
+			l.discardSnapshot(ctx, name, taken)
+			return Snapshot{}, err
+		}
+		taken = append(taken, vm)
+
+		s, err := vm.SnapshotSize(ctx)
+		if err != nil {
+			l.discardSnapshot(ctx, name, taken)
+			return Snapshot{}, err
+		}
+		size += s
+	}
+
+	if max := l.snapshotPolicy.MaxSize; max > 0 && size > max {
+		l.discardSnapshot(ctx, name, taken)
+		return Snapshot{}, ErrSnapshotTooLarge
+	}
+
+	s := Snapshot{
+		Name:      name,
+		CreatedAt: time.Now(),
+		Size:      size,
+	}
+	l.snapshots = append(l.snapshots, s)
+
+	log.Info().Str("lab", l.tag).Str("snapshot", name).Int64("size", size).Msg("Took snapshot of frontends")
+
+	return s, nil
+}
+
+// RestoreSnapshot rolls every frontend of the lab
+// back to the state of the given snapshot
+func (l *lab) RestoreSnapshot(ctx context.Context, name, eventTag, teamId string) error {
+	l.snapM.Lock()
+	defer l.snapM.Unlock()
+
+	if _, ok := l.findSnapshot(name); !ok {
+		return ErrSnapshotNotFound
+	}
+
+	vms, err := l.snapshotters()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, vm := range vms {
+		if err := vm.RestoreSnapshot(ctx, vmSnapshotName(name)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// shared folders are transient, so they are gone after the restore
+		if err := createFolderLink(vm, eventTag, teamId); err != nil {
+			log.Debug().Msgf("Error creating shared folder link after snapshot restore: %s", err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	log.Info().Str("lab", l.tag).Str("snapshot", name).Msg("Restored snapshot of frontends")
+
+	return nil
+}
+
+func (l *lab) DeleteSnapshot(ctx context.Context, name string) error {
+	l.snapM.Lock()
+	defer l.snapM.Unlock()
+
+	i, ok := l.findSnapshot(name)
+	if !ok {
+		return ErrSnapshotNotFound
+	}
+
+	vms, err := l.snapshotters()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, vm := range vms {
+		if err := vm.DeleteSnapshot(ctx, vmSnapshotName(name)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	l.snapshots = append(l.snapshots[:i], l.snapshots[i+1:]...)
+
+	return nil
+}
+
+// Snapshots returns the snapshots of the lab, oldest first
+func (l *lab) Snapshots() []Snapshot {
+	l.snapM.Lock()
+	defer l.snapM.Unlock()
+
+	snapshots := make([]Snapshot, len(l.snapshots))
+	copy(snapshots, l.snapshots)
+
+	return snapshots
+}
+
+func (l *lab) findSnapshot(name string) (int, bool) {
+	for i, s := range l.snapshots {
+		if s.Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (l *lab) discardSnapshot(ctx context.Context, name string, vms []snapshotter) {
+	for _, vm := range vms {
+		if err := vm.DeleteSnapshot(ctx, vmSnapshotName(name)); err != nil {
+			log.Warn().Msgf("Error while discarding snapshot %s of lab %s: %v", name, l.tag, err)
+		}
+	}
+}
+
+// deleteSnapshots removes all snapshots of the
+// given frontend before it is thrown away
+func (l *lab) deleteSnapshots(ctx context.Context, f Frontend) {
+	vm, ok := f.(snapshotter)
+	if !ok {
+		return
+	}
+
+	l.snapM.Lock()
+	snapshots := l.snapshots
+	l.snapM.Unlock()
+
+	for _, s := range snapshots {
+		if err := vm.DeleteSnapshot(ctx, vmSnapshotName(s.Name)); err != nil {
+			log.Warn().Msgf("Error while deleting snapshot %s of lab %s: %v", s.Name, l.tag, err)
+		}
+	}
+}
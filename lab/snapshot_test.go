@@ -0,0 +1,131 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/virtual/vbox"
+)
+
+type snapshotVM struct {
+	vbox.VM
+	snapshots map[string]int64
+	last      int64
+	growth    int64
+	closed    bool
+}
+
+func (vm *snapshotVM) Snapshot(name string) error {
+	if vm.snapshots == nil {
+		vm.snapshots = map[string]int64{}
+	}
+	vm.snapshots[name] = vm.growth
+	vm.last = vm.growth
+	return nil
+}
+
+func (vm *snapshotVM) DeleteSnapshot(ctx context.Context, name string) error {
+	if _, ok := vm.snapshots[name]; !ok {
+		return ErrSnapshotNotFound
+	}
+	delete(vm.snapshots, name)
+	return nil
+}
+
+func (vm *snapshotVM) SnapshotSize(context.Context) (int64, error) {
+	return vm.last, nil
+}
+
+func (vm *snapshotVM) Close() error {
+	vm.closed = true
+	return nil
+}
+
+type closingEnvironment struct {
+	testEnvironment
+}
+
+func (ce *closingEnvironment) Close() error {
+	return nil
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	vm := &snapshotVM{growth: 100}
+	l := &lab{
+		frontends:      map[uint]frontendConf{5000: {vm: vm}},
+		snapshotPolicy: SnapshotPolicy{MaxCount: 2, MaxSize: 150},
+	}
+	ctx := context.Background()
+
+	s, err := l.TakeSnapshot(ctx, "before nmap")
+	if err != nil {
+		t.Fatalf("expected no error when taking snapshot, got: %v", err)
+	}
+	if s.Size != 100 {
+		t.Fatalf("expected snapshot size of 100, got: %d", s.Size)
+	}
+	if _, err := l.TakeSnapshot(ctx, "before nmap"); err != ErrSnapshotExists {
+		t.Fatalf("expected snapshot exists error, got: %v", err)
+	}
+	if _, err := l.TakeSnapshot(ctx, "../origin"); err == nil {
+		t.Fatalf("expected error on invalid snapshot name")
+	}
+
+	vm.growth = 200
+	if _, err := l.TakeSnapshot(ctx, "too-large"); err != ErrSnapshotTooLarge {
+		t.Fatalf("expected snapshot too large error, got: %v", err)
+	}
+	if len(vm.snapshots) != 1 {
+		t.Fatalf("expected snapshot exceeding disk usage to be discarded, got: %v", vm.snapshots)
+	}
+
+	vm.growth = 50
+	if _, err := l.TakeSnapshot(ctx, "second"); err != nil {
+		t.Fatalf("expected no error when taking snapshot, got: %v", err)
+	}
+	if _, err := l.TakeSnapshot(ctx, "third"); err != ErrSnapshotLimit {
+		t.Fatalf("expected snapshot limit error, got: %v", err)
+	}
+
+	if err := l.DeleteSnapshot(ctx, "before nmap"); err != nil {
+		t.Fatalf("expected no error when deleting snapshot, got: %v", err)
+	}
+	if err := l.DeleteSnapshot(ctx, "before nmap"); err != ErrSnapshotNotFound {
+		t.Fatalf("expected snapshot not found error, got: %v", err)
+	}
+	if n := len(l.Snapshots()); n != 1 {
+		t.Fatalf("expected one snapshot after delete, got: %d", n)
+	}
+}
+
+func TestCloseDeletesSnapshots(t *testing.T) {
+	vm := &snapshotVM{}
+	l := &lab{
+		frontends:   map[uint]frontendConf{5000: {vm: vm}},
+		environment: &closingEnvironment{},
+	}
+	ctx := context.Background()
+
+	for _, name := range []string{"one", "two"} {
+		if _, err := l.TakeSnapshot(ctx, name); err != nil {
+			t.Fatalf("expected no error when taking snapshot, got: %v", err)
+		}
+	}
+
+	if err := l.Close(); err != nil {
+		t.Fatalf("expected no error when closing lab, got: %v", err)
+	}
+	if len(vm.snapshots) != 0 {
+		t.Fatalf("expected snapshots to be deleted on close, got: %v", vm.snapshots)
+	}
+	if !vm.closed {
+		t.Fatalf("expected frontend to be closed")
+	}
+	if n := len(l.Snapshots()); n != 0 {
+		t.Fatalf("expected no snapshots after close, got: %d", n)
+	}
+}
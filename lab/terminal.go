@@ -0,0 +1,142 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package lab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aau-network-security/haaukins/network/dns"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrNoTerminal = errors.New("lab has no terminal container")
+
+	defaultTerminalShell = []string{"/bin/bash"}
+
+	// terminalCapDrop keeps teams from changing the routes of the attacker
+	// container, which hide the exercises not solved from the terminal,
+	// and from crafting packets around them on raw sockets
+	terminalCapDrop = []string{"NET_ADMIN", "NET_RAW"}
+)
+
+// TerminalConfig is the attacker container of the labs,
+// which teams reach through the terminal in their browser
+type TerminalConfig struct {
+	Image string `yaml:"image"`
+	// Shell is the command started for each session of the terminal
+	Shell    []string `yaml:"shell,omitempty"`
+	MemoryMB uint     `yaml:"memory,omitempty"`
+	CPU      float64  `yaml:"cpu,omitempty"`
+}
+
+func (conf TerminalConfig) shell() []string {
+	if len(conf.Shell) == 0 {
+		return defaultTerminalShell
+	}
+
+	return conf.Shell
+}
+
+// addTerminal creates the attacker container on the lab network
+func (l *lab) addTerminal(ctx context.Context, conf TerminalConfig) error {
+	net := l.environment.Network()
+	if net == nil {
+		return ErrNoLabNetwork
+	}
+
+	c := docker.NewContainer(docker.ContainerConfig{
+		Image: conf.Image,
+		// keeps the container running between the sessions
+		Cmd: []string{"tail", "-f", "/dev/null"},
+		Labels: map[string]string{
+			"hkn": "lab_terminal",
+		},
+		Resources: &docker.Resources{
+			MemoryMB: conf.MemoryMB,
+			CPU:      conf.CPU,
+		},
+		DNS:     []string{net.FormatIP(dns.PreferedIP)},
+		CapDrop: terminalCapDrop,
+	})
+
+	if err := c.Create(ctx); err != nil {
+		return err
+	}
+
+	if _, err := net.Connect(c); err != nil {
+		c.Close()
+		return err
+	}
+
+	l.terminal = c
+	l.terminalConf = conf
+
+	return nil
+}
+
+// Terminal starts a shell in the attacker container of the lab
+func (l *lab) Terminal(ctx context.Context) (docker.Shell, error) {
+	if l.terminal == nil {
+		return nil, ErrNoTerminal
+	}
+
+	// the container is suspended with the rest of the lab
+	if l.terminal.Info().State != virtual.Running {
+		if err := l.terminal.Start(ctx); err != nil {
+			return nil, err
+		}
+		if err := l.setTerminalRoutes(); err != nil {
+			return nil, err
+		}
+	}
+
+	return docker.Exec(ctx, l.terminal, l.terminalConf.shell())
+}
+
+// terminalAction runs the action on the attacker container when the lab has one
+func (l *lab) terminalAction(action func(virtual.Instance) error) error {
+	if l.terminal == nil {
+		return nil
+	}
+
+	if err := action(l.terminal); err != nil {
+		log.Warn().Str("lab", l.tag).Msgf("Action on terminal container failed: %v", err)
+		return err
+	}
+
+	return l.setTerminalRoutes()
+}
+
+// setTerminalRoutes adds the routes into the segments of the lab to
+// the attacker container, which are lost whenever it is started again.
+// The instances of exercises which are not solved from the terminal
+// are unreachable, as well as the segments behind their routers.
+func (l *lab) setTerminalRoutes() error {
+	if l.terminal == nil || l.terminal.Info().State != virtual.Running {
+		return nil
+	}
+
+	var routes []docker.Route
+	hidden := map[string]bool{}
+	for _, addr := range l.environment.InstanceAddrs(l.terminalExercises) {
+		hidden[addr] = true
+		routes = append(routes, docker.Route{Destination: addr})
+	}
+	for _, r := range l.environment.LabRoutes() {
+		if hidden[r.Gateway+"/32"] {
+			continue
+		}
+		routes = append(routes, r)
+	}
+	if len(routes) == 0 {
+		return nil
+	}
+
+	return docker.SetRoutes(l.terminal, routes)
+}
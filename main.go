@@ -10,10 +10,13 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/aau-network-security/haaukins/bundle"
 	"github.com/aau-network-security/haaukins/daemon"
+	"github.com/aau-network-security/haaukins/worker"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -58,8 +61,34 @@ func main() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
 	confFilePtr := flag.String("config", defaultConfigFile, "configuration file")
+	workerConfPtr := flag.String("worker", "", "run as worker host of a daemon with the given configuration file")
+	exportPtr := flag.String("export-bundle", "", "export the exercises and frontends as an offline bundle to the given file")
+	importPtr := flag.String("import-bundle", "", "import the offline bundle of the given file")
+	exercisesPtr := flag.String("exercises", "", "comma separated exercise tags of the exported bundle")
+	frontendsPtr := flag.String("frontends", "", "comma separated frontends of the exported bundle")
+	generateKeysPtr := flag.Bool("generate-bundle-keys", false, "print a new pair of keys to sign and verify offline bundles")
 	flag.Parse()
 
+	if *generateKeysPtr {
+		public, private, err := bundle.GenerateKeys()
+		if err != nil {
+			fmt.Printf("unable to generate bundle keys: %s\n", err)
+			return
+		}
+		fmt.Printf("private-key: %s\npublic-key: %s\n", private, public)
+		return
+	}
+
+	if *workerConfPtr != "" {
+		runWorker(*workerConfPtr)
+		return
+	}
+
+	if *exportPtr != "" || *importPtr != "" {
+		runBundle(*confFilePtr, *exportPtr, *importPtr, *exercisesPtr, *frontendsPtr)
+		return
+	}
+
 	// ensure that gRPC port is free to allocate
 	conn, err := net.DialTimeout("tcp", daemon.MngtPort, time.Second)
 	if conn != nil {
@@ -94,3 +123,60 @@ func main() {
 		log.Fatal().Err(err).Msg("")
 	}
 }
+
+// runWorker runs labs for a daemon on this host, the worker
+// is drained before shutting down on interrupt and SIGTERM
+func runWorker(confFile string) {
+	c, err := worker.NewConfigFromFile(confFile)
+	if err != nil {
+		fmt.Printf("unable to read worker configuration file \"%s\": %s\n", confFile, err)
+		return
+	}
+
+	a, err := worker.NewAgent(*c)
+	if err != nil {
+		fmt.Printf("unable to create worker: %s\n", err)
+		return
+	}
+
+	handleCancel(func() error {
+		return a.Drain()
+	})
+
+	log.Info().Msgf("Started worker")
+
+	if err := a.Run(); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+}
+
+// runBundle exports or imports an offline bundle using the
+// daemon configuration, without starting the daemon
+func runBundle(confFile, export, imp, exercises, frontends string) {
+	c, err := daemon.NewConfigFromFile(confFile)
+	if err != nil {
+		fmt.Printf("unable to read configuration file \"%s\": %s\n", confFile, err)
+		return
+	}
+
+	if export != "" {
+		if err := daemon.ExportBundle(c, export, splitFlag(exercises), splitFlag(frontends)); err != nil {
+			fmt.Printf("unable to export bundle: %s\n", err)
+		}
+		return
+	}
+
+	if err := daemon.ImportBundle(c, imp); err != nil {
+		fmt.Printf("unable to import bundle: %s\n", err)
+	}
+}
+
+func splitFlag(s string) []string {
+	var res []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			res = append(res, e)
+		}
+	}
+	return res
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+// Package capture records the traffic of lab networks into rotating pcap files
+package capture
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	fileName = "capture.pcap"
+
+	DefaultMaxFileMB   = 100
+	DefaultMaxFiles    = 5
+	DefaultMaxDuration = 24 * time.Hour
+	DefaultRetention   = 7 * 24 * time.Hour
+)
+
+var (
+	ErrNoCapture = errors.New("no traffic has been captured for the lab")
+
+	cmd = "tcpdump"
+)
+
+// Config bounds the capture of a lab network, the capture is split
+// into at most MaxFiles files of MaxFileMB where the oldest file
+// is overwritten, and stops after MaxDuration
+type Config struct {
+	Directory   string        `yaml:"directory,omitempty"`
+	MaxFileMB   uint          `yaml:"max-file-mb,omitempty"`
+	MaxFiles    uint          `yaml:"max-files,omitempty"`
+	MaxDuration time.Duration `yaml:"max-duration,omitempty"`
+	// TeamDownload allows teams to download the
+	// capture of their own lab from amigo
+	TeamDownload bool `yaml:"team-download,omitempty"`
+	// Retention is how long the captures of labs are kept
+	// after they have been written to the last time
+	Retention time.Duration `yaml:"retention,omitempty"`
+}
+
+// WithDefaults returns the config where unset bounds are replaced by the defaults
+func (c Config) WithDefaults() Config {
+	if c.MaxFileMB == 0 {
+		c.MaxFileMB = DefaultMaxFileMB
+	}
+	if c.MaxFiles == 0 {
+		c.MaxFiles = DefaultMaxFiles
+	}
+	if c.MaxDuration <= 0 {
+		c.MaxDuration = DefaultMaxDuration
+	}
+	if c.Retention <= 0 {
+		c.Retention = DefaultRetention
+	}
+	return c
+}
+
+func (c Config) args(iface string) []string {
+	return []string{
+		"-i", iface,
+		"-n",
+		// packets are written as they arrive, such that
+		// the files can be downloaded while capturing
+		"-U",
+		// privileges are not dropped, such that
+		// the rotated files can be created
+		"-Z", "root",
+		"-w", filepath.Join(c.Directory, fileName),
+		"-C", strconv.FormatUint(uint64(c.MaxFileMB), 10),
+		"-W", strconv.FormatUint(uint64(c.MaxFiles), 10),
+	}
+}
+
+// Capture is a running capture of a network interface
+type Capture struct {
+	iface  string
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	m   sync.Mutex
+	err error
+}
+
+// Start captures the traffic of the network interface into the
+// directory of the config until MaxDuration has passed or it is closed
+func Start(iface string, conf Config) (*Capture, error) {
+	conf = conf.WithDefaults()
+	if err := os.MkdirAll(conf.Directory, 0755); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), conf.MaxDuration)
+	c := exec.CommandContext(ctx, cmd, conf.args(iface)...)
+	if err := c.Start(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("unable to start capture on %s: %v", iface, err)
+	}
+
+	capture := &Capture{
+		iface:  iface,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(capture.done)
+		err := c.Wait()
+		if ctx.Err() == context.DeadlineExceeded {
+			log.Info().Str("interface", iface).Msg("Stopped capture after maximum duration")
+			return
+		}
+		if ctx.Err() == nil && err != nil {
+			log.Warn().Str("interface", iface).Msgf("Capture stopped unexpectedly: %v", err)
+			capture.m.Lock()
+			capture.err = err
+			capture.m.Unlock()
+		}
+	}()
+
+	log.Debug().Str("interface", iface).Str("directory", conf.Directory).Msg("Started capture")
+	return capture, nil
+}
+
+// Running reports whether the capture has not stopped yet
+func (c *Capture) Running() bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Close stops the capture, the captured files are kept
+func (c *Capture) Close() error {
+	c.cancel()
+	<-c.done
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.err
+}
+
+// File is a pcap file of a capture
+type File struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// Files returns the pcap files of the capture in dir, oldest first
+func Files(dir string) ([]File, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoCapture
+		}
+		return nil, err
+	}
+
+	var files []File
+	for _, fi := range infos {
+		if fi.IsDir() || !strings.HasPrefix(fi.Name(), fileName) {
+			continue
+		}
+		files = append(files, File{
+			Name:    fi.Name(),
+			Size:    fi.Size(),
+			ModTime: fi.ModTime(),
+		})
+	}
+	if len(files) == 0 {
+		return nil, ErrNoCapture
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime.Before(files[j].ModTime)
+	})
+
+	return files, nil
+}
+
+// WriteArchive writes the pcap files of the capture in dir as a gzipped tarball
+func WriteArchive(dir string, w io.Writer) error {
+	files, err := Files(dir)
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, f := range files {
+		if err := addFile(tw, filepath.Join(dir, f.Name), f.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Prune removes the captures below dir, which are kept by event and
+// lab, that have not been written to within the retention, and the
+// directories of events which are left without captures. The removed
+// captures are returned as "<event>/<lab>".
+func Prune(dir string, retention time.Duration) ([]string, error) {
+	events, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var removed []string
+	for _, event := range events {
+		if !event.IsDir() {
+			continue
+		}
+		eventDir := filepath.Join(dir, event.Name())
+		labs, err := ioutil.ReadDir(eventDir)
+		if err != nil {
+			return removed, err
+		}
+
+		left := len(labs)
+		for _, l := range labs {
+			if !l.IsDir() {
+				continue
+			}
+			labDir := filepath.Join(eventDir, l.Name())
+			if time.Since(lastWrite(labDir, l.ModTime())) < retention {
+				continue
+			}
+			if err := os.RemoveAll(labDir); err != nil {
+				return removed, err
+			}
+			removed = append(removed, event.Name()+"/"+l.Name())
+			left--
+		}
+
+		if left == 0 {
+			if err := os.Remove(eventDir); err != nil && !os.IsNotExist(err) {
+				return removed, err
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+// lastWrite is the last modification of the capture in dir
+func lastWrite(dir string, modTime time.Time) time.Time {
+	last := modTime
+	files, err := Files(dir)
+	if err != nil {
+		return last
+	}
+	for _, f := range files {
+		if f.ModTime.After(last) {
+			last = f.ModTime
+		}
+	}
+
+	return last
+}
+
+func addFile(tw *tar.Writer, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// the file is still being written while capturing,
+	// so only the size at this point is archived
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    fi.Size(),
+		ModTime: fi.ModTime(),
+	}); err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(tw, f, fi.Size())
+	return err
+}
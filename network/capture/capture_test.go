@@ -0,0 +1,144 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package capture
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArgs(t *testing.T) {
+	conf := Config{Directory: "/captures/lab", MaxFiles: 3}.WithDefaults()
+
+	expected := []string{
+		"-i", "br-123",
+		"-n",
+		"-U",
+		"-Z", "root",
+		"-w", "/captures/lab/capture.pcap",
+		"-C", "100",
+		"-W", "3",
+	}
+	if args := conf.args("br-123"); !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected args %v, got: %v", expected, args)
+	}
+	if conf.MaxDuration != DefaultMaxDuration {
+		t.Fatalf("expected default duration, got: %s", conf.MaxDuration)
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture-test")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := WriteArchive(dir, ioutil.Discard); err != ErrNoCapture {
+		t.Fatalf("expected no capture error, got: %v", err)
+	}
+
+	now := time.Now()
+	content := map[string]string{
+		"capture.pcap0": "oldest",
+		"capture.pcap1": "newest",
+		"other.txt":     "ignored",
+	}
+	for name, c := range content {
+		p := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(p, []byte(c), 0644); err != nil {
+			t.Fatalf("unable to write file: %v", err)
+		}
+	}
+	os.Chtimes(filepath.Join(dir, "capture.pcap0"), now, now.Add(-time.Minute))
+
+	var buf bytes.Buffer
+	if err := WriteArchive(dir, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("unable to read archive: %v", err)
+	}
+	tr := tar.NewReader(gr)
+
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unable to read archive: %v", err)
+		}
+
+		data, _ := ioutil.ReadAll(tr)
+		if string(data) != content[hdr.Name] {
+			t.Fatalf("unexpected content of %s: %s", hdr.Name, data)
+		}
+		names = append(names, hdr.Name)
+	}
+
+	expected := []string{"capture.pcap0", "capture.pcap1"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected files %v, got: %v", expected, names)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture-test")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	old := time.Now().Add(-48 * time.Hour)
+	labs := map[string]time.Time{
+		"closed/lab1":  old,
+		"running/lab2": old,
+		"running/lab3": time.Now(),
+	}
+	for l, modTime := range labs {
+		labDir := filepath.Join(dir, filepath.FromSlash(l))
+		if err := os.MkdirAll(labDir, 0755); err != nil {
+			t.Fatalf("unable to create lab directory: %v", err)
+		}
+		p := filepath.Join(labDir, "capture.pcap0")
+		if err := ioutil.WriteFile(p, []byte("packets"), 0644); err != nil {
+			t.Fatalf("unable to write file: %v", err)
+		}
+		os.Chtimes(p, modTime, modTime)
+		os.Chtimes(labDir, old, old)
+	}
+
+	removed, err := Prune(dir, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"closed/lab1", "running/lab2"}
+	if !reflect.DeepEqual(removed, expected) {
+		t.Fatalf("expected removed captures %v, got: %v", expected, removed)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "closed")); !os.IsNotExist(err) {
+		t.Fatalf("expected directory of event without captures to be removed")
+	}
+	if _, err := Files(filepath.Join(dir, "running", "lab3")); err != nil {
+		t.Fatalf("expected recent capture to be kept: %v", err)
+	}
+
+	if removed, err := Prune(filepath.Join(dir, "unknown"), time.Hour); err != nil || len(removed) != 0 {
+		t.Fatalf("expected nothing to be pruned in missing directory, got: %v, %v", removed, err)
+	}
+}
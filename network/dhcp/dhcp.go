@@ -6,14 +6,22 @@ package dhcp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/aau-network-security/haaukins/network/dns"
 	"github.com/aau-network-security/haaukins/virtual/docker"
 )
 
+var (
+	NotListeningErr = errors.New("DHCP server is not listening")
+)
+
 type Server struct {
 	cont     docker.Container
 	confFile string
@@ -21,7 +29,10 @@ type Server struct {
 	subnet   string
 }
 
-func New(format func(n int) string) (*Server, error) {
+// New creates the DHCP server of a lab network, the routes into the
+// segments of exercises are handed to virtual machines as classless
+// static routes (RFC 3442)
+func New(format func(n int) string, routes ...docker.Route) (*Server, error) {
 	f, err := ioutil.TempFile("", "dhcpd-conf")
 	if err != nil {
 		return nil, err
@@ -35,15 +46,31 @@ func New(format func(n int) string) (*Server, error) {
 	broadcast := format(255)
 	router := format(1)
 
+	var routesStr string
+	if len(routes) > 0 {
+		// clients ignore the routers option when classless
+		// static routes are given, hence the default route
+		routes = append(routes, docker.Route{Gateway: router})
+		opt, err := classlessRoutes(routes)
+		if err != nil {
+			return nil, err
+		}
+		routesStr = fmt.Sprintf(`
+		option rfc3442-classless-static-routes %s;
+		option ms-classless-static-routes %s;`, opt, opt)
+	}
+
 	confStr := fmt.Sprintf(
 		`option domain-name-servers %s;
+	option rfc3442-classless-static-routes code 121 = array of integer 8;
+	option ms-classless-static-routes code 249 = array of integer 8;
 
 	subnet %s netmask 255.255.255.0 {
 		range %s %s;
 		option subnet-mask 255.255.255.0;
 		option broadcast-address %s;
-		option routers %s;
-	}`, dns, subnet, minRange, maxRange, broadcast, router)
+		option routers %s;%s
+	}`, dns, subnet, minRange, maxRange, broadcast, router, routesStr)
 
 	_, err = f.WriteString(confStr)
 	if err != nil {
@@ -74,6 +101,54 @@ func New(format func(n int) string) (*Server, error) {
 	}, nil
 }
 
+// classlessRoutes encodes routes as the value of the classless static
+// route option, each route is the prefix length, the significant octets
+// of the destination and the octets of the gateway
+func classlessRoutes(routes []docker.Route) (string, error) {
+	var octets []string
+	for _, r := range routes {
+		gw := net.ParseIP(r.Gateway).To4()
+		if gw == nil {
+			return "", docker.InvalidRouteErr
+		}
+
+		var dst net.IP
+		var ones int
+		if r.Destination != "" {
+			_, ipNet, err := net.ParseCIDR(r.Destination)
+			if err != nil || ipNet.IP.To4() == nil {
+				return "", docker.InvalidRouteErr
+			}
+			dst = ipNet.IP.To4()
+			ones, _ = ipNet.Mask.Size()
+		}
+
+		octets = append(octets, strconv.Itoa(ones))
+		for _, o := range dst[:(ones+7)/8] {
+			octets = append(octets, strconv.Itoa(int(o)))
+		}
+		for _, o := range gw {
+			octets = append(octets, strconv.Itoa(int(o)))
+		}
+	}
+
+	return strings.Join(octets, ", "), nil
+}
+
+// Probe checks that the server has bound its port within the
+// container, which it does once the configuration has been loaded
+func (dhcp *Server) Probe() error {
+	listens, err := docker.ListensUDP(dhcp.cont, 67)
+	if err != nil {
+		return err
+	}
+	if !listens {
+		return NotListeningErr
+	}
+
+	return nil
+}
+
 func (dhcp *Server) Container() docker.Container {
 	return dhcp.cont
 }
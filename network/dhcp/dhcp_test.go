@@ -0,0 +1,52 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dhcp
+
+import (
+	"testing"
+
+	"github.com/aau-network-security/haaukins/virtual/docker"
+)
+
+func TestClasslessRoutes(t *testing.T) {
+	tt := []struct {
+		name     string
+		routes   []docker.Route
+		expected string
+		err      error
+	}{
+		{
+			name: "Segments",
+			routes: []docker.Route{
+				{Destination: "10.20.6.0/24", Gateway: "10.20.5.10"},
+				{Destination: "10.0.0.0/8", Gateway: "10.20.5.11"},
+				{Gateway: "10.20.5.1"},
+			},
+			expected: "24, 10, 20, 6, 10, 20, 5, 10, 8, 10, 10, 20, 5, 11, 0, 10, 20, 5, 1",
+		},
+		{
+			name:   "Invalid gateway",
+			routes: []docker.Route{{Destination: "10.20.6.0/24", Gateway: "fd00::1"}},
+			err:    docker.InvalidRouteErr,
+		},
+		{
+			name:   "Invalid destination",
+			routes: []docker.Route{{Destination: "10.20.6.0", Gateway: "10.20.5.10"}},
+			err:    docker.InvalidRouteErr,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := classlessRoutes(tc.routes)
+			if err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if opt != tc.expected {
+				t.Fatalf("expected option (%s), got (%s)", tc.expected, opt)
+			}
+		})
+	}
+}
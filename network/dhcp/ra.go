@@ -0,0 +1,160 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dhcp
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	raInterval        = 10 * time.Second
+	raRouterLifetime  = 1800
+	raValidLifetime   = 86400
+	raPreferLifetime  = 14400
+	raRDNSSLifetime   = 600
+	icmpv6RouterAdv   = 134
+	optPrefixInfo     = 3
+	optRDNSS          = 25
+	prefixOnLink      = 0x80
+	prefixAutonomous  = 0x40
+	advertisementSize = 16 + 32 + 24
+)
+
+var (
+	InvalidIPv6Err = errors.New("IPv6 prefix or DNS server of lab is invalid")
+)
+
+// Advertiser sends router advertisements into the network of a
+// lab, such that virtual machines configure their IPv6 address
+// through SLAAC and learn the lab DNS server from the RDNSS option.
+// Containers get their IPv6 address from docker instead.
+type Advertiser struct {
+	conn *ipv6.PacketConn
+	dst  *net.IPAddr
+	msg  []byte
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// Advertise starts advertising the /64 prefix on the interface of
+// the lab network, the host is announced as default router when
+// router is true, which is the case for bridged (VPN) networks
+func Advertise(iface, prefix, dns string, router bool) (*Advertiser, error) {
+	msg, err := advertisement(prefix, dns, router)
+	if err != nil {
+		return nil, err
+	}
+
+	ifi, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+	conn := ipv6.NewPacketConn(c)
+
+	// router advertisements are only accepted with
+	// a hop limit of 255, received messages are ignored
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	if err := conn.SetICMPFilter(&filter); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.SetMulticastHopLimit(255); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.SetMulticastInterface(ifi); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	a := &Advertiser{
+		conn: conn,
+		dst:  &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: iface},
+		msg:  msg,
+		stop: make(chan struct{}),
+	}
+
+	a.wg.Add(1)
+	go a.run()
+
+	return a, nil
+}
+
+func (a *Advertiser) run() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(raInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := a.conn.WriteTo(a.msg, nil, a.dst); err != nil {
+			log.Warn().Str("interface", a.dst.Zone).Msgf("Unable to send router advertisement: %v", err)
+		}
+
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Advertiser) Close() error {
+	close(a.stop)
+	a.wg.Wait()
+
+	return a.conn.Close()
+}
+
+// advertisement renders a router advertisement (RFC 4861) with
+// a prefix information option and a RDNSS option (RFC 8106), the
+// checksum is left to the kernel
+func advertisement(prefix, dns string, router bool) ([]byte, error) {
+	_, subnet, err := net.ParseCIDR(prefix)
+	if err != nil || subnet.IP.To4() != nil {
+		return nil, InvalidIPv6Err
+	}
+	if ones, _ := subnet.Mask.Size(); ones != 64 {
+		return nil, InvalidIPv6Err
+	}
+	dnsIP := net.ParseIP(dns)
+	if dnsIP == nil || dnsIP.To4() != nil {
+		return nil, InvalidIPv6Err
+	}
+
+	b := make([]byte, advertisementSize)
+	b[0] = icmpv6RouterAdv
+	b[4] = 64 // hop limit of hosts
+	if router {
+		binary.BigEndian.PutUint16(b[6:], raRouterLifetime)
+	}
+
+	opt := b[16:]
+	opt[0], opt[1] = optPrefixInfo, 4
+	opt[2] = 64
+	opt[3] = prefixOnLink | prefixAutonomous
+	binary.BigEndian.PutUint32(opt[4:], raValidLifetime)
+	binary.BigEndian.PutUint32(opt[8:], raPreferLifetime)
+	copy(opt[16:], subnet.IP.To16())
+
+	opt = b[48:]
+	opt[0], opt[1] = optRDNSS, 3
+	binary.BigEndian.PutUint32(opt[4:], raRDNSSLifetime)
+	copy(opt[8:], dnsIP.To16())
+
+	return b, nil
+}
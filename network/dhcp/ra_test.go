@@ -0,0 +1,57 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dhcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func TestAdvertisement(t *testing.T) {
+	tt := []struct {
+		name   string
+		prefix string
+		dns    string
+		router bool
+		err    error
+	}{
+		{name: "Normal", prefix: "fd00:10:20:5::/64", dns: "fd00:10:20:5::3"},
+		{name: "Router", prefix: "fd00:10:20:5::/64", dns: "fd00:10:20:5::3", router: true},
+		{name: "IPv4 prefix", prefix: "10.20.5.0/24", dns: "fd00:10:20:5::3", err: InvalidIPv6Err},
+		{name: "Prefix length", prefix: "fd00:10:20::/48", dns: "fd00:10:20:5::3", err: InvalidIPv6Err},
+		{name: "IPv4 DNS", prefix: "fd00:10:20:5::/64", dns: "10.20.5.3", err: InvalidIPv6Err},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := advertisement(tc.prefix, tc.dns, tc.router)
+			if err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if b[0] != icmpv6RouterAdv {
+				t.Fatalf("expected router advertisement, got type %d", b[0])
+			}
+			lifetime := binary.BigEndian.Uint16(b[6:])
+			if tc.router != (lifetime > 0) {
+				t.Fatalf("unexpected router lifetime %d", lifetime)
+			}
+			if b[16] != optPrefixInfo || b[19] != prefixOnLink|prefixAutonomous {
+				t.Fatalf("expected on-link autonomous prefix")
+			}
+			if !bytes.Equal(b[32:48], net.ParseIP("fd00:10:20:5::")) {
+				t.Fatalf("unexpected prefix %v", net.IP(b[32:48]))
+			}
+			if b[48] != optRDNSS || !bytes.Equal(b[56:72], net.ParseIP(tc.dns)) {
+				t.Fatalf("unexpected RDNSS option %v", b[48:])
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
 
 	"io"
 
@@ -17,9 +19,13 @@ import (
 )
 
 const (
-	PreferedIP      = 3
+	PreferedIP = 3
+	// the zone file is reloaded by the file plugin whenever
+	// its serial is increased, see Server.Update
 	coreFileContent = `. {
-    file zonefile
+    file /dns/zonefile {
+        reload 2s
+    }
     prometheus     # enable metrics
     errors         # show errors
     log            # enable query logs
@@ -27,7 +33,7 @@ const (
 `
 	zonePrefixContent = `$ORIGIN .
 @   3600 IN SOA sns.dns.icann.org. noc.dns.icann.org. (
-                2017042745 ; serial
+                %d ; serial
                 7200       ; refresh (2 hours)
                 3600       ; retry (1 hour)
                 1209600    ; expire (2 weeks)
@@ -38,8 +44,10 @@ const (
 )
 
 type Server struct {
-	cont     docker.Container
-	confFile string
+	m       sync.Mutex
+	cont    docker.Container
+	confDir string
+	serial  uint32
 	io.Closer
 }
 
@@ -54,39 +62,29 @@ func (rr *RR) Format() string {
 }
 
 func New(records []RR) (*Server, error) {
-	f, err := ioutil.TempFile("", "zonefile")
+	confDir, err := ioutil.TempDir("", "dns")
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	c, err := ioutil.TempFile("", "Corefile")
-	if err != nil {
+	if err := ioutil.WriteFile(filepath.Join(confDir, "Corefile"), []byte(coreFileContent), 0644); err != nil {
 		return nil, err
 	}
-	defer c.Close()
-
-	confFile := f.Name()
-
-	f.Write([]byte(zonePrefixContent))
 
-	for _, r := range records {
-		_, err = f.Write([]byte(r.Format() + "\n"))
-		if err != nil {
-			return nil, err
-		}
+	s := &Server{
+		confDir: confDir,
+		serial:  2017042745,
+	}
+	if err := s.writeZone(records); err != nil {
+		return nil, err
 	}
 
-	coreFile := c.Name()
-
-	c.Write([]byte(coreFileContent))
-
-	f.Sync()
-	cont := docker.NewContainer(docker.ContainerConfig{
+	s.cont = docker.NewContainer(docker.ContainerConfig{
 		Image: "coredns/coredns:1.6.1",
+		// the directory is mounted instead of the files, such
+		// that the zone file can be replaced while running
 		Mounts: []string{
-			fmt.Sprintf("%s:/Corefile", coreFile),
-			fmt.Sprintf("%s:/zonefile", confFile),
+			fmt.Sprintf("%s:/dns", confDir),
 		},
 		UsedPorts: []string{
 			"53/tcp",
@@ -96,16 +94,52 @@ func New(records []RR) (*Server, error) {
 			MemoryMB: 50,
 			CPU:      0.3,
 		},
-		Cmd: []string{"--conf", "Corefile"},
+		Cmd: []string{"--conf", "/dns/Corefile"},
 		Labels: map[string]string{
 			"hkn": "lab_dns",
 		},
 	})
 
-	return &Server{
-		cont:     cont,
-		confFile: confFile,
-	}, nil
+	return s, nil
+}
+
+// Update replaces the records of a running server, the
+// server picks up the records without being restarted
+func (s *Server) Update(records []RR) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.serial++
+	return s.writeZone(records)
+}
+
+// writeZone renders the zone file into a temporary file which
+// is renamed afterwards, such that the server never reads a
+// partially written zone
+func (s *Server) writeZone(records []RR) error {
+	f, err := ioutil.TempFile(s.confDir, "zonefile")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, zonePrefixContent, s.serial); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if _, err := f.Write([]byte(r.Format() + "\n")); err != nil {
+			return err
+		}
+	}
+	if err := f.Chmod(0644); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(s.confDir, "zonefile"))
 }
 
 func (s *Server) Container() docker.Container {
@@ -117,7 +151,7 @@ func (s *Server) Run(ctx context.Context) error {
 }
 
 func (s *Server) Close() error {
-	if err := os.Remove(s.confFile); err != nil {
+	if err := os.RemoveAll(s.confDir); err != nil {
 		log.Warn().Msgf("error while removing DNS configuration file: %s", err)
 	}
 
@@ -0,0 +1,41 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	s, err := New([]RR{{Name: "formalbank.com", Type: "A", RData: "77.1.2.5"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(s.confDir)
+
+	if err := s.Update([]RR{{Name: "login.formalbank.com", Type: "CNAME", RData: "formalbank.com"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zone, err := ioutil.ReadFile(filepath.Join(s.confDir, "zonefile"))
+	if err != nil {
+		t.Fatalf("unable to read zone file: %v", err)
+	}
+	if !strings.Contains(string(zone), "2017042746 ; serial") {
+		t.Fatalf("expected serial to be increased, got:\n%s", zone)
+	}
+	if strings.Contains(string(zone), "77.1.2.5") || !strings.Contains(string(zone), "login.formalbank.com IN CNAME formalbank.com") {
+		t.Fatalf("expected records to be replaced, got:\n%s", zone)
+	}
+
+	files, _ := ioutil.ReadDir(s.confDir)
+	if len(files) != 2 {
+		t.Fatalf("expected only Corefile and zonefile, got %d files", len(files))
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dns
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/aau-network-security/haaukins/virtual/docker"
+)
+
+const (
+	probeTimeout = 2 * time.Second
+	// the query asks for the SOA record of the zone of the server
+	soaType   = 6
+	classIN   = 1
+	rcodeMask = 0x0f
+)
+
+var (
+	NoAnswerErr = errors.New("DNS server did not answer the probe")
+)
+
+// Probe queries the server on its address in the lab network, the
+// query is sent from within the network namespace of the server as
+// lab networks are not reachable from the host
+func (s *Server) Probe(ip string) error {
+	var conn net.Conn
+	err := docker.InNetns(s.cont, func() error {
+		var err error
+		conn, err = net.DialTimeout("udp", net.JoinHostPort(ip, "53"), probeTimeout)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id := uint16(rand.Intn(1 << 16))
+	if err := conn.SetDeadline(time.Now().Add(probeTimeout)); err != nil {
+		return err
+	}
+	if _, err := conn.Write(soaQuery(id)); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 512)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return NoAnswerErr
+	}
+	if !answers(buf[:n], id) {
+		return NoAnswerErr
+	}
+
+	return nil
+}
+
+// soaQuery returns a query of the SOA record of the root zone
+func soaQuery(id uint16) []byte {
+	b := make([]byte, 12, 17)
+	binary.BigEndian.PutUint16(b[0:], id)
+	// recursion desired, one question
+	binary.BigEndian.PutUint16(b[2:], 0x0100)
+	binary.BigEndian.PutUint16(b[4:], 1)
+
+	// the root name is a single zero length label
+	b = append(b, 0)
+	b = append(b, 0, soaType, 0, classIN)
+
+	return b
+}
+
+// answers returns true when the message is a response to the query
+// with the id, which did not fail within the server
+func answers(msg []byte, id uint16) bool {
+	if len(msg) < 12 || binary.BigEndian.Uint16(msg) != id {
+		return false
+	}
+
+	flags := binary.BigEndian.Uint16(msg[2:])
+	isResponse := flags&0x8000 != 0
+	// NOERROR or NXDOMAIN, other codes like SERVFAIL
+	// are returned when the zone could not be loaded
+	rcode := flags & rcodeMask
+
+	return isResponse && (rcode == 0 || rcode == 3)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package dns
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestAnswers(t *testing.T) {
+	response := func(id, flags uint16) []byte {
+		msg := soaQuery(id)
+		binary.BigEndian.PutUint16(msg[2:], flags)
+		return msg
+	}
+
+	tt := []struct {
+		name     string
+		msg      []byte
+		expected bool
+	}{
+		{name: "answer", msg: response(42, 0x8180), expected: true},
+		{name: "not found", msg: response(42, 0x8183), expected: true},
+		{name: "server failure", msg: response(42, 0x8182)},
+		{name: "other query", msg: response(43, 0x8180)},
+		{name: "query", msg: soaQuery(42)},
+		{name: "truncated", msg: []byte{0, 42, 0x81}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if answers := answers(tc.msg, 42); answers != tc.expected {
+				t.Fatalf("expected answer %t, got %t", tc.expected, answers)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	IPTablesBackend = "iptables"
+	NFTablesBackend = "nftables"
+)
+
+// Firewall manages the rules of the traffic leaving labs,
+// every lab gets its own chain of rules which is replaced
+// as a whole whenever the rules of the lab change
+type Firewall interface {
+	// Apply creates or replaces the rules of a lab
+	Apply(LabRules) error
+	// Remove deletes the rules of a lab
+	Remove(name string) error
+	// Reconcile removes the rules of all labs which are not
+	// active, e.g. left by events of a crashed daemon
+	Reconcile(active []string) error
+}
+
+// LabRules are the rules of the traffic from the subnet of a lab
+type LabRules struct {
+	// Name identifies the lab, e.g. its tag
+	Name   string
+	Subnet string
+	// Subnet6 is the IPv6 subnet of dual-stack labs
+	Subnet6 string
+	// Peers are VPN addresses which are allowed to be reached
+	Peers     []string
+	Peers6    []string
+	Policy    Policy
+	Instances []InstanceRule
+}
+
+// ipv6 returns the rules of the IPv6 subnet of the lab, which
+// are applied by the IPv6 firewall in line with the IPv4 rules
+func (r LabRules) ipv6() LabRules {
+	v6 := LabRules{
+		Name:    r.Name,
+		Subnet:  r.Subnet6,
+		Subnet6: r.Subnet6,
+		Peers:   r.Peers6,
+		Policy:  r.Policy,
+	}
+	for _, i := range r.Instances {
+		if i.IP6 == "" {
+			continue
+		}
+		v6.Instances = append(v6.Instances, InstanceRule{IP: i.IP6, IP6: i.IP6, Internet: i.Internet, Allow: i.Allow})
+	}
+
+	return v6
+}
+
+// Policy is the egress policy of a lab
+type Policy struct {
+	BlockInternet bool
+	// Allow are domains, addresses or subnets which
+	// are reachable when the internet is blocked
+	Allow []string
+	// Isolate drops traffic to other labs
+	Isolate bool
+}
+
+// InstanceRule grants a single exercise instance of a
+// lab access beyond the policy of the lab
+type InstanceRule struct {
+	IP       string
+	IP6      string
+	Internet bool
+	Allow    []string
+}
+
+type ExecFunc func(cmd string, args ...string) ([]byte, error)
+
+type Errori struct {
+	Out []byte
+	Err error
+}
+
+func (e Errori) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, string(e.Out))
+}
+
+// New returns the firewall of the given backend, iptables
+// is used when no backend is given
+func New(backend string) (Firewall, error) {
+	switch backend {
+	case "", IPTablesBackend:
+		return &dualStack{v4: NewIPTables(), v6: NewIP6Tables()}, nil
+	case NFTablesBackend:
+		return &dualStack{v4: NewNFTables(), v6: NewNFTables6()}, nil
+	}
+
+	return nil, fmt.Errorf("unknown firewall %q, use %q or %q", backend, IPTablesBackend, NFTablesBackend)
+}
+
+// dualStack applies the rules of the IPv6 subnet of dual-stack
+// labs through a second firewall of the same backend
+type dualStack struct {
+	v4 Firewall
+	v6 Firewall
+}
+
+func (ds *dualStack) Apply(r LabRules) error {
+	if err := ds.v4.Apply(r); err != nil {
+		return err
+	}
+	if r.Subnet6 == "" {
+		return nil
+	}
+
+	return ds.v6.Apply(r.ipv6())
+}
+
+func (ds *dualStack) Remove(name string) error {
+	if err := ds.v4.Remove(name); err != nil {
+		return err
+	}
+
+	return ds.v6.Remove(name)
+}
+
+func (ds *dualStack) Reconcile(active []string) error {
+	if err := ds.v4.Reconcile(active); err != nil {
+		return err
+	}
+
+	return ds.v6.Reconcile(active)
+}
+
+func shellExec(cmd string, args ...string) ([]byte, error) {
+	return exec.Command(cmd, args...).CombinedOutput()
+}
+
+// resolver turns allowed entries into addresses or subnets of
+// the given family, domains are resolved to the addresses they
+// have when the rules are applied
+type resolver func(host string) ([]net.IP, error)
+
+func (r resolver) resolve(entries []string, ipv6 bool) []string {
+	seen := map[string]bool{}
+	var res []string
+	add := func(s string) {
+		if !seen[s] {
+			seen[s] = true
+			res = append(res, s)
+		}
+	}
+
+	isFamily := func(ip net.IP) bool {
+		return (ip.To4() == nil) == ipv6
+	}
+
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if ip, _, err := net.ParseCIDR(e); err == nil {
+			if isFamily(ip) {
+				add(e)
+			}
+			continue
+		}
+		if ip := net.ParseIP(e); ip != nil {
+			if isFamily(ip) {
+				add(ip.String())
+			}
+			continue
+		}
+
+		ips, err := r(e)
+		if err != nil {
+			log.Warn().Str("domain", e).Msgf("Unable to resolve allowed domain: %v", err)
+			continue
+		}
+		for _, ip := range ips {
+			if isFamily(ip) {
+				add(ip.String())
+			}
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
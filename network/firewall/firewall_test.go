@@ -0,0 +1,318 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package firewall
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type execRecorder struct {
+	cmds []string
+	// output of commands by their prefix
+	out map[string]string
+	// commands failing by their prefix
+	fail []string
+}
+
+func (e *execRecorder) exec(cmd string, args ...string) ([]byte, error) {
+	c := strings.Join(append([]string{cmd}, args...), " ")
+	e.cmds = append(e.cmds, c)
+	for _, f := range e.fail {
+		if strings.HasPrefix(c, f) {
+			return nil, errors.New("failed")
+		}
+	}
+	for prefix, out := range e.out {
+		if strings.HasPrefix(c, prefix) {
+			return []byte(out), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func lookup(host string) ([]net.IP, error) {
+	if host == "github.com" {
+		return []net.IP{net.ParseIP("140.82.121.4"), net.ParseIP("2606:50c0::1")}, nil
+	}
+
+	return nil, errors.New("unknown host")
+}
+
+var testRules = LabRules{
+	Name:   "elated_turing",
+	Subnet: "77.1.2.0/24",
+	Peers:  []string{"10.2.240.2/32", "10.2.241.2/32"},
+	Policy: Policy{
+		BlockInternet: true,
+		Allow:         []string{"github.com", "1.1.1.1", "unknown.example"},
+		Isolate:       true,
+	},
+	Instances: []InstanceRule{
+		{IP: "77.1.2.34", Internet: true},
+		{IP: "77.1.2.35", Allow: []string{"8.8.8.0/24"}},
+	},
+}
+
+func TestIPTablesApply(t *testing.T) {
+	rec := &execRecorder{fail: []string{"iptables -S HKN-LABS"}}
+	ipt := &IPTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}}
+
+	if err := ipt.Apply(testRules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	chain := string(labChain(testRules.Name))
+	expected := []string{
+		"iptables -S HKN-LABS",
+		"iptables -N HKN-LABS",
+		"iptables -N " + chain,
+		"iptables --insert DOCKER-USER -s 77.1.2.0/24 -j " + chain,
+		"iptables -A HKN-LABS -d 77.1.2.0/24 -j DROP",
+		"iptables -A " + chain + " -m state --state RELATED,ESTABLISHED -j RETURN",
+		"iptables -A " + chain + " -d 77.1.2.0/24 -j RETURN",
+		"iptables -A " + chain + " -d 10.2.240.2/32,10.2.241.2/32 -j ACCEPT",
+		"iptables -A " + chain + " -j HKN-LABS",
+		"iptables -A " + chain + " -d 1.1.1.1,140.82.121.4 -j RETURN",
+		"iptables -A " + chain + " -s 77.1.2.34 -j RETURN",
+		"iptables -A " + chain + " -s 77.1.2.35 -d 8.8.8.0/24 -j RETURN",
+		"iptables -A " + chain + " -j REJECT --reject-with icmp-port-unreachable",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+
+	// applying again replaces the rules of the chain
+	rec.cmds, rec.fail = nil, nil
+	if err := ipt.Apply(LabRules{Name: testRules.Name, Subnet: testRules.Subnet}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{
+		"iptables -S HKN-LABS",
+		"iptables -F " + chain,
+		"iptables -A " + chain + " -m state --state RELATED,ESTABLISHED -j RETURN",
+		"iptables -A " + chain + " -d 77.1.2.0/24 -j RETURN",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+
+	rec.cmds = nil
+	if err := ipt.Remove(testRules.Name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{
+		"iptables -D DOCKER-USER -s 77.1.2.0/24 -j " + chain,
+		"iptables -D HKN-LABS -d 77.1.2.0/24 -j DROP",
+		"iptables -F " + chain,
+		"iptables -X " + chain,
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+}
+
+func TestIPTablesReconcile(t *testing.T) {
+	active, stale := string(labChain("active")), string(labChain("stale"))
+	rec := &execRecorder{out: map[string]string{
+		"iptables -S DOCKER-USER": strings.Join([]string{
+			"-N DOCKER-USER",
+			"-A DOCKER-USER -s 77.1.3.0/24 -j " + stale,
+			"-A DOCKER-USER -s 77.1.2.0/24 -j " + active,
+			"-A DOCKER-USER -s 10.0.0.0/8 -j ACCEPT",
+			"-A DOCKER-USER -j RETURN",
+		}, "\n"),
+	}}
+	ipt := &IPTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}}
+
+	if err := ipt.Reconcile([]string{"active"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"iptables -S DOCKER-USER",
+		"iptables -D DOCKER-USER -s 77.1.3.0/24 -j " + stale,
+		"iptables -D HKN-LABS -d 77.1.3.0/24 -j DROP",
+		"iptables -F " + stale,
+		"iptables -X " + stale,
+		"iptables -F HKN-LABS",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+}
+
+func TestIPTablesRemoveLegacyRules(t *testing.T) {
+	rec := &execRecorder{out: map[string]string{
+		"iptables -S DOCKER-USER": strings.Join([]string{
+			"-N DOCKER-USER",
+			"-A DOCKER-USER -s 77.1.2.0/24 -d 10.2.240.2/32 -j ACCEPT",
+			"-A DOCKER-USER -s 77.1.2.0/24 -m state --state RELATED,ESTABLISHED -j RETURN",
+			"-A DOCKER-USER -s 77.1.2.0/24 -j REJECT --reject-with icmp-port-unreachable",
+			"-A DOCKER-USER -s 77.1.3.0/24 -j " + string(labChain("active")),
+			"-A DOCKER-USER -s 10.0.0.0/8 -d 10.1.0.0/16 -j ACCEPT",
+			"-A DOCKER-USER -j RETURN",
+		}, "\n"),
+	}}
+	ipt := &IPTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}}
+
+	if err := ipt.removeLegacyRules(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"iptables -S DOCKER-USER",
+		"iptables -D DOCKER-USER -s 77.1.2.0/24 -d 10.2.240.2/32 -j ACCEPT",
+		"iptables -D DOCKER-USER -s 77.1.2.0/24 -m state --state RELATED,ESTABLISHED -j RETURN",
+		"iptables -D DOCKER-USER -s 77.1.2.0/24 -j REJECT --reject-with icmp-port-unreachable",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+}
+
+func TestNFTablesApply(t *testing.T) {
+	rec := &execRecorder{}
+	nft := &NFTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}}
+
+	if err := nft.Apply(testRules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"nft add table ip haaukins",
+		"nft add set ip haaukins labs { type ipv4_addr ; flags interval ; }",
+		"nft add map ip haaukins chains { type ipv4_addr : verdict ; flags interval ; }",
+		"nft add chain ip haaukins forward " + nftForwardHook,
+		"nft list chain ip haaukins forward",
+		"nft add rule ip haaukins forward ip saddr vmap @chains",
+		"nft add chain ip haaukins lab_elated_turing",
+		"nft add element ip haaukins chains { 77.1.2.0/24 : jump lab_elated_turing }",
+		"nft add element ip haaukins labs { 77.1.2.0/24 }",
+		"nft add rule ip haaukins lab_elated_turing ct state related,established return",
+		"nft add rule ip haaukins lab_elated_turing ip daddr 77.1.2.0/24 return",
+		"nft add rule ip haaukins lab_elated_turing ip daddr { 10.2.240.2/32, 10.2.241.2/32 } accept",
+		"nft add rule ip haaukins lab_elated_turing ip daddr @labs drop",
+		"nft add rule ip haaukins lab_elated_turing ip daddr { 1.1.1.1, 140.82.121.4 } return",
+		"nft add rule ip haaukins lab_elated_turing ip saddr 77.1.2.34 return",
+		"nft add rule ip haaukins lab_elated_turing ip saddr 77.1.2.35 ip daddr 8.8.8.0/24 return",
+		"nft add rule ip haaukins lab_elated_turing reject with icmp type port-unreachable",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+
+	rec.cmds = nil
+	if err := nft.Remove(testRules.Name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{
+		"nft delete element ip haaukins chains { 77.1.2.0/24 }",
+		"nft delete element ip haaukins labs { 77.1.2.0/24 }",
+		"nft flush chain ip haaukins lab_elated_turing",
+		"nft delete chain ip haaukins lab_elated_turing",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+}
+
+func TestNFTablesReconcile(t *testing.T) {
+	rec := &execRecorder{out: map[string]string{
+		"nft list map ip haaukins chains": `table ip haaukins {
+	map chains {
+		type ipv4_addr : verdict
+		flags interval
+		elements = { 77.1.2.0/24 : jump lab_active, 77.1.3.0/24 : jump lab_stale }
+	}
+}`,
+	}}
+	nft := &NFTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}}
+
+	if err := nft.Reconcile([]string{"active"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"nft list map ip haaukins chains",
+		"nft delete element ip haaukins chains { 77.1.3.0/24 }",
+		"nft delete element ip haaukins labs { 77.1.3.0/24 }",
+		"nft flush chain ip haaukins lab_stale",
+		"nft delete chain ip haaukins lab_stale",
+	}
+	if !reflect.DeepEqual(rec.cmds, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rec.cmds, "\n"))
+	}
+}
+
+func TestDualStackApply(t *testing.T) {
+	rules := testRules
+	rules.Subnet6 = "fd00:77:1:2::/64"
+	rules.Peers6 = []string{"fd00:10:2:f000::f0:2/128"}
+	rules.Instances = []InstanceRule{
+		{IP: "77.1.2.34", IP6: "fd00:77:1:2::34", Internet: true},
+		{IP: "77.1.2.35", Allow: []string{"8.8.8.0/24"}},
+	}
+
+	rec := &execRecorder{fail: []string{"ip6tables -S DOCKER-USER"}}
+	fw := &dualStack{
+		v4: &IPTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}},
+		v6: &IPTables{ipv6: true, execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}},
+	}
+	if err := fw.Apply(rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	chain := string(labChain(rules.Name))
+	var v6 []string
+	for _, c := range rec.cmds {
+		if strings.HasPrefix(c, "ip6tables") {
+			v6 = append(v6, c)
+		}
+	}
+	expected := []string{
+		"ip6tables -S DOCKER-USER",
+		"ip6tables -N DOCKER-USER",
+		"ip6tables --insert FORWARD -j DOCKER-USER",
+		"ip6tables -S HKN-LABS",
+		"ip6tables -N " + chain,
+		"ip6tables --insert DOCKER-USER -s fd00:77:1:2::/64 -j " + chain,
+		"ip6tables -A HKN-LABS -d fd00:77:1:2::/64 -j DROP",
+		"ip6tables -A " + chain + " -m state --state RELATED,ESTABLISHED -j RETURN",
+		"ip6tables -A " + chain + " -d fd00:77:1:2::/64 -j RETURN",
+		"ip6tables -A " + chain + " -d fd00:10:2:f000::f0:2/128 -j ACCEPT",
+		"ip6tables -A " + chain + " -j HKN-LABS",
+		"ip6tables -A " + chain + " -d 2606:50c0::1 -j RETURN",
+		"ip6tables -A " + chain + " -s fd00:77:1:2::34 -j RETURN",
+		"ip6tables -A " + chain + " -j REJECT --reject-with icmp6-port-unreachable",
+	}
+	if !reflect.DeepEqual(v6, expected) {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(v6, "\n"))
+	}
+
+	rec = &execRecorder{}
+	fw = &dualStack{
+		v4: &NFTables{execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}},
+		v6: &NFTables{ipv6: true, execFunc: rec.exec, lookup: lookup, subnets: map[string]string{}},
+	}
+	if err := fw.Apply(rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range []string{
+		"nft add map ip6 haaukins chains { type ipv6_addr : verdict ; flags interval ; }",
+		"nft add rule ip6 haaukins forward ip6 saddr vmap @chains",
+		"nft add element ip6 haaukins chains { fd00:77:1:2::/64 : jump lab_elated_turing }",
+		"nft add rule ip6 haaukins lab_elated_turing ip6 daddr 2606:50c0::1 return",
+		"nft add rule ip6 haaukins lab_elated_turing reject with icmpv6 type port-unreachable",
+	} {
+		if !contains(rec.cmds, c) {
+			t.Fatalf("expected command %q, got:\n%s", c, strings.Join(rec.cmds, "\n"))
+		}
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package firewall
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	cmd  = "iptables"
+	cmd6 = "ip6tables"
+
+	// DOCKER-USER is evaluated before the rules of docker
+	dockerUserC = Chain("DOCKER-USER")
+	forwardC    = Chain("FORWARD")
+	// labsC drops traffic to the subnets of all labs
+	labsC = Chain("HKN-LABS")
+	// chains of labs are prefixed, such that they are
+	// found when reconciling
+	labPrefix = "HKN-L-"
+
+	//name of the targets
+	acceptP = Target("ACCEPT")
+	dropP   = Target("DROP")
+	rejectP = Target("REJECT")
+	returnP = Target("RETURN")
+
+	deleteA = Action("-D")       // delete action
+	insertA = Action("--insert") // insert action
+	appendA = Action("-A")       // append action
+)
+
+type Action string
+
+type Chain string
+
+type Target string
+
+// IPTables keeps a chain per lab, which is jumped to from
+// DOCKER-USER for the traffic from the subnet of the lab
+type IPTables struct {
+	sudo bool
+	// ipv6 manages the rules of ip6tables
+	ipv6 bool
+
+	// flags to service
+	flags []string
+
+	// Implementation of ExecFunc.
+	execFunc ExecFunc
+	lookup   resolver
+
+	m       sync.Mutex
+	subnets map[string]string
+}
+
+func NewIPTables() *IPTables {
+	return &IPTables{
+		sudo:     true,
+		execFunc: shellExec,
+		lookup:   net.LookupIP,
+		subnets:  map[string]string{},
+	}
+}
+
+// NewIP6Tables returns the firewall of the IPv6
+// subnets of labs, its chains mirror the IPv4 chains
+func NewIP6Tables() *IPTables {
+	ipTab := NewIPTables()
+	ipTab.ipv6 = true
+
+	return ipTab
+}
+
+// labChain returns the chain of a lab, the name of a lab is hashed
+// as chain names of iptables are limited to 28 characters
+func labChain(name string) Chain {
+	sum := sha1.Sum([]byte(name))
+	return Chain(labPrefix + hex.EncodeToString(sum[:])[:12])
+}
+
+func (ipTab *IPTables) Apply(r LabRules) error {
+	ipTab.m.Lock()
+	defer ipTab.m.Unlock()
+
+	if err := ipTab.ensureLabsChain(); err != nil {
+		return err
+	}
+
+	chain := labChain(r.Name)
+	if subnet, ok := ipTab.subnets[r.Name]; ok {
+		if _, err := ipTab.execute("-F", string(chain)); err != nil {
+			return err
+		}
+		if subnet != r.Subnet {
+			ipTab.unlink(chain, subnet)
+			if err := ipTab.link(chain, r.Subnet); err != nil {
+				return err
+			}
+		}
+	} else {
+		if _, err := ipTab.execute("-N", string(chain)); err != nil {
+			return err
+		}
+		if err := ipTab.link(chain, r.Subnet); err != nil {
+			return err
+		}
+	}
+	ipTab.subnets[r.Name] = r.Subnet
+
+	for _, rule := range ipTab.rules(r) {
+		args := append([]string{string(appendA), string(chain)}, rule...)
+		if _, err := ipTab.execute(args...); err != nil {
+			return err
+		}
+	}
+	log.Debug().Str("lab", r.Name).Str("chain", string(chain)).Msg("Applied iptables rules of lab")
+
+	return nil
+}
+
+func (ipTab *IPTables) rules(r LabRules) [][]string {
+	rules := [][]string{
+		{"-m", "state", "--state", "RELATED,ESTABLISHED", "-j", string(returnP)},
+		{"-d", r.Subnet, "-j", string(returnP)},
+	}
+	if len(r.Peers) > 0 {
+		rules = append(rules, []string{"-d", strings.Join(r.Peers, ","), "-j", string(acceptP)})
+	}
+	if r.Policy.Isolate {
+		rules = append(rules, []string{"-j", string(labsC)})
+	}
+	if allow := ipTab.lookup.resolve(r.Policy.Allow, ipTab.ipv6); len(allow) > 0 {
+		rules = append(rules, []string{"-d", strings.Join(allow, ","), "-j", string(returnP)})
+	}
+	for _, i := range r.Instances {
+		if allow := ipTab.lookup.resolve(i.Allow, ipTab.ipv6); len(allow) > 0 {
+			rules = append(rules, []string{"-s", i.IP, "-d", strings.Join(allow, ","), "-j", string(returnP)})
+		}
+		if i.Internet {
+			rules = append(rules, []string{"-s", i.IP, "-j", string(returnP)})
+		}
+	}
+	if r.Policy.BlockInternet {
+		reject := "icmp-port-unreachable"
+		if ipTab.ipv6 {
+			reject = "icmp6-port-unreachable"
+		}
+		rules = append(rules, []string{"-j", string(rejectP), "--reject-with", reject})
+	}
+
+	return rules
+}
+
+func (ipTab *IPTables) Remove(name string) error {
+	ipTab.m.Lock()
+	defer ipTab.m.Unlock()
+
+	subnet, ok := ipTab.subnets[name]
+	if !ok {
+		return nil
+	}
+	delete(ipTab.subnets, name)
+
+	return ipTab.removeChain(labChain(name), subnet)
+}
+
+// Reconcile removes the chains of labs which are not active,
+// the chain of a lab is found through the jump from DOCKER-USER
+func (ipTab *IPTables) Reconcile(active []string) error {
+	ipTab.m.Lock()
+	defer ipTab.m.Unlock()
+
+	keep := map[Chain]bool{}
+	for _, name := range active {
+		keep[labChain(name)] = true
+	}
+
+	out, err := ipTab.execute("-S", string(dockerUserC))
+	if err != nil {
+		if ipTab.ipv6 {
+			// no dual-stack lab was created yet
+			return nil
+		}
+		return err
+	}
+	jumps := map[Chain]string{}
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		// -A DOCKER-USER -s 77.1.2.0/24 -j HKN-L-0123456789ab
+		f := strings.Fields(sc.Text())
+		if len(f) == 6 && f[2] == "-s" && f[4] == "-j" && strings.HasPrefix(f[5], labPrefix) {
+			jumps[Chain(f[5])] = f[3]
+		}
+	}
+
+	for chain, subnet := range jumps {
+		if keep[chain] {
+			continue
+		}
+		log.Info().Str("chain", string(chain)).Str("subnet", subnet).Msg("Removing stale iptables rules of lab")
+		if err := ipTab.removeChain(chain, subnet); err != nil {
+			return err
+		}
+	}
+
+	// subnets of labs which are not active are not isolated anymore
+	if _, err := ipTab.execute("-F", string(labsC)); err != nil {
+		// no lab was created yet
+		return nil
+	}
+	for name, subnet := range ipTab.subnets {
+		if !contains(active, name) {
+			delete(ipTab.subnets, name)
+			continue
+		}
+		ipTab.execute(string(appendA), string(labsC), "-d", subnet, "-j", string(dropP))
+	}
+
+	return nil
+}
+
+// RemoveLegacyRules removes the rules which daemons before the
+// firewall of labs inserted directly into DOCKER-USER for the
+// subnets of VPN labs, which are left whatever backend is used
+func RemoveLegacyRules() error {
+	return NewIPTables().removeLegacyRules()
+}
+
+// removeLegacyRules deletes the reject, state and accept rules from
+// the subnets of labs, a subnet is only considered a lab subnet when
+// it has both the reject and the state rule of the legacy rules
+func (ipTab *IPTables) removeLegacyRules() error {
+	ipTab.m.Lock()
+	defer ipTab.m.Unlock()
+
+	out, err := ipTab.execute("-S", string(dockerUserC))
+	if err != nil {
+		// docker did not create the chain
+		return nil
+	}
+
+	var rules [][]string
+	rejected := map[string]bool{}
+	stateful := map[string]bool{}
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		// -A DOCKER-USER -s 77.1.2.0/24 -j REJECT --reject-with icmp-port-unreachable
+		// -A DOCKER-USER -s 77.1.2.0/24 -m state --state RELATED,ESTABLISHED -j RETURN
+		// -A DOCKER-USER -s 77.1.2.0/24 -d 10.2.240.2/32 -j ACCEPT
+		f := strings.Fields(sc.Text())
+		if len(f) < 6 || f[0] != string(appendA) || f[2] != "-s" {
+			continue
+		}
+		subnet, rule := f[3], strings.Join(f[4:], " ")
+		switch {
+		case rule == "-j REJECT --reject-with icmp-port-unreachable":
+			rejected[subnet] = true
+		case rule == "-m state --state RELATED,ESTABLISHED -j RETURN":
+			stateful[subnet] = true
+		case len(f) == 8 && f[4] == "-d" && f[6] == "-j" && f[7] == string(acceptP):
+		default:
+			continue
+		}
+		rules = append(rules, f[1:])
+	}
+
+	for _, r := range rules {
+		subnet := r[2]
+		if !rejected[subnet] || !stateful[subnet] {
+			continue
+		}
+		args := append([]string{string(deleteA)}, r...)
+		if _, err := ipTab.execute(args...); err != nil {
+			return err
+		}
+		log.Info().Str("subnet", subnet).Msg("Removed legacy iptables rule of lab")
+	}
+
+	return nil
+}
+
+func (ipTab *IPTables) ensureLabsChain() error {
+	// docker only creates DOCKER-USER of ip6tables when
+	// its ip6tables option is enabled
+	if ipTab.ipv6 {
+		if _, err := ipTab.execute("-S", string(dockerUserC)); err != nil {
+			if _, err := ipTab.execute("-N", string(dockerUserC)); err != nil {
+				return err
+			}
+			if _, err := ipTab.execute(string(insertA), string(forwardC), "-j", string(dockerUserC)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := ipTab.execute("-S", string(labsC)); err == nil {
+		return nil
+	}
+
+	_, err := ipTab.execute("-N", string(labsC))
+	return err
+}
+
+// link jumps to the chain of a lab for the traffic from its
+// subnet and adds the subnet to the isolated lab subnets
+func (ipTab *IPTables) link(chain Chain, subnet string) error {
+	if _, err := ipTab.execute(string(insertA), string(dockerUserC), "-s", subnet, "-j", string(chain)); err != nil {
+		return err
+	}
+
+	_, err := ipTab.execute(string(appendA), string(labsC), "-d", subnet, "-j", string(dropP))
+	return err
+}
+
+func (ipTab *IPTables) unlink(chain Chain, subnet string) {
+	ipTab.execute(string(deleteA), string(dockerUserC), "-s", subnet, "-j", string(chain))
+	ipTab.execute(string(deleteA), string(labsC), "-d", subnet, "-j", string(dropP))
+}
+
+func (ipTab *IPTables) removeChain(chain Chain, subnet string) error {
+	ipTab.unlink(chain, subnet)
+	if _, err := ipTab.execute("-F", string(chain)); err != nil {
+		return err
+	}
+
+	_, err := ipTab.execute("-X", string(chain))
+	return err
+}
+
+// exec executes an ExecFunc using 'iptables' or 'ip6tables'.
+func (ipTab *IPTables) execute(args ...string) ([]byte, error) {
+	if ipTab.ipv6 {
+		return ipTab.exec(cmd6, args...)
+	}
+	return ipTab.exec(cmd, args...)
+}
+
+// exec executes an ExecFunc using 'iptables'.
+func (ipTab *IPTables) exec(cmd string, args ...string) ([]byte, error) {
+	return execute(ipTab.execFunc, ipTab.sudo, cmd, append(ipTab.flags, args...)...)
+}
+
+func execute(execFunc ExecFunc, sudo bool, cmd string, flags ...string) ([]byte, error) {
+	// If needed, prefix sudo.
+	if sudo {
+		flags = append([]string{cmd}, flags...)
+		cmd = "sudo"
+	}
+	log.Debug().Msgf("exec %s %v", cmd, flags)
+	out, err := execFunc(cmd, flags...)
+	if out != nil {
+		out = bytes.TrimSpace(out)
+		log.Debug().Msgf("exec: %q", string(out))
+	}
+	if err != nil {
+		// Wrap errors in Error type for further introspection
+		return nil, &Errori{
+			Out: out,
+			Err: err,
+		}
+	}
+	return out, nil
+}
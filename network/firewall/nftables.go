@@ -0,0 +1,282 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	nftCmd = "nft"
+
+	// haaukins keeps its rules in a table of its own, the forward
+	// chain runs before the chains of docker (priority filter)
+	nftFamily  = "ip"
+	nftFamily6 = "ip6"
+	nftTable   = "haaukins"
+	// labs holds the subnets of all labs, used to isolate them
+	nftLabsSet = "labs"
+	// chains maps the subnet of a lab to the chain of the lab
+	nftChainsMap   = "chains"
+	nftLabPrefix   = "lab_"
+	nftForwardHook = "{ type filter hook forward priority -1 ; policy accept ; }"
+)
+
+var (
+	nftJumpRe = regexp.MustCompile(`([0-9a-f:./]+) : jump (` + nftLabPrefix + `[A-Za-z0-9_]+)`)
+)
+
+// NFTables keeps a chain per lab within the haaukins table, the
+// forward chain jumps to the chain of a lab through a verdict map
+// from the subnet of the lab, such that no rule handles are needed
+type NFTables struct {
+	sudo bool
+	// ipv6 manages the rules of the ip6 table
+	ipv6     bool
+	execFunc ExecFunc
+	lookup   resolver
+
+	m       sync.Mutex
+	ready   bool
+	subnets map[string]string
+}
+
+func NewNFTables() *NFTables {
+	return &NFTables{
+		sudo:     true,
+		execFunc: shellExec,
+		lookup:   net.LookupIP,
+		subnets:  map[string]string{},
+	}
+}
+
+// NewNFTables6 returns the firewall of the IPv6 subnets
+// of labs, which keeps its rules in the ip6 table
+func NewNFTables6() *NFTables {
+	nft := NewNFTables()
+	nft.ipv6 = true
+
+	return nft
+}
+
+// family returns the family of the table, which is
+// also the keyword matching addresses of the family
+func (nft *NFTables) family() string {
+	if nft.ipv6 {
+		return nftFamily6
+	}
+	return nftFamily
+}
+
+func (nft *NFTables) addrType() string {
+	if nft.ipv6 {
+		return "ipv6_addr"
+	}
+	return "ipv4_addr"
+}
+
+func nftChain(name string) string {
+	return nftLabPrefix + strings.NewReplacer("-", "_", ".", "_").Replace(name)
+}
+
+func (nft *NFTables) Apply(r LabRules) error {
+	nft.m.Lock()
+	defer nft.m.Unlock()
+
+	if err := nft.ensureTable(); err != nil {
+		return err
+	}
+
+	chain := nftChain(r.Name)
+	if subnet, ok := nft.subnets[r.Name]; ok {
+		if _, err := nft.execute("flush", "chain", nft.family(), nftTable, chain); err != nil {
+			return err
+		}
+		if subnet != r.Subnet {
+			nft.unlink(subnet)
+			if err := nft.link(chain, r.Subnet); err != nil {
+				return err
+			}
+		}
+	} else {
+		if _, err := nft.execute("add", "chain", nft.family(), nftTable, chain); err != nil {
+			return err
+		}
+		if err := nft.link(chain, r.Subnet); err != nil {
+			return err
+		}
+	}
+	nft.subnets[r.Name] = r.Subnet
+
+	for _, rule := range nft.rules(r) {
+		args := append([]string{"add", "rule", nft.family(), nftTable, chain}, rule...)
+		if _, err := nft.execute(args...); err != nil {
+			return err
+		}
+	}
+	log.Debug().Str("lab", r.Name).Str("chain", chain).Msg("Applied nftables rules of lab")
+
+	return nil
+}
+
+func nftSet(elements []string) string {
+	if len(elements) == 1 {
+		return elements[0]
+	}
+
+	return fmt.Sprintf("{ %s }", strings.Join(elements, ", "))
+}
+
+func (nft *NFTables) rules(r LabRules) [][]string {
+	ip := nft.family()
+	rules := [][]string{
+		{"ct", "state", "related,established", "return"},
+		{ip, "daddr", r.Subnet, "return"},
+	}
+	if len(r.Peers) > 0 {
+		rules = append(rules, []string{ip, "daddr", nftSet(r.Peers), "accept"})
+	}
+	if r.Policy.Isolate {
+		rules = append(rules, []string{ip, "daddr", "@" + nftLabsSet, "drop"})
+	}
+	if allow := nft.lookup.resolve(r.Policy.Allow, nft.ipv6); len(allow) > 0 {
+		rules = append(rules, []string{ip, "daddr", nftSet(allow), "return"})
+	}
+	for _, i := range r.Instances {
+		if allow := nft.lookup.resolve(i.Allow, nft.ipv6); len(allow) > 0 {
+			rules = append(rules, []string{ip, "saddr", i.IP, ip, "daddr", nftSet(allow), "return"})
+		}
+		if i.Internet {
+			rules = append(rules, []string{ip, "saddr", i.IP, "return"})
+		}
+	}
+	if r.Policy.BlockInternet {
+		icmp := "icmp"
+		if nft.ipv6 {
+			icmp = "icmpv6"
+		}
+		rules = append(rules, []string{"reject", "with", icmp, "type", "port-unreachable"})
+	}
+
+	return rules
+}
+
+func (nft *NFTables) Remove(name string) error {
+	nft.m.Lock()
+	defer nft.m.Unlock()
+
+	subnet, ok := nft.subnets[name]
+	if !ok {
+		return nil
+	}
+	delete(nft.subnets, name)
+
+	return nft.removeChain(nftChain(name), subnet)
+}
+
+// Reconcile removes the chains of labs which are not active,
+// the chain of a lab is found through the verdict map
+func (nft *NFTables) Reconcile(active []string) error {
+	nft.m.Lock()
+	defer nft.m.Unlock()
+
+	keep := map[string]bool{}
+	for _, name := range active {
+		keep[nftChain(name)] = true
+	}
+
+	out, err := nft.execute("list", "map", nft.family(), nftTable, nftChainsMap)
+	if err != nil {
+		// the table does not exist before the first lab
+		return nil
+	}
+
+	for _, m := range nftJumpRe.FindAllStringSubmatch(string(out), -1) {
+		subnet, chain := m[1], m[2]
+		if keep[chain] {
+			continue
+		}
+		log.Info().Str("chain", chain).Str("subnet", subnet).Msg("Removing stale nftables rules of lab")
+		if err := nft.removeChain(chain, subnet); err != nil {
+			return err
+		}
+	}
+	for name := range nft.subnets {
+		if !contains(active, name) {
+			delete(nft.subnets, name)
+		}
+	}
+
+	return nil
+}
+
+func (nft *NFTables) ensureTable() error {
+	if nft.ready {
+		return nil
+	}
+
+	cmds := [][]string{
+		{"add", "table", nft.family(), nftTable},
+		{"add", "set", nft.family(), nftTable, nftLabsSet, fmt.Sprintf("{ type %s ; flags interval ; }", nft.addrType())},
+		{"add", "map", nft.family(), nftTable, nftChainsMap, fmt.Sprintf("{ type %s : verdict ; flags interval ; }", nft.addrType())},
+		{"add", "chain", nft.family(), nftTable, "forward", nftForwardHook},
+	}
+	for _, c := range cmds {
+		if _, err := nft.execute(c...); err != nil {
+			return err
+		}
+	}
+
+	// the rule is only added when the chain is empty, as the
+	// table is kept when the daemon is restarted
+	out, err := nft.execute("list", "chain", nft.family(), nftTable, "forward")
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(string(out), "@"+nftChainsMap) {
+		if _, err := nft.execute("add", "rule", nft.family(), nftTable, "forward", nft.family(), "saddr", "vmap", "@"+nftChainsMap); err != nil {
+			return err
+		}
+	}
+	nft.ready = true
+
+	return nil
+}
+
+func (nft *NFTables) link(chain, subnet string) error {
+	if _, err := nft.execute("add", "element", nft.family(), nftTable, nftChainsMap, fmt.Sprintf("{ %s : jump %s }", subnet, chain)); err != nil {
+		return err
+	}
+
+	_, err := nft.execute("add", "element", nft.family(), nftTable, nftLabsSet, fmt.Sprintf("{ %s }", subnet))
+	return err
+}
+
+func (nft *NFTables) unlink(subnet string) {
+	nft.execute("delete", "element", nft.family(), nftTable, nftChainsMap, fmt.Sprintf("{ %s }", subnet))
+	nft.execute("delete", "element", nft.family(), nftTable, nftLabsSet, fmt.Sprintf("{ %s }", subnet))
+}
+
+func (nft *NFTables) removeChain(chain, subnet string) error {
+	// the chain cannot be deleted while the map jumps to it
+	nft.unlink(subnet)
+	if _, err := nft.execute("flush", "chain", nft.family(), nftTable, chain); err != nil {
+		return err
+	}
+
+	_, err := nft.execute("delete", "chain", nft.family(), nftTable, chain)
+	return err
+}
+
+// execute executes an ExecFunc using 'nft'.
+func (nft *NFTables) execute(args ...string) ([]byte, error) {
+	return execute(nft.execFunc, nft.sudo, nftCmd, args...)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package forward
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPortsPerTeam = 5
+	dialTimeout         = 5 * time.Second
+)
+
+var (
+	InvalidRangeErr = errors.New("invalid port range, expected <first>-<last>")
+	NoPortsErr      = errors.New("no ports are left in the port range")
+)
+
+// Config of the host ports of the TCP services forwarded to teams
+type Config struct {
+	// TCPPorts is the range of host ports, e.g. 40000-49999
+	TCPPorts string `yaml:"tcp-ports,omitempty"`
+	// PortsPerTeam is the amount of ports of the range given to every team
+	PortsPerTeam int `yaml:"ports-per-team,omitempty"`
+	// Address the ports are bound to, all interfaces when empty
+	Address string `yaml:"address,omitempty"`
+}
+
+// Ports hands out blocks of the port range to teams,
+// every TCP service of a team gets a port of its block
+type Ports struct {
+	m       sync.Mutex
+	first   int
+	last    int
+	perTeam int
+	address string
+	// owners of the blocks by their first port
+	blocks map[int]string
+}
+
+func NewPorts(conf Config) (*Ports, error) {
+	parts := strings.Split(conf.TCPPorts, "-")
+	if len(parts) != 2 {
+		return nil, InvalidRangeErr
+	}
+	first, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return nil, InvalidRangeErr
+	}
+	last, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return nil, InvalidRangeErr
+	}
+	if first < 1 || last > 65535 || first > last {
+		return nil, InvalidRangeErr
+	}
+
+	perTeam := conf.PortsPerTeam
+	if perTeam <= 0 {
+		perTeam = defaultPortsPerTeam
+	}
+	if perTeam > last-first+1 {
+		return nil, fmt.Errorf("port range %s is smaller than the ports of a team", conf.TCPPorts)
+	}
+
+	return &Ports{
+		first:   first,
+		last:    last,
+		perTeam: perTeam,
+		address: conf.Address,
+		blocks:  map[int]string{},
+	}, nil
+}
+
+// Allocate returns the first port of a free block of ports,
+// the block of the owner is returned when it has one
+func (p *Ports) Allocate(owner string) (int, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	for port, o := range p.blocks {
+		if o == owner {
+			return port, nil
+		}
+	}
+
+	for port := p.first; port+p.perTeam-1 <= p.last; port += p.perTeam {
+		if _, ok := p.blocks[port]; ok {
+			continue
+		}
+		p.blocks[port] = owner
+		return port, nil
+	}
+
+	return 0, NoPortsErr
+}
+
+// Release frees the block of ports of the owner
+func (p *Ports) Release(owner string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	for port, o := range p.blocks {
+		if o == owner {
+			delete(p.blocks, port)
+		}
+	}
+}
+
+// PerTeam is the amount of ports in a block
+func (p *Ports) PerTeam() int {
+	return p.perTeam
+}
+
+// Address is the address the ports are bound to
+func (p *Ports) Address() string {
+	return p.address
+}
+
+// TCP forwards the connections accepted on a port of the host to a
+// service within a lab, until it is closed
+type TCP struct {
+	l      net.Listener
+	target string
+
+	m      sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// ListenTCP forwards the connections on the port of the address to the target
+func ListenTCP(address string, port int, target string) (*TCP, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &TCP{
+		l:      l,
+		target: target,
+		conns:  map[net.Conn]struct{}{},
+	}
+	go t.serve()
+
+	return t, nil
+}
+
+// Addr is the address of the listener on the host
+func (t *TCP) Addr() net.Addr {
+	return t.l.Addr()
+}
+
+func (t *TCP) serve() {
+	for {
+		conn, err := t.l.Accept()
+		if err != nil {
+			return
+		}
+		go t.forward(conn)
+	}
+}
+
+func (t *TCP) forward(conn net.Conn) {
+	target, err := net.DialTimeout("tcp", t.target, dialTimeout)
+	if err != nil {
+		log.Debug().Str("target", t.target).Msgf("Unable to reach forwarded service: %v", err)
+		conn.Close()
+		return
+	}
+
+	if !t.track(conn, target) {
+		conn.Close()
+		target.Close()
+		return
+	}
+	defer t.untrack(conn, target)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		io.Copy(dst, src)
+		// unblocks the copy in the other direction
+		dst.Close()
+		src.Close()
+		done <- struct{}{}
+	}
+	go pipe(target, conn)
+	go pipe(conn, target)
+	<-done
+	<-done
+}
+
+func (t *TCP) track(conns ...net.Conn) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.closed {
+		return false
+	}
+	for _, c := range conns {
+		t.conns[c] = struct{}{}
+	}
+	return true
+}
+
+func (t *TCP) untrack(conns ...net.Conn) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, c := range conns {
+		delete(t.conns, c)
+	}
+}
+
+// Close stops the listener and closes the forwarded connections
+func (t *TCP) Close() error {
+	t.m.Lock()
+	t.closed = true
+	for c := range t.conns {
+		c.Close()
+	}
+	t.m.Unlock()
+
+	return t.l.Close()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package forward
+
+import (
+	"bufio"
+	"net"
+	"testing"
+)
+
+func TestNewPorts(t *testing.T) {
+	tt := []struct {
+		name string
+		conf Config
+		err  bool
+	}{
+		{name: "Normal", conf: Config{TCPPorts: "40000-40009", PortsPerTeam: 5}},
+		{name: "Default ports per team", conf: Config{TCPPorts: "40000-40009"}},
+		{name: "Missing range", conf: Config{}, err: true},
+		{name: "Reversed range", conf: Config{TCPPorts: "40009-40000"}, err: true},
+		{name: "Invalid port", conf: Config{TCPPorts: "40000-70000"}, err: true},
+		{name: "Range too small", conf: Config{TCPPorts: "40000-40001", PortsPerTeam: 5}, err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPorts(tc.conf)
+			if tc.err && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestPortsAllocate(t *testing.T) {
+	p, err := NewPorts(Config{TCPPorts: "40000-40011", PortsPerTeam: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first, err := p.Allocate("event/team1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := p.Allocate("event/team2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first == second || first != 40000 && first != 40005 || second != 40000 && second != 40005 {
+		t.Fatalf("expected blocks at 40000 and 40005, got %d and %d", first, second)
+	}
+
+	// the remaining ports are less than a block
+	if _, err := p.Allocate("event/team3"); err != NoPortsErr {
+		t.Fatalf("expected error %v, got %v", NoPortsErr, err)
+	}
+
+	again, err := p.Allocate("event/team1")
+	if err != nil || again != first {
+		t.Fatalf("expected block %d of the owner, got %d (%v)", first, again, err)
+	}
+
+	p.Release("event/team1")
+	third, err := p.Allocate("event/team3")
+	if err != nil || third != first {
+		t.Fatalf("expected released block %d, got %d (%v)", first, third, err)
+	}
+}
+
+func TestTCP(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, _ := bufio.NewReader(conn).ReadString('\n')
+				conn.Write([]byte(line))
+			}()
+		}
+	}()
+
+	fwd, err := ListenTCP("127.0.0.1", 0, echo.Addr().String())
+	if err != nil {
+		t.Fatalf("unable to forward: %v", err)
+	}
+
+	conn, err := net.Dial("tcp", fwd.Addr().String())
+	if err != nil {
+		t.Fatalf("unable to connect to forwarded port: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("hello\n")); err != nil {
+		t.Fatalf("unable to write: %v", err)
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		t.Fatalf("unable to read: %v", err)
+	}
+	if line != "hello\n" {
+		t.Fatalf("expected echo of hello, got %q", line)
+	}
+
+	if err := fwd.Close(); err != nil {
+		t.Fatalf("unexpected error on close: %v", err)
+	}
+	if _, err := net.Dial("tcp", fwd.Addr().String()); err == nil {
+		t.Fatalf("expected forwarded port to be closed")
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package subnet
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/vishvananda/netlink"
+)
+
+type Kind string
+
+const (
+	// Lab is the subnet of the network of a lab
+	Lab Kind = "lab"
+	// Segment is the subnet of a network segment of an exercise
+	Segment Kind = "segment"
+	// VPN is the subnet of the wireguard interface of an event
+	VPN Kind = "vpn"
+	// Service is the subnet of a network of the daemon itself,
+	// e.g. the bridge between the frontends and guacamole
+	Service Kind = "service"
+)
+
+var (
+	NoSubnetErr    = errors.New("no subnets are left")
+	UnknownKindErr = errors.New("unknown kind of subnet")
+	InUseErr       = errors.New("subnet is in use")
+)
+
+// Allocation is a subnet in use by haaukins
+type Allocation struct {
+	Subnet    string
+	Kind      Kind
+	Owner     string
+	CreatedAt time.Time
+	// Conflict is the host route or Docker network overlapping
+	// the subnet, which is found when allocations are loaded
+	Conflict string
+}
+
+// Store keeps the allocations across restarts of the daemon
+type Store interface {
+	Add(Allocation) error
+	Release(subnet string) error
+	List() ([]Allocation, error)
+}
+
+// pool is a range of candidate subnets, the first octet is fixed
+// while the second and third octet run through inclusive ranges
+type pool struct {
+	first  int
+	second [2]int
+	third  [2]int
+	bits   int
+}
+
+func (p pool) size() int {
+	return (p.second[1] - p.second[0] + 1) * (p.third[1] - p.third[0] + 1)
+}
+
+func (p pool) subnet(i int) string {
+	n := p.third[1] - p.third[0] + 1
+	return fmt.Sprintf("%d.%d.%d.0/%d", p.first, p.second[0]+i/n, p.third[0]+i%n, p.bits)
+}
+
+var (
+	// networks of labs, segments and services are /24 subnets
+	networkPools = []pool{
+		{first: 77, second: [2]int{0, 254}, third: [2]int{0, 254}, bits: 24},
+		{first: 34, second: [2]int{25, 30}, third: [2]int{0, 254}, bits: 24},
+		{first: 22, second: [2]int{168, 168}, third: [2]int{0, 254}, bits: 24},
+	}
+	// wireguard interfaces of events are /22 subnets at x.y.240.0
+	vpnPools = []pool{
+		{first: 25, second: [2]int{0, 254}, third: [2]int{240, 240}, bits: 22},
+		{first: 35, second: [2]int{0, 254}, third: [2]int{240, 240}, bits: 22},
+	}
+
+	pools = map[Kind][]pool{
+		Lab:     networkPools,
+		Segment: networkPools,
+		Service: networkPools,
+		VPN:     vpnPools,
+	}
+)
+
+// Allocator hands out the subnets of labs, segments, VPN interfaces
+// and services, such that they neither overlap each other nor host
+// routes and Docker networks not created by haaukins
+type Allocator struct {
+	m         sync.Mutex
+	store     Store
+	allocated map[string]Allocation
+	reserved  []*net.IPNet
+}
+
+// NewAllocator returns an allocator persisting its allocations
+// in the store, allocations are kept in memory when it is nil
+func NewAllocator(s Store) *Allocator {
+	return &Allocator{
+		store:     s,
+		allocated: map[string]Allocation{},
+	}
+}
+
+// Load restores the allocations of the store and reserves the taken
+// subnets. Stored subnets of networks which no longer exist (live) are
+// released, while live networks missing in the store are added to it.
+// VPN subnets are kept until their event releases them. When the
+// store cannot be read, the live networks and taken subnets are
+// still reserved and the error is returned.
+func (a *Allocator) Load(live []Allocation, taken []string) error {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	isLive := map[string]bool{}
+	for _, l := range live {
+		isLive[l.Subnet] = true
+	}
+
+	allocated := map[string]Allocation{}
+	var storeErr error
+	if a.store != nil {
+		stored, err := a.store.List()
+		if err != nil {
+			storeErr = err
+		}
+		for _, al := range stored {
+			if al.Kind != VPN && !isLive[al.Subnet] {
+				log.Debug().Str("subnet", al.Subnet).Msg("Releasing subnet of removed network")
+				if err := a.store.Release(al.Subnet); err != nil {
+					log.Warn().Str("subnet", al.Subnet).Msgf("Unable to release subnet: %v", err)
+				}
+				continue
+			}
+			allocated[al.Subnet] = al
+		}
+	}
+
+	for _, l := range live {
+		if _, ok := allocated[l.Subnet]; ok {
+			continue
+		}
+		if l.CreatedAt.IsZero() {
+			l.CreatedAt = time.Now()
+		}
+		allocated[l.Subnet] = l
+		// the live network may be stored already
+		if storeErr == nil {
+			a.persist(l)
+		}
+	}
+
+	var reserved []*net.IPNet
+	for _, s := range taken {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil || n.IP.To4() == nil {
+			continue
+		}
+		// routes of the networks of haaukins itself
+		if _, ok := allocated[n.String()]; ok {
+			continue
+		}
+		reserved = append(reserved, n)
+	}
+
+	for s, al := range allocated {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			continue
+		}
+		for _, r := range reserved {
+			if overlaps(n, r) {
+				log.Warn().Str("subnet", s).Str("kind", string(al.Kind)).Msgf("Subnet overlaps %s", r)
+				al.Conflict = r.String()
+				allocated[s] = al
+				break
+			}
+		}
+	}
+
+	a.allocated = allocated
+	a.reserved = reserved
+
+	return storeErr
+}
+
+// Allocate returns a free subnet of the kind, the subnet
+// already allocated to the owner is returned when it has one
+func (a *Allocator) Allocate(kind Kind, owner string) (string, error) {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	for _, al := range a.allocated {
+		if owner != "" && al.Kind == kind && al.Owner == owner {
+			return al.Subnet, nil
+		}
+	}
+
+	ps, ok := pools[kind]
+	if !ok {
+		return "", UnknownKindErr
+	}
+	var total int
+	for _, p := range ps {
+		total += p.size()
+	}
+
+	start := rand.Intn(total)
+	for i := 0; i < total; i++ {
+		s := candidate(ps, (start+i)%total)
+		if !a.free(s) {
+			continue
+		}
+
+		al := Allocation{
+			Subnet:    s,
+			Kind:      kind,
+			Owner:     owner,
+			CreatedAt: time.Now(),
+		}
+		a.allocated[s] = al
+		a.persist(al)
+
+		return s, nil
+	}
+
+	return "", NoSubnetErr
+}
+
+// Reserve allocates the given subnet, which has been allocated
+// elsewhere, e.g. by the daemon for a lab on a worker
+func (a *Allocator) Reserve(kind Kind, owner, subnet string) error {
+	s := Of(subnet)
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	if _, ok := pools[kind]; !ok {
+		return UnknownKindErr
+	}
+	if !a.free(s) {
+		return InUseErr
+	}
+
+	al := Allocation{
+		Subnet:    s,
+		Kind:      kind,
+		Owner:     owner,
+		CreatedAt: time.Now(),
+	}
+	a.allocated[s] = al
+	a.persist(al)
+
+	return nil
+}
+
+// Release frees the subnet, which may be given
+// as the address of an interface within the subnet
+func (a *Allocator) Release(subnet string) {
+	s := Of(subnet)
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	if _, ok := a.allocated[s]; !ok {
+		return
+	}
+	delete(a.allocated, s)
+
+	if a.store != nil {
+		if err := a.store.Release(s); err != nil {
+			log.Warn().Str("subnet", s).Msgf("Unable to release subnet in store: %v", err)
+		}
+	}
+}
+
+// Allocations returns the current allocations ordered by subnet
+func (a *Allocator) Allocations() []Allocation {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	var res []Allocation
+	for _, al := range a.allocated {
+		res = append(res, al)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Subnet < res[j].Subnet
+	})
+
+	return res
+}
+
+func (a *Allocator) persist(al Allocation) {
+	if a.store == nil {
+		return
+	}
+	if err := a.store.Add(al); err != nil {
+		log.Warn().Str("subnet", al.Subnet).Msgf("Unable to store subnet: %v", err)
+	}
+}
+
+func (a *Allocator) free(s string) bool {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return false
+	}
+
+	for _, r := range a.reserved {
+		if overlaps(n, r) {
+			return false
+		}
+	}
+	for allocated := range a.allocated {
+		_, an, err := net.ParseCIDR(allocated)
+		if err == nil && overlaps(n, an) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func candidate(ps []pool, i int) string {
+	for _, p := range ps {
+		if i < p.size() {
+			return p.subnet(i)
+		}
+		i -= p.size()
+	}
+	return ""
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// Of returns the subnet of an address in CIDR notation,
+// 10.20.240.1/22 becomes 10.20.240.0/22
+func Of(address string) string {
+	_, n, err := net.ParseCIDR(address)
+	if err != nil {
+		return address
+	}
+	return n.String()
+}
+
+// HostSubnets returns the IPv4 subnets of the routes
+// and interface addresses of the host
+func HostSubnets() ([]string, error) {
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []string
+	for _, r := range routes {
+		if r.Dst == nil {
+			continue
+		}
+		subnets = append(subnets, r.Dst.String())
+	}
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() == nil || ipNet.IP.IsLoopback() {
+			continue
+		}
+		subnets = append(subnets, Of(ipNet.String()))
+	}
+
+	return subnets, nil
+}
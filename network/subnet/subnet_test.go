@@ -0,0 +1,243 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package subnet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type fakeStore struct {
+	allocations map[string]Allocation
+	released    []string
+}
+
+func newFakeStore(als ...Allocation) *fakeStore {
+	fs := &fakeStore{allocations: map[string]Allocation{}}
+	for _, al := range als {
+		fs.allocations[al.Subnet] = al
+	}
+	return fs
+}
+
+func (fs *fakeStore) Add(al Allocation) error {
+	fs.allocations[al.Subnet] = al
+	return nil
+}
+
+func (fs *fakeStore) Release(s string) error {
+	delete(fs.allocations, s)
+	fs.released = append(fs.released, s)
+	return nil
+}
+
+func (fs *fakeStore) List() ([]Allocation, error) {
+	var res []Allocation
+	for _, al := range fs.allocations {
+		res = append(res, al)
+	}
+	return res, nil
+}
+
+func TestAllocate(t *testing.T) {
+	fs := newFakeStore()
+	a := NewAllocator(fs)
+
+	total := 0
+	for _, p := range vpnPools {
+		total += p.size()
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < total; i++ {
+		s, err := a.Allocate(VPN, "")
+		if err != nil {
+			t.Fatalf("unexpected error on allocation %d: %v", i, err)
+		}
+		if seen[s] {
+			t.Fatalf("subnet %s allocated twice", s)
+		}
+		seen[s] = true
+	}
+
+	if _, err := a.Allocate(VPN, ""); err != NoSubnetErr {
+		t.Fatalf("expected error %v, got %v", NoSubnetErr, err)
+	}
+	if len(fs.allocations) != total {
+		t.Fatalf("expected %d stored allocations, got %d", total, len(fs.allocations))
+	}
+
+	if _, err := a.Allocate(Kind("unknown"), ""); err != UnknownKindErr {
+		t.Fatalf("expected error %v, got %v", UnknownKindErr, err)
+	}
+}
+
+func TestAllocateOwner(t *testing.T) {
+	a := NewAllocator(nil)
+
+	first, err := a.Allocate(VPN, "event")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := a.Allocate(VPN, "event")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected the same subnet for the same owner, got %s and %s", first, second)
+	}
+
+	a.Release("25.0.240.1/22")
+	a.Release(first)
+	if n := len(a.Allocations()); n != 0 {
+		t.Fatalf("expected no allocations after release, got %d", n)
+	}
+}
+
+func TestAllocateReserved(t *testing.T) {
+	a := NewAllocator(nil)
+	// reserve every candidate of the pools but one
+	if err := a.Load(nil, []string{"77.0.0.0/8", "34.0.0.0/8", "22.168.0.0/17", "22.168.128.0/18", "22.168.192.0/19", "22.168.224.0/20", "22.168.240.0/21", "22.168.248.0/22", "22.168.252.0/23"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s, err := a.Allocate(Lab, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s != "22.168.254.0/24" {
+		t.Fatalf("expected the only free subnet 22.168.254.0/24, got %s", s)
+	}
+	if _, err := a.Allocate(Segment, ""); err != NoSubnetErr {
+		t.Fatalf("expected error %v, got %v", NoSubnetErr, err)
+	}
+}
+
+func TestReserve(t *testing.T) {
+	a := NewAllocator(nil)
+	if err := a.Load(nil, []string{"77.1.0.0/16"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := a.Reserve(Lab, "lab", "77.2.3.0/24"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if al := a.Allocations(); len(al) != 1 || al[0].Subnet != "77.2.3.0/24" || al[0].Owner != "lab" {
+		t.Fatalf("expected reserved subnet to be allocated, got %v", al)
+	}
+
+	for _, s := range []string{"77.2.3.0/24", "77.1.5.0/24"} {
+		if err := a.Reserve(Lab, "other", s); err != InUseErr {
+			t.Fatalf("expected error %v for %s, got %v", InUseErr, s, err)
+		}
+	}
+	if err := a.Reserve(Kind("unknown"), "", "77.4.0.0/24"); err != UnknownKindErr {
+		t.Fatalf("expected error %v, got %v", UnknownKindErr, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fs := newFakeStore(
+		Allocation{Subnet: "77.1.1.0/24", Kind: Lab, Owner: "removed"},
+		Allocation{Subnet: "77.2.2.0/24", Kind: Lab, Owner: "running"},
+		Allocation{Subnet: "25.3.240.0/22", Kind: VPN, Owner: "event"},
+	)
+	a := NewAllocator(fs)
+
+	live := []Allocation{
+		{Subnet: "77.2.2.0/24", Kind: Lab, Owner: "running"},
+		{Subnet: "77.4.4.0/24", Kind: Segment, Owner: "unknown"},
+	}
+	taken := []string{"77.2.2.0/24", "25.3.0.0/16", "192.168.1.0/24", "fd00::/64"}
+	if err := a.Load(live, taken); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fs.released) != 1 || fs.released[0] != "77.1.1.0/24" {
+		t.Fatalf("expected stored subnet of removed network to be released, got %v", fs.released)
+	}
+	if _, ok := fs.allocations["77.4.4.0/24"]; !ok {
+		t.Fatalf("expected live network missing in store to be added")
+	}
+
+	expected := map[string]string{
+		"25.3.240.0/22": "25.3.0.0/16",
+		"77.2.2.0/24":   "",
+		"77.4.4.0/24":   "",
+	}
+	als := a.Allocations()
+	if len(als) != len(expected) {
+		t.Fatalf("expected %d allocations, got %d", len(expected), len(als))
+	}
+	for _, al := range als {
+		conflict, ok := expected[al.Subnet]
+		if !ok {
+			t.Fatalf("unexpected allocation of %s", al.Subnet)
+		}
+		if al.Conflict != conflict {
+			t.Fatalf("expected conflict (%s) for %s, got (%s)", conflict, al.Subnet, al.Conflict)
+		}
+	}
+
+	s, err := a.Allocate(VPN, "event")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s != "25.3.240.0/22" {
+		t.Fatalf("expected restored subnet of event, got %s", s)
+	}
+}
+
+type failingStore struct {
+	fakeStore
+}
+
+func (fs *failingStore) List() ([]Allocation, error) {
+	return nil, errors.New("store is down")
+}
+
+func TestLoadFailingStore(t *testing.T) {
+	fs := &failingStore{fakeStore: *newFakeStore()}
+	a := NewAllocator(fs)
+
+	live := []Allocation{{Subnet: "34.25.0.0/24", Kind: Lab, Owner: "running"}}
+	if err := a.Load(live, []string{"77.0.0.0/8"}); err == nil {
+		t.Fatalf("expected error when store cannot be read")
+	}
+	if len(fs.allocations) != 0 {
+		t.Fatalf("expected live networks not to be stored again, got %v", fs.allocations)
+	}
+
+	if als := a.Allocations(); len(als) != 1 || als[0].Subnet != "34.25.0.0/24" {
+		t.Fatalf("expected live network to be allocated, got %v", als)
+	}
+	for i := 0; i < 10; i++ {
+		s, err := a.Allocate(Lab, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if s == "34.25.0.0/24" || strings.HasPrefix(s, "77.") {
+			t.Fatalf("expected live network and host route to be reserved, got %s", s)
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	tt := []struct {
+		address  string
+		expected string
+	}{
+		{address: "10.20.240.1/22", expected: "10.20.240.0/22"},
+		{address: "77.5.6.0/24", expected: "77.5.6.0/24"},
+		{address: "invalid", expected: "invalid"},
+	}
+
+	for _, tc := range tt {
+		if s := Of(tc.address); s != tc.expected {
+			t.Fatalf("expected %s for %s, got %s", tc.expected, tc.address, s)
+		}
+	}
+}
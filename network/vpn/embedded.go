@@ -0,0 +1,515 @@
+package wg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/vishvananda/netlink"
+	"golang.zx2c4.com/wireguard/wgctrl"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc"
+)
+
+const (
+	// a peer is connected when it did a handshake within the
+	// interval wireguard renews the keys of a session and the
+	// keepalive of the client
+	connectedHandshake = 3 * time.Minute
+)
+
+var (
+	UnknownNICErr  = errors.New("wireguard interface is not initialized")
+	UnknownKeyErr  = errors.New("key is not generated")
+	UnknownPeerErr = errors.New("peer is not added to any interface")
+	UnknownCmdErr  = errors.New("unknown command, use up or down")
+)
+
+// wgDevices configures wireguard devices, it is implemented by *wgctrl.Client
+type wgDevices interface {
+	Device(name string) (*wgtypes.Device, error)
+	ConfigureDevice(name string, cfg wgtypes.Config) error
+	Close() error
+}
+
+// nicLinks manages the network interfaces of the host
+type nicLinks interface {
+	Create(name, address string) error
+	SetUp(name string) error
+	Delete(name string) error
+}
+
+type nicState struct {
+	// PrivateKey of the interface, such that VPN configurations
+	// of teams stay valid after the host is restarted
+	PrivateKey string      `json:"privateKey"`
+	Address    string      `json:"address"`
+	ListenPort int         `json:"listenPort"`
+	Peers      []peerState `json:"peers"`
+}
+
+type peerState struct {
+	PublicKey  string `json:"publicKey"`
+	AllowedIPs string `json:"allowedIPs"`
+}
+
+// Embedded implements the WireguardClient within the daemon, the
+// interfaces and peers are managed through netlink instead of a
+// separate VPN service. Keys of peers are only kept in memory, while
+// the key, address, port and peers of every interface are saved in
+// the state directory, such that the peers of an interface are
+// restored when it is initialized again after a restart of the daemon.
+type Embedded struct {
+	m        sync.Mutex
+	stateDir string
+	devices  wgDevices
+	links    nicLinks
+	nics     map[string]*nicState
+	keys     map[string]wgtypes.Key
+}
+
+func NewEmbeddedClient(stateDir string) (*Embedded, error) {
+	// the state contains the private keys of the interfaces
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return nil, err
+	}
+	devices, err := wgctrl.New()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Embedded{
+		stateDir: stateDir,
+		devices:  devices,
+		links:    netlinkLinks{},
+		nics:     map[string]*nicState{},
+		keys:     map[string]wgtypes.Key{},
+	}, nil
+}
+
+// NewVPNClient returns the embedded client when it is enabled
+// in the configuration and a client of the VPN service otherwise
+func NewVPNClient(wgConn WireGuardConfig) (WireguardClient, error) {
+	if wgConn.Embedded {
+		return NewEmbeddedClient(wgConn.StateDir)
+	}
+
+	return NewGRPCVPNClient(wgConn)
+}
+
+func (e *Embedded) InitializeI(ctx context.Context, in *IReq, opts ...grpc.CallOption) (*IResp, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	saved, err := e.loadState(in.IName)
+	if err != nil {
+		saved = nil
+	}
+
+	// the key of an interface left by a previous run is reused
+	key, ok := e.keys[in.IName]
+	if !ok {
+		if dev, err := e.devices.Device(in.IName); err == nil {
+			key = dev.PrivateKey
+		} else if saved != nil && saved.PrivateKey != "" {
+			if key, err = wgtypes.ParseKey(saved.PrivateKey); err != nil {
+				return nil, err
+			}
+		} else if key, err = wgtypes.GeneratePrivateKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	state := &nicState{PrivateKey: key.String(), Address: in.Address, ListenPort: int(in.ListenPort)}
+	if saved != nil && saved.Address == in.Address {
+		state.Peers = knownPeers(saved.Peers, in.Peers)
+	}
+	peers, err := peerConfigs(state.Peers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.links.Create(in.IName, in.Address); err != nil {
+		return nil, err
+	}
+	port := state.ListenPort
+	if err := e.devices.ConfigureDevice(in.IName, wgtypes.Config{
+		PrivateKey:   &key,
+		ListenPort:   &port,
+		ReplacePeers: true,
+		Peers:        peers,
+	}); err != nil {
+		return nil, err
+	}
+	if err := e.links.SetUp(in.IName); err != nil {
+		return nil, err
+	}
+
+	e.keys[in.IName] = key
+	e.nics[in.IName] = state
+	e.saveState(in.IName, state)
+	log.Info().Str("nic", in.IName).
+		Int("peers", len(state.Peers)).
+		Msgf("Initialized wireguard interface on port %d", port)
+
+	return &IResp{Message: fmt.Sprintf("interface %s is initialized", in.IName)}, nil
+}
+
+func (e *Embedded) AddPeer(ctx context.Context, in *AddPReq, opts ...grpc.CallOption) (*AddPResp, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	state, ok := e.nics[in.Nic]
+	if !ok {
+		return nil, UnknownNICErr
+	}
+	p := peerState{PublicKey: in.PublicKey, AllowedIPs: in.AllowedIPs}
+	peers, err := peerConfigs([]peerState{p})
+	if err != nil {
+		return nil, err
+	}
+	if err := e.devices.ConfigureDevice(in.Nic, wgtypes.Config{Peers: peers}); err != nil {
+		return nil, err
+	}
+
+	state.Peers = append(state.Peers, p)
+	e.saveState(in.Nic, state)
+
+	return &AddPResp{Message: fmt.Sprintf("peer %s is added to %s", in.AllowedIPs, in.Nic)}, nil
+}
+
+func (e *Embedded) DelPeer(ctx context.Context, in *DelPReq, opts ...grpc.CallOption) (*DelPResp, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	key, err := wgtypes.ParseKey(in.PeerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	for nic, state := range e.nics {
+		for i, p := range state.Peers {
+			if p.PublicKey != in.PeerPublicKey {
+				continue
+			}
+			if err := e.devices.ConfigureDevice(nic, wgtypes.Config{
+				Peers: []wgtypes.PeerConfig{{PublicKey: key, Remove: true}},
+			}); err != nil {
+				return nil, err
+			}
+			state.Peers = append(state.Peers[:i], state.Peers[i+1:]...)
+			e.saveState(nic, state)
+			e.forgetKey(key)
+
+			return &DelPResp{Message: fmt.Sprintf("peer %s is removed from %s", in.IpAddress, nic)}, nil
+		}
+	}
+
+	return nil, UnknownPeerErr
+}
+
+func (e *Embedded) ListPeers(ctx context.Context, in *ListPeersReq, opts ...grpc.CallOption) (*ListPeersResp, error) {
+	dev, err := e.devices.Device(in.Nicname)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	for _, p := range dev.Peers {
+		fmt.Fprintf(&b, "peer: %s\n  allowed ips: %s\n  latest handshake: %s\n",
+			p.PublicKey, ipNetsString(p.AllowedIPs), handshakeString(p.LastHandshakeTime))
+	}
+
+	return &ListPeersResp{Response: b.String()}, nil
+}
+
+func (e *Embedded) ManageNIC(ctx context.Context, in *ManageNICReq, opts ...grpc.CallOption) (*ManageNICResp, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	switch in.Cmd {
+	case "up":
+		if _, ok := e.nics[in.Nic]; !ok {
+			return nil, UnknownNICErr
+		}
+		if err := e.links.SetUp(in.Nic); err != nil {
+			return nil, err
+		}
+	case "down":
+		// the interface is removed together with its peers
+		if err := e.links.Delete(in.Nic); err != nil {
+			return nil, err
+		}
+		if state, ok := e.nics[in.Nic]; ok {
+			for _, p := range state.Peers {
+				if key, err := wgtypes.ParseKey(p.PublicKey); err == nil {
+					e.forgetKey(key)
+				}
+			}
+		}
+		delete(e.nics, in.Nic)
+		delete(e.keys, in.Nic)
+		if err := os.Remove(e.statePath(in.Nic)); err != nil && !os.IsNotExist(err) {
+			log.Warn().Str("nic", in.Nic).Msgf("Unable to remove state of wireguard interface: %v", err)
+		}
+	default:
+		return nil, UnknownCmdErr
+	}
+
+	return &ManageNICResp{Message: fmt.Sprintf("interface %s is %s", in.Nic, in.Cmd)}, nil
+}
+
+func (e *Embedded) GetPeerStatus(ctx context.Context, in *PeerStatusReq, opts ...grpc.CallOption) (*PeerStatusResp, error) {
+	dev, err := e.devices.Device(in.NicName)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range dev.Peers {
+		if p.PublicKey.String() != in.PublicKey {
+			continue
+		}
+		resp := &PeerStatusResp{}
+		if !p.LastHandshakeTime.IsZero() {
+			resp.LastHandshake = p.LastHandshakeTime.Unix()
+			resp.Status = time.Since(p.LastHandshakeTime) < connectedHandshake
+		}
+
+		return resp, nil
+	}
+
+	return nil, UnknownPeerErr
+}
+
+func (e *Embedded) GetNICInfo(ctx context.Context, in *NICInfoReq, opts ...grpc.CallOption) (*NICInfoResp, error) {
+	dev, err := e.devices.Device(in.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	e.m.Lock()
+	var address string
+	if state, ok := e.nics[in.Interface]; ok {
+		address = state.Address
+	}
+	e.m.Unlock()
+
+	return &NICInfoResp{Message: fmt.Sprintf("interface: %s\n  public key: %s\n  address: %s\n  listening port: %d\n  peers: %d\n",
+		dev.Name, dev.PublicKey, address, dev.ListenPort, len(dev.Peers))}, nil
+}
+
+func (e *Embedded) GenPrivateKey(ctx context.Context, in *PrivKeyReq, opts ...grpc.CallOption) (*PrivKeyResp, error) {
+	key, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	e.m.Lock()
+	e.keys[in.PrivateKeyName] = key
+	e.m.Unlock()
+
+	return &PrivKeyResp{Message: fmt.Sprintf("private key %s is generated", in.PrivateKeyName)}, nil
+}
+
+func (e *Embedded) GetPrivateKey(ctx context.Context, in *PrivKeyReq, opts ...grpc.CallOption) (*PrivKeyResp, error) {
+	key, err := e.key(in.PrivateKeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivKeyResp{Message: key.String()}, nil
+}
+
+// GenPublicKey is kept for the VPN service, public
+// keys are derived from the private keys when needed
+func (e *Embedded) GenPublicKey(ctx context.Context, in *PubKeyReq, opts ...grpc.CallOption) (*PubKeyResp, error) {
+	key, err := e.key(in.PrivKeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKeyResp{Message: key.PublicKey().String()}, nil
+}
+
+func (e *Embedded) GetPublicKey(ctx context.Context, in *PubKeyReq, opts ...grpc.CallOption) (*PubKeyResp, error) {
+	name := in.PrivKeyName
+	if name == "" {
+		name = in.PubKeyName
+	}
+	key, err := e.key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKeyResp{Message: key.PublicKey().String()}, nil
+}
+
+func (e *Embedded) Close() error {
+	return e.devices.Close()
+}
+
+func (e *Embedded) key(name string) (wgtypes.Key, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	key, ok := e.keys[name]
+	if !ok {
+		return wgtypes.Key{}, UnknownKeyErr
+	}
+
+	return key, nil
+}
+
+// forgetKey removes the private key of a removed peer
+func (e *Embedded) forgetKey(pub wgtypes.Key) {
+	for name, key := range e.keys {
+		if key.PublicKey() == pub {
+			delete(e.keys, name)
+		}
+	}
+}
+
+func (e *Embedded) statePath(nic string) string {
+	return filepath.Join(e.stateDir, nic+".json")
+}
+
+func (e *Embedded) loadState(nic string) (*nicState, error) {
+	raw, err := ioutil.ReadFile(e.statePath(nic))
+	if err != nil {
+		return nil, err
+	}
+	var state nicState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// saveState saves the state of an interface, a failure is only
+// logged as the interface itself is configured already
+func (e *Embedded) saveState(nic string, state *nicState) {
+	raw, err := json.Marshal(state)
+	if err == nil {
+		err = ioutil.WriteFile(e.statePath(nic), raw, 0600)
+	}
+	if err != nil {
+		log.Warn().Str("nic", nic).Msgf("Unable to save state of wireguard interface: %v", err)
+	}
+}
+
+// knownPeers returns the saved peers which belong to the given public
+// keys, the peers of profiles which are not known anymore are dropped
+func knownPeers(saved []peerState, known []string) []peerState {
+	keys := map[string]bool{}
+	for _, k := range known {
+		keys[k] = true
+	}
+
+	var peers []peerState
+	for _, p := range saved {
+		if !keys[p.PublicKey] {
+			log.Warn().Str("peer", p.PublicKey).Msg("Removing unknown peer of wireguard interface")
+			continue
+		}
+		peers = append(peers, p)
+	}
+
+	return peers
+}
+
+func peerConfigs(peers []peerState) ([]wgtypes.PeerConfig, error) {
+	var configs []wgtypes.PeerConfig
+	for _, p := range peers {
+		key, err := wgtypes.ParseKey(p.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		var allowed []net.IPNet
+		for _, a := range strings.Split(p.AllowedIPs, ",") {
+			_, ipNet, err := net.ParseCIDR(strings.TrimSpace(a))
+			if err != nil {
+				return nil, err
+			}
+			allowed = append(allowed, *ipNet)
+		}
+		configs = append(configs, wgtypes.PeerConfig{
+			PublicKey:         key,
+			ReplaceAllowedIPs: true,
+			AllowedIPs:        allowed,
+		})
+	}
+
+	return configs, nil
+}
+
+func ipNetsString(nets []net.IPNet) string {
+	var s []string
+	for _, n := range nets {
+		s = append(s, n.String())
+	}
+	sort.Strings(s)
+
+	return strings.Join(s, ", ")
+}
+
+func handshakeString(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+type netlinkLinks struct{}
+
+func (netlinkLinks) Create(name, address string) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		attrs := netlink.NewLinkAttrs()
+		attrs.Name = name
+		link = &netlink.GenericLink{LinkAttrs: attrs, LinkType: "wireguard"}
+		if err := netlink.LinkAdd(link); err != nil {
+			return fmt.Errorf("unable to create interface %s: %v", name, err)
+		}
+	}
+	// dual-stack interfaces have an address per family
+	for _, a := range strings.Split(address, ",") {
+		addr, err := netlink.ParseAddr(strings.TrimSpace(a))
+		if err != nil {
+			return err
+		}
+		if err := netlink.AddrReplace(link, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (netlinkLinks) SetUp(name string) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return err
+	}
+
+	return netlink.LinkSetUp(link)
+}
+
+func (netlinkLinks) Delete(name string) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		// the interface is already removed
+		return nil
+	}
+
+	return netlink.LinkDel(link)
+}
@@ -0,0 +1,196 @@
+package wg
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+type testDevices struct {
+	devices map[string]*wgtypes.Device
+}
+
+func (td *testDevices) Device(name string) (*wgtypes.Device, error) {
+	dev, ok := td.devices[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return dev, nil
+}
+
+func (td *testDevices) ConfigureDevice(name string, cfg wgtypes.Config) error {
+	dev, ok := td.devices[name]
+	if !ok {
+		dev = &wgtypes.Device{Name: name}
+		td.devices[name] = dev
+	}
+	if cfg.PrivateKey != nil {
+		dev.PrivateKey, dev.PublicKey = *cfg.PrivateKey, cfg.PrivateKey.PublicKey()
+	}
+	if cfg.ListenPort != nil {
+		dev.ListenPort = *cfg.ListenPort
+	}
+	if cfg.ReplacePeers {
+		dev.Peers = nil
+	}
+	for _, pc := range cfg.Peers {
+		peers := dev.Peers[:0]
+		for _, p := range dev.Peers {
+			if p.PublicKey != pc.PublicKey {
+				peers = append(peers, p)
+			}
+		}
+		if !pc.Remove {
+			peers = append(peers, wgtypes.Peer{PublicKey: pc.PublicKey, AllowedIPs: pc.AllowedIPs})
+		}
+		dev.Peers = peers
+	}
+	return nil
+}
+
+func (td *testDevices) Close() error { return nil }
+
+type testLinks struct {
+	up map[string]bool
+}
+
+func (tl *testLinks) Create(name, address string) error {
+	if _, ok := tl.up[name]; !ok {
+		tl.up[name] = false
+	}
+	return nil
+}
+
+func (tl *testLinks) SetUp(name string) error {
+	tl.up[name] = true
+	return nil
+}
+
+func (tl *testLinks) Delete(name string) error {
+	delete(tl.up, name)
+	return nil
+}
+
+func newTestEmbedded(dir string, devices *testDevices) (*Embedded, *testLinks) {
+	links := &testLinks{up: map[string]bool{}}
+	return &Embedded{
+		stateDir: dir,
+		devices:  devices,
+		links:    links,
+		nics:     map[string]*nicState{},
+		keys:     map[string]wgtypes.Key{},
+	}, links
+}
+
+func TestEmbedded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wg")
+	if err != nil {
+		t.Fatalf("unable to create dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	devices := &testDevices{devices: map[string]*wgtypes.Device{}}
+	e, links := newTestEmbedded(dir, devices)
+
+	iReq := &IReq{Address: "10.2.240.1/22", ListenPort: 5000, SaveConfig: true, IName: "test"}
+	if _, err := e.InitializeI(ctx, iReq); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !links.up["test"] {
+		t.Fatalf("expected interface to be up")
+	}
+	serverKey, err := e.GetPublicKey(ctx, &PubKeyReq{PubKeyName: "test", PrivKeyName: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if serverKey.Message != devices.devices["test"].PublicKey.String() {
+		t.Fatalf("expected public key of interface, got %s", serverKey.Message)
+	}
+
+	if _, err := e.GenPrivateKey(ctx, &PrivKeyReq{PrivateKeyName: "peer"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pubKey, err := e.GetPublicKey(ctx, &PubKeyReq{PubKeyName: "peer"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := e.AddPeer(ctx, &AddPReq{Nic: "test", AllowedIPs: "10.2.240.2/32", PublicKey: pubKey.Message}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(devices.devices["test"].Peers); n != 1 {
+		t.Fatalf("expected one peer, got %d", n)
+	}
+
+	devices.devices["test"].Peers[0].LastHandshakeTime = time.Now()
+	status, err := e.GetPeerStatus(ctx, &PeerStatusReq{NicName: "test", PublicKey: pubKey.Message})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !status.Status || status.LastHandshake == 0 {
+		t.Fatalf("expected peer to be connected")
+	}
+
+	// a restarted daemon restores the known peers of the interface
+	// and reuses the key of the interface left in the kernel
+	if _, err := e.GenPrivateKey(ctx, &PrivKeyReq{PrivateKeyName: "unknown"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unknownKey, _ := e.GetPublicKey(ctx, &PubKeyReq{PubKeyName: "unknown"})
+	if _, err := e.AddPeer(ctx, &AddPReq{Nic: "test", AllowedIPs: "10.2.240.3/32", PublicKey: unknownKey.Message}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restarted, _ := newTestEmbedded(dir, devices)
+	devices.devices["test"].Peers = nil
+	iReq.Peers = []string{pubKey.Message}
+	if _, err := restarted.InitializeI(ctx, iReq); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := devices.devices["test"].Peers; len(p) != 1 || p[0].PublicKey.String() != pubKey.Message {
+		t.Fatalf("expected only the known peer to be restored, got %v", p)
+	}
+	if s, _ := restarted.loadState("test"); len(s.Peers) != 1 {
+		t.Fatalf("expected unknown peer to be removed from the state, got %v", s.Peers)
+	}
+	if k, _ := restarted.GetPublicKey(ctx, &PubKeyReq{PrivKeyName: "test"}); k.Message != serverKey.Message {
+		t.Fatalf("expected key of interface to be reused")
+	}
+	// the key of the interface is restored from the
+	// state when the interface is gone after a reboot
+	delete(devices.devices, "test")
+	rebooted, _ := newTestEmbedded(dir, devices)
+	if _, err := rebooted.InitializeI(ctx, iReq); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if devices.devices["test"].PublicKey.String() != serverKey.Message {
+		t.Fatalf("expected key of interface to be restored")
+	}
+	if fi, err := os.Stat(rebooted.statePath("test")); err != nil || fi.Mode().Perm() != 0600 {
+		t.Fatalf("expected state to only be readable by the owner")
+	}
+	// private keys of peers are not saved
+	if _, err := restarted.GetPrivateKey(ctx, &PrivKeyReq{PrivateKeyName: "peer"}); err != UnknownKeyErr {
+		t.Fatalf("expected unknown key error, got %v", err)
+	}
+
+	if _, err := restarted.DelPeer(ctx, &DelPReq{PeerPublicKey: pubKey.Message, IpAddress: "10.2.240.2/32"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := len(devices.devices["test"].Peers); n != 0 {
+		t.Fatalf("expected peer to be removed, got %d peers", n)
+	}
+	if _, err := restarted.DelPeer(ctx, &DelPReq{PeerPublicKey: pubKey.Message}); err != UnknownPeerErr {
+		t.Fatalf("expected unknown peer error, got %v", err)
+	}
+
+	if _, err := restarted.ManageNIC(ctx, &ManageNICReq{Nic: "test", Cmd: "down"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(restarted.statePath("test")); !os.IsNotExist(err) {
+		t.Fatalf("expected state to be removed")
+	}
+}
@@ -73,7 +73,10 @@ func (m *PeerStatusReq) GetPublicKey() string {
 
 type PeerStatusResp struct {
 	// connected or not connected
-	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status bool `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// unix time of the latest handshake, zero when the peer never connected,
+	// it is only given by the embedded client and always zero for the VPN service
+	LastHandshake        int64    `protobuf:"varint,2,opt,name=lastHandshake,proto3" json:"lastHandshake,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -111,6 +114,13 @@ func (m *PeerStatusResp) GetStatus() bool {
 	return false
 }
 
+func (m *PeerStatusResp) GetLastHandshake() int64 {
+	if m != nil {
+		return m.LastHandshake
+	}
+	return 0
+}
+
 type AddPReq struct {
 	Nic                  string   `protobuf:"bytes,1,opt,name=nic,proto3" json:"nic,omitempty"`
 	AllowedIPs           string   `protobuf:"bytes,2,opt,name=allowedIPs,proto3" json:"allowedIPs,omitempty"`
@@ -292,12 +302,14 @@ func (m *ListPeersResp) GetResponse() string {
 }
 
 type IReq struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ListenPort           uint32   `protobuf:"varint,2,opt,name=listenPort,proto3" json:"listenPort,omitempty"`
-	SaveConfig           bool     `protobuf:"varint,3,opt,name=saveConfig,proto3" json:"saveConfig,omitempty"`
-	PrivateKey           string   `protobuf:"bytes,4,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
-	Eth                  string   `protobuf:"bytes,5,opt,name=eth,proto3" json:"eth,omitempty"`
-	IName                string   `protobuf:"bytes,6,opt,name=iName,proto3" json:"iName,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ListenPort uint32 `protobuf:"varint,2,opt,name=listenPort,proto3" json:"listenPort,omitempty"`
+	SaveConfig bool   `protobuf:"varint,3,opt,name=saveConfig,proto3" json:"saveConfig,omitempty"`
+	PrivateKey string `protobuf:"bytes,4,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	Eth        string `protobuf:"bytes,5,opt,name=eth,proto3" json:"eth,omitempty"`
+	IName      string `protobuf:"bytes,6,opt,name=iName,proto3" json:"iName,omitempty"`
+	// public keys of the peers which are restored on the interface
+	Peers                []string `protobuf:"bytes,7,rep,name=peers,proto3" json:"peers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -370,6 +382,13 @@ func (m *IReq) GetIName() string {
 	return ""
 }
 
+func (m *IReq) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
 type IResp struct {
 	// message could be error or ordinary result depend on function result.
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -849,47 +868,49 @@ func init() {
 func init() { proto.RegisterFile("wg.proto", fileDescriptor_44e0f2c0b3aafb52) }
 
 var fileDescriptor_44e0f2c0b3aafb52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x6b, 0xdb, 0x30,
-	0x10, 0x4f, 0x97, 0x26, 0x8d, 0x2f, 0x71, 0x56, 0xc4, 0x18, 0xc1, 0x8c, 0xd2, 0x89, 0xd2, 0xb5,
-	0xdb, 0xd0, 0x43, 0xb7, 0xe7, 0x41, 0xe9, 0x20, 0x98, 0xae, 0xc5, 0x64, 0x0f, 0x63, 0x8f, 0x6e,
-	0x7c, 0x4d, 0x05, 0xae, 0xed, 0x49, 0x4a, 0x4b, 0xf7, 0x89, 0xf6, 0xa1, 0xf6, 0x61, 0x86, 0xfe,
-	0xd8, 0x96, 0xcb, 0x48, 0xfb, 0xe6, 0x3b, 0x9d, 0xee, 0xee, 0x77, 0xbf, 0xdf, 0xc9, 0x30, 0xba,
-	0x5f, 0xb1, 0x4a, 0x94, 0xaa, 0xa4, 0x73, 0x08, 0x13, 0x44, 0xf1, 0x5d, 0xa5, 0x6a, 0x2d, 0x17,
-	0xf8, 0x8b, 0xcc, 0x60, 0xa7, 0xe0, 0xcb, 0xcb, 0xf4, 0x16, 0x67, 0x5b, 0xfb, 0x5b, 0x47, 0xc1,
-	0xa2, 0x36, 0xc9, 0x1b, 0x08, 0xaa, 0xf5, 0x55, 0xce, 0x97, 0xe7, 0xf8, 0x30, 0x7b, 0x61, 0xce,
-	0x5a, 0x07, 0x3d, 0x82, 0xa9, 0x9f, 0x48, 0x56, 0xe4, 0x35, 0x0c, 0xa5, 0xb1, 0x4c, 0xa2, 0xd1,
-	0xc2, 0x59, 0xf4, 0x27, 0xec, 0x9c, 0x66, 0x59, 0xa2, 0x8b, 0xed, 0x42, 0xbf, 0xe0, 0x4b, 0x57,
-	0x48, 0x7f, 0x92, 0x3d, 0x80, 0x34, 0xcf, 0xcb, 0x7b, 0xcc, 0xe2, 0x44, 0xba, 0x2a, 0x9e, 0xa7,
-	0xdb, 0x44, 0xff, 0x71, 0x13, 0x07, 0x30, 0xb2, 0xa9, 0x65, 0xa5, 0x81, 0xdc, 0xa2, 0x94, 0xe9,
-	0xaa, 0x01, 0xe2, 0x4c, 0xfa, 0x05, 0x26, 0xdf, 0xb8, 0x54, 0xba, 0x5d, 0x0f, 0x72, 0xd1, 0x85,
-	0xac, 0x4d, 0xf2, 0x0a, 0x06, 0x28, 0x44, 0x29, 0x5c, 0x23, 0xd6, 0xa0, 0x1f, 0x20, 0xf4, 0xee,
-	0xcb, 0x8a, 0x44, 0x30, 0x12, 0x28, 0xab, 0xb2, 0x90, 0x75, 0x86, 0xc6, 0xa6, 0x7f, 0xb6, 0x60,
-	0x3b, 0x76, 0x55, 0xd2, 0x2c, 0x13, 0x28, 0x65, 0x5d, 0xc5, 0x99, 0x1a, 0x73, 0xce, 0xa5, 0xc2,
-	0x22, 0x29, 0x85, 0x32, 0xa5, 0xc2, 0x85, 0xe7, 0xd1, 0xe7, 0x32, 0xbd, 0xc3, 0xb3, 0xb2, 0xb8,
-	0xe6, 0x2b, 0x03, 0x7a, 0xb4, 0xf0, 0x3c, 0xfa, 0xbc, 0x12, 0xfc, 0x2e, 0x55, 0xa8, 0x87, 0xb2,
-	0x6d, 0x67, 0xd6, 0x7a, 0xf4, 0x94, 0x51, 0xdd, 0xcc, 0x06, 0x76, 0xca, 0xa8, 0x6e, 0x34, 0x2e,
-	0x6e, 0x28, 0x1e, 0x5a, 0x5c, 0xc6, 0xa0, 0x6f, 0x61, 0x10, 0x3f, 0x31, 0xba, 0x0b, 0xd8, 0xf9,
-	0x8a, 0xb9, 0xe1, 0xee, 0x00, 0xc2, 0x0a, 0x51, 0x24, 0x0d, 0x1b, 0x36, 0xb4, 0xeb, 0xd4, 0x7c,
-	0xf1, 0xea, 0xd4, 0xe1, 0x76, 0xa2, 0x69, 0x1c, 0x9a, 0x2f, 0x9b, 0x6e, 0x63, 0xd1, 0x13, 0x98,
-	0x5c, 0xa4, 0x45, 0xba, 0xc2, 0xcb, 0xf8, 0xec, 0xff, 0xaa, 0xd9, 0x85, 0xfe, 0xf2, 0x36, 0x73,
-	0xf9, 0xf5, 0x27, 0x3d, 0x86, 0xd0, 0xbb, 0xb3, 0x31, 0xfd, 0x7b, 0x80, 0xcb, 0xf8, 0x2c, 0x2e,
-	0xae, 0x4b, 0x9d, 0x5c, 0x37, 0x5c, 0x28, 0x14, 0xd7, 0xe9, 0xb2, 0x8e, 0x6c, 0x1d, 0xf4, 0x1d,
-	0x8c, 0x9b, 0xd8, 0x27, 0x06, 0x15, 0x24, 0xeb, 0xab, 0x73, 0x7c, 0xd0, 0x39, 0x35, 0x41, 0xc6,
-	0xf0, 0xd6, 0xca, 0xf3, 0x90, 0x7d, 0x18, 0x6b, 0xba, 0xea, 0x00, 0x0b, 0xc3, 0x77, 0xd1, 0x43,
-	0x80, 0x3a, 0xdd, 0xc6, 0xb2, 0x9f, 0x01, 0x12, 0x7b, 0x4d, 0xd7, 0x3d, 0x84, 0x69, 0x2b, 0x03,
-	0xaf, 0xf6, 0x23, 0xaf, 0x46, 0xd5, 0xdc, 0xda, 0x94, 0xfe, 0xe4, 0x6f, 0x1f, 0x82, 0x1f, 0x5c,
-	0xe0, 0x6a, 0x9d, 0x8a, 0x8c, 0xec, 0xc1, 0x38, 0x2e, 0xb8, 0xe2, 0x69, 0xce, 0x7f, 0x63, 0x4c,
-	0x06, 0x4c, 0xeb, 0x3c, 0x1a, 0x32, 0x23, 0x22, 0xda, 0x23, 0xfb, 0x76, 0xd1, 0x11, 0x05, 0x19,
-	0x31, 0xb7, 0xf2, 0x51, 0xc0, 0xea, 0x0d, 0xb5, 0x11, 0x9a, 0x7f, 0x1b, 0xe1, 0x84, 0x15, 0x05,
-	0xac, 0xd6, 0x04, 0xed, 0x91, 0x8f, 0x10, 0x34, 0xbb, 0x46, 0x42, 0xe6, 0xef, 0x6d, 0x34, 0x65,
-	0x9d, 0x35, 0xb4, 0xd1, 0x0d, 0xeb, 0x24, 0x64, 0xbe, 0x6a, 0xa2, 0x29, 0xeb, 0x08, 0x82, 0xf6,
-	0xc8, 0x09, 0x84, 0x73, 0x54, 0xed, 0xab, 0x45, 0xa6, 0xac, 0xf3, 0x16, 0x46, 0x2f, 0x59, 0xf7,
-	0x49, 0xa3, 0x3d, 0x72, 0x0c, 0x30, 0x47, 0xe5, 0x34, 0x40, 0xc6, 0xac, 0x55, 0x4e, 0x34, 0x61,
-	0x9e, 0x34, 0x4c, 0xe8, 0x64, 0x8e, 0x45, 0xbb, 0x0a, 0xc0, 0x1a, 0x45, 0x44, 0x63, 0xd6, 0xd2,
-	0x69, 0xfa, 0x0e, 0x75, 0x68, 0xbb, 0xb2, 0x63, 0xd6, 0xd2, 0x18, 0x4d, 0x98, 0xc7, 0x4e, 0x1d,
-	0xad, 0x9e, 0x1b, 0x6d, 0xda, 0x50, 0xcf, 0x69, 0xe3, 0x6a, 0x68, 0xfe, 0x09, 0x9f, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0xf5, 0xe1, 0xfd, 0x66, 0x1f, 0x06, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdb, 0x6e, 0xd3, 0x4c,
+	0x10, 0x4e, 0xff, 0x34, 0x07, 0x4f, 0xe2, 0xfc, 0xd5, 0x0a, 0xa1, 0xc8, 0x42, 0x55, 0x58, 0x55,
+	0x85, 0x02, 0xda, 0x8b, 0xc2, 0x35, 0x52, 0x55, 0xa4, 0x60, 0x95, 0x46, 0x56, 0xb8, 0x40, 0x5c,
+	0x6e, 0xe3, 0x69, 0x6a, 0xe1, 0xd8, 0xc6, 0xbb, 0x69, 0x55, 0x1e, 0x8e, 0x27, 0xe1, 0x61, 0xd0,
+	0xac, 0x4f, 0xeb, 0x0a, 0xa5, 0xbd, 0xf3, 0x7c, 0x3b, 0xfb, 0xcd, 0xe9, 0x9b, 0x35, 0x0c, 0xef,
+	0xd6, 0x22, 0xcb, 0x53, 0x9d, 0xf2, 0x39, 0xb8, 0x01, 0x62, 0xfe, 0x55, 0x4b, 0xbd, 0x55, 0x4b,
+	0xfc, 0xc9, 0xa6, 0x30, 0x48, 0xa2, 0xd5, 0x42, 0x6e, 0x70, 0xba, 0x37, 0xdb, 0x7b, 0xed, 0x2c,
+	0x2b, 0x93, 0xbd, 0x00, 0x27, 0xdb, 0x5e, 0xc5, 0xd1, 0xea, 0x02, 0xef, 0xa7, 0xff, 0x99, 0xb3,
+	0x06, 0xe0, 0x0b, 0x98, 0xd8, 0x44, 0x2a, 0x63, 0xcf, 0xa1, 0xaf, 0x8c, 0x65, 0x88, 0x86, 0xcb,
+	0xd2, 0x62, 0x47, 0xe0, 0xc6, 0x52, 0xe9, 0xcf, 0x32, 0x09, 0xd5, 0x8d, 0xfc, 0x81, 0x86, 0xab,
+	0xbb, 0x6c, 0x83, 0xfc, 0x3b, 0x0c, 0xce, 0xc2, 0x30, 0xa0, 0x94, 0x0e, 0xa0, 0x9b, 0x44, 0xab,
+	0x32, 0x1d, 0xfa, 0x64, 0x87, 0x00, 0x32, 0x8e, 0xd3, 0x3b, 0x0c, 0xfd, 0x40, 0x95, 0xb9, 0x58,
+	0x48, 0x3b, 0xd5, 0xee, 0xc3, 0x54, 0x8f, 0x60, 0x58, 0x50, 0xab, 0x8c, 0xca, 0xdd, 0xa0, 0x52,
+	0x72, 0x5d, 0x97, 0x5b, 0x9a, 0xfc, 0x23, 0x8c, 0xbf, 0x44, 0x4a, 0x53, 0x51, 0x56, 0x63, 0x92,
+	0x76, 0x63, 0xc8, 0x64, 0xcf, 0xa0, 0x87, 0x79, 0x9e, 0xe6, 0x65, 0x22, 0x85, 0xc1, 0xdf, 0x82,
+	0x6b, 0xdd, 0x57, 0x19, 0xf3, 0x60, 0x98, 0xa3, 0xca, 0xd2, 0x44, 0x55, 0x0c, 0xb5, 0xcd, 0x7f,
+	0xef, 0xc1, 0xbe, 0x5f, 0x46, 0x91, 0x61, 0x98, 0xa3, 0x52, 0x55, 0x94, 0xd2, 0xa4, 0x9a, 0xe3,
+	0x48, 0x69, 0x4c, 0x82, 0x34, 0xd7, 0x26, 0x94, 0xbb, 0xb4, 0x10, 0x3a, 0x57, 0xf2, 0x16, 0xcf,
+	0xd3, 0xe4, 0x3a, 0x5a, 0x9b, 0xa2, 0x87, 0x4b, 0x0b, 0xa1, 0xf3, 0x2c, 0x8f, 0x6e, 0xa5, 0x46,
+	0x6a, 0xca, 0x7e, 0xd1, 0xb3, 0x06, 0xa1, 0x2e, 0xa3, 0xbe, 0x99, 0xf6, 0x8a, 0x2e, 0xa3, 0xbe,
+	0xa1, 0xba, 0x22, 0x23, 0x84, 0x7e, 0x51, 0x97, 0x31, 0x08, 0xcd, 0xa8, 0xa6, 0xe9, 0x60, 0xd6,
+	0x25, 0xd4, 0x18, 0xfc, 0x25, 0xf4, 0xfc, 0x47, 0x1a, 0x7a, 0x09, 0x83, 0x4f, 0x18, 0x9b, 0x89,
+	0x1e, 0x81, 0x4b, 0xd7, 0x82, 0x7a, 0x46, 0x85, 0x6b, 0x1b, 0xa4, 0x29, 0x46, 0xd9, 0x59, 0xd9,
+	0x8d, 0x52, 0x70, 0x35, 0x40, 0x53, 0x2c, 0xe8, 0x76, 0x06, 0x3d, 0x85, 0xf1, 0xa5, 0x4c, 0xe4,
+	0x1a, 0x17, 0xfe, 0xf9, 0xbf, 0xb5, 0x74, 0x00, 0xdd, 0xd5, 0x26, 0x2c, 0xf9, 0xe9, 0x93, 0x9f,
+	0x80, 0x6b, 0xdd, 0xd9, 0x49, 0xff, 0x06, 0x60, 0xe1, 0x9f, 0xfb, 0xc9, 0x75, 0x4a, 0xe4, 0x94,
+	0x70, 0xa2, 0x31, 0xbf, 0x96, 0xab, 0xca, 0xb3, 0x01, 0xf8, 0x2b, 0x18, 0xd5, 0xbe, 0x8f, 0x34,
+	0xca, 0x09, 0xb6, 0x57, 0x17, 0x78, 0x4f, 0x9c, 0x34, 0x36, 0x63, 0x58, 0x2b, 0x69, 0x21, 0x6c,
+	0x06, 0x23, 0x1a, 0x62, 0xe5, 0x50, 0x94, 0x61, 0x43, 0xfc, 0x18, 0xa0, 0xa2, 0xdb, 0x19, 0xf6,
+	0x03, 0x40, 0x50, 0x5c, 0xa3, 0xb8, 0xc7, 0x30, 0x69, 0xc4, 0x61, 0xc5, 0x7e, 0x80, 0x52, 0x55,
+	0xf5, 0xad, 0x5d, 0xf4, 0xa7, 0x7f, 0xba, 0xe0, 0x7c, 0x8b, 0x72, 0x5c, 0x6f, 0x65, 0x1e, 0xb2,
+	0x43, 0x18, 0xf9, 0x49, 0xa4, 0x23, 0x19, 0x47, 0xbf, 0xd0, 0x67, 0x3d, 0x41, 0xea, 0xf7, 0xfa,
+	0xc2, 0x88, 0x88, 0x77, 0xd8, 0xac, 0x58, 0x7f, 0xc4, 0x9c, 0x0d, 0x45, 0xf9, 0x10, 0x78, 0x8e,
+	0xa8, 0xf6, 0xb6, 0xf0, 0xa0, 0xf9, 0x17, 0x1e, 0xa5, 0xb0, 0x3c, 0x47, 0x54, 0x9a, 0xe0, 0x1d,
+	0xf6, 0x0e, 0x9c, 0x7a, 0x03, 0x99, 0x2b, 0xec, 0x6d, 0xf6, 0x26, 0xa2, 0xb5, 0x9c, 0x85, 0x77,
+	0x3d, 0x75, 0xe6, 0x0a, 0x5b, 0x35, 0xde, 0x44, 0xb4, 0x04, 0xc1, 0x3b, 0xec, 0x14, 0xdc, 0x39,
+	0xea, 0xe6, 0xc5, 0x63, 0x13, 0xd1, 0x7a, 0x47, 0xbd, 0xff, 0x45, 0xfb, 0x39, 0xe4, 0x1d, 0x76,
+	0x02, 0x30, 0x47, 0x5d, 0x6a, 0x80, 0x8d, 0x44, 0xa3, 0x1c, 0x6f, 0x2c, 0x2c, 0x69, 0x18, 0xd7,
+	0xf1, 0x1c, 0x93, 0x66, 0x15, 0x40, 0xd4, 0x8a, 0xf0, 0x46, 0xa2, 0x19, 0xa7, 0xc9, 0xdb, 0x25,
+	0xd7, 0x66, 0x91, 0x47, 0xa2, 0x19, 0xa3, 0x37, 0x16, 0xd6, 0x74, 0x2a, 0x6f, 0xfd, 0x54, 0x6f,
+	0x93, 0x86, 0x7e, 0x4a, 0x1a, 0x57, 0x7d, 0xf3, 0x3f, 0x79, 0xff, 0x77, 0x00, 0x1d, 0x91, 0xd6,
+	0xc4, 0x5b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
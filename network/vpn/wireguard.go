@@ -35,6 +35,10 @@ type WireGuardConfig struct {
 	CertKey  string
 	CAFile   string
 	Dir      string // client configuration file will reside
+	// Embedded manages wireguard within the daemon instead
+	// of using the VPN service, its state is kept in StateDir
+	Embedded bool
+	StateDir string
 }
 
 type Creds struct {
@@ -52,6 +56,8 @@ func (c Creds) RequireTransportSecurity() bool {
 	return !c.Insecure
 }
 
+// NewGRPCVPNClient returns a client of the separate VPN service,
+// which does not report the last handshake of the status of peers
 func NewGRPCVPNClient(wgConn WireGuardConfig) (WireguardClient, error) {
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
@@ -0,0 +1,32 @@
+#!/usr/bin/env bash
+# Vendors the javascript libraries of the pages of amigo and of the
+# replay page of recordings, such that the pages do not depend on a
+# CDN. The packages are fetched from npm, which verifies them against
+# the integrity of the registry.
+set -e
+
+cd "$(dirname "$0")/.."
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+# vendor <package@version> <destination> <files...>
+vendor() {
+	local pkg=$1 dest=$2
+	shift 2
+	(cd "$tmp" && npm pack --silent "$pkg" > /dev/null)
+	mkdir -p "$tmp/${pkg%@*}" "$dest"
+	tar -xzf "$tmp"/*.tgz -C "$tmp/${pkg%@*}" --strip-components=1
+	rm "$tmp"/*.tgz
+	for f in "$@"; do
+		cp "$tmp/${pkg%@*}/$f" "$dest/"
+	done
+}
+
+vendor xterm@4.19.0 svcs/amigo/resources/public/vendor/xterm css/xterm.css lib/xterm.js LICENSE
+vendor xterm-addon-fit@0.5.0 svcs/amigo/resources/public/vendor/xterm-addon-fit lib/xterm-addon-fit.js LICENSE
+
+# the replay page of recordings imports the module of the package,
+# it is served from the assets of amigo on the domain of the event
+vendor guacamole-common-js@1.5.0 svcs/amigo/resources/public/vendor/guacamole-common-js
+module=$(node -p "require('$tmp/guacamole-common-js/package.json').module")
+cp "$tmp/guacamole-common-js/$module" svcs/amigo/resources/public/vendor/guacamole-common-js/guacamole-common.js
@@ -0,0 +1,45 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/json"
+)
+
+// Checkpoint holds the images committed from the containers of
+// the exercises of a lab, by exercise tag in the order of the
+// containers of the exercise
+type Checkpoint map[string][]string
+
+// Images returns all images of the checkpoint
+func (c Checkpoint) Images() []string {
+	var images []string
+	for _, imgs := range c {
+		images = append(images, imgs...)
+	}
+	return images
+}
+
+// String returns the checkpoint in the format it is saved to the database
+func (c Checkpoint) String() string {
+	if len(c) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(c)
+	return string(b)
+}
+
+// ParseCheckpoint parses a checkpoint from the database,
+// an empty string is parsed into no checkpoint
+func ParseCheckpoint(s string) (Checkpoint, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var c Checkpoint
+	if err := json.Unmarshal([]byte(s), &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/json"
+)
+
+// DNSRecordsString returns the records in the format they are saved to the database
+func DNSRecordsString(records []RecordConfig) string {
+	if len(records) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(records)
+	return string(b)
+}
+
+// ParseDNSRecords parses the records of an event from the
+// database, an empty string is parsed into no records
+func ParseDNSRecords(s string) ([]RecordConfig, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var records []RecordConfig
+	if err := json.Unmarshal([]byte(s), &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
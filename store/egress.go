@@ -0,0 +1,71 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+)
+
+var (
+	domainRe = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`)
+)
+
+// EgressPolicy restricts the traffic leaving the labs of an event,
+// labs of VPN events only reach the VPN peers of their team and the
+// allowed destinations
+type EgressPolicy struct {
+	BlockInternet bool `json:"blockInternet,omitempty" yaml:"block-internet,omitempty"`
+	// Allow are domains, addresses or subnets which
+	// are reachable when the internet is blocked
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	// Isolate drops traffic to the labs of other teams
+	Isolate bool `json:"isolate,omitempty" yaml:"isolate,omitempty"`
+}
+
+// IsSet returns true when the policy restricts any traffic
+func (p EgressPolicy) IsSet() bool {
+	return p.BlockInternet || p.Isolate
+}
+
+func (p EgressPolicy) Validate() error {
+	return validateAllowed(p.Allow)
+}
+
+// String returns the policy in the format it is saved to the database
+func (p EgressPolicy) String() string {
+	if !p.IsSet() && len(p.Allow) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// ParseEgressPolicy parses a policy from the database, an
+// empty string is parsed into a policy without restrictions
+func ParseEgressPolicy(s string) (EgressPolicy, error) {
+	var p EgressPolicy
+	if s == "" {
+		return p, nil
+	}
+	err := json.Unmarshal([]byte(s), &p)
+	return p, err
+}
+
+func validateAllowed(allow []string) error {
+	for _, a := range allow {
+		if _, _, err := net.ParseCIDR(a); err == nil {
+			continue
+		}
+		if net.ParseIP(a) != nil || domainRe.MatchString(a) {
+			continue
+		}
+		return fmt.Errorf("allowed destination %q is not a domain, address or subnet", a)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEgressPolicy(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    string
+		expected EgressPolicy
+		err      bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+		},
+		{
+			name: "Normal",
+			input: EgressPolicy{
+				BlockInternet: true,
+				Allow:         []string{"github.com", "10.0.0.0/8"},
+			}.String(),
+			expected: EgressPolicy{
+				BlockInternet: true,
+				Allow:         []string{"github.com", "10.0.0.0/8"},
+			},
+		},
+		{
+			name:  "Invalid",
+			input: "block",
+			err:   true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParseEgressPolicy(tc.input)
+			if (err != nil) != tc.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.err && !reflect.DeepEqual(p, tc.expected) {
+				t.Fatalf("expected policy %+v, got %+v", tc.expected, p)
+			}
+		})
+	}
+}
+
+func TestEgressPolicyValidate(t *testing.T) {
+	tt := []struct {
+		name  string
+		allow []string
+		err   bool
+	}{
+		{name: "Normal", allow: []string{"github.com", "1.1.1.1", "10.0.0.0/8"}},
+		{name: "Invalid domain", allow: []string{"github"}, err: true},
+		{name: "Invalid subnet", allow: []string{"10.0.0.0/33"}, err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := EgressPolicy{Allow: tc.allow}.Validate()
+			if (err != nil) != tc.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -24,6 +24,14 @@ const (
 	TEAMNAME_KEY      = "TN"
 	token_key         = "testing"
 	displayTimeFormat = time.RFC3339
+
+	// environment backends running the exercises of labs
+	DockerBackend     = "docker"
+	KubernetesBackend = "kubernetes"
+
+	// VPN profiles a team can create when the event does not set it
+	DefaultVPNProfiles = 4
+	MaxVPNProfiles     = 10
 )
 
 var (
@@ -49,13 +57,31 @@ type EventConfig struct {
 	DisabledChallenges map[string][]string // list of disabled children challenge tags to be used for amigo frontend ...
 	AllChallenges      map[string][]string
 	SecretKey          string // secret key is a key which is defined by event creator to setup events which are accessible only with signup key
-
+	IdlePolicy         IdlePolicy
+	Backend            string
+	VPNProfiles        int
+	Egress             EgressPolicy
+	// IPv6 makes the lab networks dual-stack
+	IPv6 bool
+	// PortForward forwards the services exposed by the
+	// exercises to the teams, next to their frontends or VPN
+	PortForward bool
+	Recording   RecordingPolicy
+	// Capture records the traffic on the networks of
+	// the labs once they are assigned to teams
+	Capture bool
+	// DNSRecords are added by the organizers to the DNS
+	// of every lab next to the records of the exercises
+	DNSRecords []RecordConfig
 }
 
 type Lab struct {
 	Frontends         []InstanceConfig
 	Exercises         []Tag
 	DisabledExercises []Tag
+	// TerminalExercises are the exercises which teams solve from
+	// the terminal in their browser, labs have no terminal when empty
+	TerminalExercises []Tag
 }
 
 func (e EventConfig) Validate() error {
@@ -80,9 +106,57 @@ func (e EventConfig) Validate() error {
 		return &EmptyVarErr{Var: "Frontends", Type: "Event"}
 	}
 
+	if err := e.IdlePolicy.Validate(); err != nil {
+		return err
+	}
+
+	switch e.Backend {
+	case "", DockerBackend, KubernetesBackend:
+	default:
+		return fmt.Errorf("unknown backend %q, use %q or %q", e.Backend, DockerBackend, KubernetesBackend)
+	}
+
+	if err := e.Egress.Validate(); err != nil {
+		return err
+	}
+
+	if err := e.Recording.Validate(); err != nil {
+		return err
+	}
+
+	if e.VPNProfiles < 0 || e.VPNProfiles > MaxVPNProfiles {
+		return fmt.Errorf("amount of VPN profiles should be between 1 and %d", MaxVPNProfiles)
+	}
+
+	for _, t := range e.Lab.TerminalExercises {
+		if !tagIn(t, e.Lab.Exercises) {
+			return &UnknownExerTagErr{t}
+		}
+	}
+
 	return nil
 }
 
+func tagIn(tag Tag, tags []Tag) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TeamVPNProfiles returns the amount of VPN profiles
+// a team of the event is allowed to create
+func (e EventConfig) TeamVPNProfiles() int {
+	if e.VPNProfiles == 0 {
+		return DefaultVPNProfiles
+	}
+
+	return e.VPNProfiles
+}
+
 type Event struct {
 	Dir string
 	dbc pbc.StoreClient
@@ -126,6 +200,24 @@ func (e Event) SetStatus(eventTag string, status int32) error {
 	return nil
 }
 
+// SetIdlePolicy saves the idle policy of the event on db
+func (e Event) SetIdlePolicy(p IdlePolicy) error {
+	_, err := e.dbc.UpdateIdlePolicy(context.Background(), &pbc.UpdateIdlePolicyRequest{
+		EventTag:   string(e.Tag),
+		IdlePolicy: p.String(),
+	})
+	return err
+}
+
+// SetDNSRecords saves the records added to the DNS of the labs of the event on db
+func (e Event) SetDNSRecords(records []RecordConfig) error {
+	_, err := e.dbc.UpdateDNSRecords(context.Background(), &pbc.UpdateDNSRecordsRequest{
+		EventTag:   string(e.Tag),
+		DnsRecords: DNSRecordsString(records),
+	})
+	return err
+}
+
 // Create the EventSore for the event. It contains:
 // The connection with the DB
 // A new TeamStore that contains all the teams retrieved from the DB (if no teams are retrieved the TeamStore will be empty)
@@ -146,6 +238,14 @@ func NewEventStore(conf EventConfig, eDir string, dbc pbc.StoreClient) (Event, e
 		team := NewTeam(teamDB.Email, teamDB.Name, "",
 			teamDB.Id, teamDB.HashPassword, teamDB.SolvedChallenges,
 			lastAccessedTime.UTC(), conf.DisabledChallenges, conf.AllChallenges, dbc)
+		team.checkpoint, err = ParseCheckpoint(teamDB.Checkpoint)
+		if err != nil {
+			log.Error().Msgf("[NewEventStore] Checkpoint parsing error %v", err)
+		}
+		team.vpnProfiles, err = ParseVPNProfiles(teamDB.VpnProfiles)
+		if err != nil {
+			log.Error().Msgf("[NewEventStore] VPN profiles parsing error %v", err)
+		}
 		teamToken, err := GetTokenForTeam([]byte(token_key), team)
 		if err != nil {
 			log.Debug().Msgf("Error in getting token for team %s", team.Name())
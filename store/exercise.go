@@ -3,16 +3,55 @@ package store
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aau-network-security/haaukins/virtual/docker"
 )
 
+const (
+	RDPProtocol = "rdp"
+	VNCProtocol = "vnc"
+	SSHProtocol = "ssh"
+
+	// protocols of services forwarded to teams
+	HTTPService = "http"
+	TCPService  = "tcp"
+
+	// LabNetwork is the network shared by the exercises of a lab,
+	// instances attached to segments use it to stay on the lab network
+	LabNetwork = "lab"
+
+	// fixed addresses below .4 are taken by the gateway,
+	// DHCP and DNS server of the lab network
+	minFixedIP = 4
+	maxFixedIP = 254
+)
+
 var (
 	EmptyExTags         = errors.New("Exercise cannot have zero tags")
 	ImageNotDefinedErr  = errors.New("image cannot be empty")
 	MemoryNotDefinedErr = errors.New("memory cannot be empty")
+	DuplicateNetworkErr = errors.New("network is declared or attached more than once")
+	InvalidFixedIPErr   = errors.New("fixed address must be between .4 and .254")
+	DuplicateFixedIPErr = errors.New("fixed address is used by more than one instance")
+	VMNetworkErr        = errors.New("virtual machines can only be on the lab network")
+	RouterNetworksErr   = errors.New("router needs to be attached to at least two networks")
+	ServiceNameErr      = errors.New("name of exposed service must be a lowercase DNS label")
+	DuplicateServiceErr = errors.New("service is exposed more than once")
+	ServiceNetworkErr   = errors.New("only services on the lab network can be exposed")
+
+	serviceNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
 )
 
+type UnknownNetworkErr struct {
+	network string
+}
+
+func (une *UnknownNetworkErr) Error() string {
+	return fmt.Sprintf("Unknown network: %s", une.network)
+}
+
 type UnknownExerTagErr struct {
 	tag Tag
 }
@@ -54,6 +93,90 @@ func (e Exercise) Validate() error {
 		return &EmptyVarErr{Var: "Tag", Type: "Exercise"}
 	}
 
+	if err := e.validateNetworks(); err != nil {
+		return err
+	}
+
+	return e.validateServices()
+}
+
+// validateServices checks that the names of exposed services are
+// unique within the exercise and that their hosts are on the lab network
+func (e Exercise) validateServices() error {
+	names := map[string]bool{}
+	for _, conf := range e.Instance {
+		onLab := len(conf.Networks) == 0
+		for _, a := range conf.Networks {
+			if a.Network == LabNetwork {
+				onLab = true
+			}
+		}
+
+		for _, r := range conf.Records {
+			for _, ex := range r.Expose {
+				if err := ex.Validate(); err != nil {
+					return err
+				}
+				if !onLab {
+					return ServiceNetworkErr
+				}
+				if names[ex.Name] {
+					return DuplicateServiceErr
+				}
+				names[ex.Name] = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateNetworks checks that instances are attached to declared
+// networks, and that fixed addresses are unique on each network
+func (e Exercise) validateNetworks() error {
+	networks := map[string]bool{LabNetwork: true}
+	for _, n := range e.Networks {
+		if n.Name == "" {
+			return &EmptyVarErr{Var: "Name", Type: "Network"}
+		}
+		if networks[n.Name] {
+			return DuplicateNetworkErr
+		}
+		networks[n.Name] = true
+	}
+
+	fixed := map[NetworkAttachment]bool{}
+	for _, conf := range e.Instance {
+		if len(conf.Networks) > 0 && strings.Contains(conf.Image, ".ova") {
+			return VMNetworkErr
+		}
+		if conf.Router && len(conf.Networks) < 2 {
+			return RouterNetworksErr
+		}
+
+		attached := map[string]bool{}
+		for _, a := range conf.Networks {
+			if !networks[a.Network] {
+				return &UnknownNetworkErr{network: a.Network}
+			}
+			if attached[a.Network] {
+				return DuplicateNetworkErr
+			}
+			attached[a.Network] = true
+
+			if a.IP == 0 {
+				continue
+			}
+			if a.IP < minFixedIP || a.IP > maxFixedIP {
+				return InvalidFixedIPErr
+			}
+			if fixed[a] {
+				return DuplicateFixedIPErr
+			}
+			fixed[a] = true
+		}
+	}
+
 	return nil
 }
 
@@ -61,6 +184,10 @@ type ContainerOptions struct {
 	DockerConf docker.ContainerConfig
 	Records    []RecordConfig
 	Challenges []Challenge
+	Connection *ConnectionConfig
+	Egress     *ExerciseEgress
+	Networks   []NetworkAttachment
+	Router     bool
 }
 
 func (e Exercise) ContainerOpts() []ContainerOptions {
@@ -99,12 +226,12 @@ func (e Exercise) ContainerOpts() []ContainerOptions {
 
 		if !e.Static {
 			spec = docker.ContainerConfig{
-				Image: conf.Image,
-				Resources: &docker.Resources{
-					MemoryMB: conf.MemoryMB,
-					CPU:      conf.CPU,
-				},
-				EnvVars: envVars,
+				Image:     conf.Image,
+				Resources: conf.Limits.resources(conf.MemoryMB, conf.CPU),
+				EnvVars:   envVars,
+			}
+			if conf.Router {
+				spec.Sysctls = map[string]string{"net.ipv4.ip_forward": "1"}
 			}
 		}
 
@@ -112,12 +239,43 @@ func (e Exercise) ContainerOpts() []ContainerOptions {
 			DockerConf: spec,
 			Records:    conf.Records,
 			Challenges: challenges,
+			Connection: conf.Connection,
+			Egress:     conf.Egress,
+			Networks:   conf.Networks,
+			Router:     conf.Router,
 		})
 	}
 
 	return opts
 }
 
+func (rl *ResourceLimits) resources(memoryMB uint, cpu float64) *docker.Resources {
+	res := &docker.Resources{
+		MemoryMB: memoryMB,
+		CPU:      cpu,
+	}
+	if rl == nil {
+		return res
+	}
+
+	res.PidsLimit = rl.PidsLimit
+	res.StorageMB = rl.StorageMB
+	res.BlkioWeight = rl.BlkioWeight
+	res.Tmpfs = rl.Tmpfs
+	res.ReadOnlyRootfs = rl.ReadOnly
+	res.CapDrop = rl.CapDrop
+	res.SeccompProfile = rl.Seccomp
+	for _, u := range rl.Ulimits {
+		res.Ulimits = append(res.Ulimits, docker.Ulimit{
+			Name: u.Name,
+			Soft: u.Soft,
+			Hard: u.Hard,
+		})
+	}
+
+	return res
+}
+
 func (rc RecordConfig) Validate() error {
 	if rc.Type == "" {
 		return &EmptyVarErr{Var: "Type", Type: "Record config"}
@@ -130,6 +288,36 @@ func (rc RecordConfig) Validate() error {
 	return nil
 }
 
+func (ec ExposeConfig) Validate() error {
+	if !serviceNameRegex.MatchString(ec.Name) {
+		return ServiceNameErr
+	}
+
+	switch ec.Protocol {
+	case "", HTTPService, TCPService:
+	default:
+		return fmt.Errorf("unsupported service protocol: %s", ec.Protocol)
+	}
+
+	if ec.Port == 0 {
+		return &EmptyVarErr{Var: "Port", Type: "Exposed service"}
+	}
+	if ec.Port > 65535 {
+		return fmt.Errorf("invalid port of service %s: %d", ec.Name, ec.Port)
+	}
+
+	return nil
+}
+
+// ServiceProtocol returns the protocol of the service, defaults to http
+func (ec ExposeConfig) ServiceProtocol() string {
+	if ec.Protocol == "" {
+		return HTTPService
+	}
+
+	return ec.Protocol
+}
+
 func (rc RecordConfig) Format(ip string) string {
 	return fmt.Sprintf("%s %s %s", rc.Name, rc.Type, ip)
 }
@@ -176,6 +364,27 @@ func (ic InstanceConfig) Validate() error {
 	if ic.CPU < 0 {
 		return errors.New("cpu cannot be negative")
 	}
+	switch ic.Protocol {
+	case "", RDPProtocol, VNCProtocol:
+	default:
+		return fmt.Errorf("unsupported frontend protocol: %s", ic.Protocol)
+	}
+
+	return nil
+}
+
+func (cc ConnectionConfig) Validate() error {
+	switch cc.Protocol {
+	case SSHProtocol, VNCProtocol:
+	case "":
+		return &EmptyVarErr{Var: "Protocol", Type: "Connection config"}
+	default:
+		return fmt.Errorf("unsupported connection protocol: %s", cc.Protocol)
+	}
+
+	if cc.Port == 0 {
+		return &EmptyVarErr{Var: "Port", Type: "Connection config"}
+	}
 
 	return nil
 }
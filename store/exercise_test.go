@@ -0,0 +1,165 @@
+package store
+
+import (
+	"testing"
+)
+
+func TestExerciseValidateNetworks(t *testing.T) {
+	dmz := []NetworkConfig{{Name: "dmz"}}
+	router := ExerciseInstanceConfig{
+		Image:    "router",
+		Router:   true,
+		Networks: []NetworkAttachment{{Network: LabNetwork, IP: 10}, {Network: "dmz", IP: 10}},
+	}
+
+	tt := []struct {
+		name      string
+		networks  []NetworkConfig
+		instances []ExerciseInstanceConfig
+		err       string
+	}{
+		{
+			name:      "Lab network",
+			instances: []ExerciseInstanceConfig{{Image: "web"}, {Image: "kali.ova"}},
+		},
+		{
+			name:     "Segment",
+			networks: dmz,
+			instances: []ExerciseInstanceConfig{
+				router,
+				{Image: "web", Networks: []NetworkAttachment{{Network: "dmz"}}},
+			},
+		},
+		{
+			name:     "Duplicate network",
+			networks: []NetworkConfig{{Name: "dmz"}, {Name: "dmz"}},
+			err:      DuplicateNetworkErr.Error(),
+		},
+		{
+			name:     "Lab network declared",
+			networks: []NetworkConfig{{Name: LabNetwork}},
+			err:      DuplicateNetworkErr.Error(),
+		},
+		{
+			name:      "Unknown network",
+			instances: []ExerciseInstanceConfig{{Image: "web", Networks: []NetworkAttachment{{Network: "dmz"}}}},
+			err:       "Unknown network: dmz",
+		},
+		{
+			name:     "Duplicate address",
+			networks: dmz,
+			instances: []ExerciseInstanceConfig{
+				router,
+				{Image: "web", Networks: []NetworkAttachment{{Network: "dmz", IP: 10}}},
+			},
+			err: DuplicateFixedIPErr.Error(),
+		},
+		{
+			name:      "Reserved address",
+			instances: []ExerciseInstanceConfig{{Image: "web", Networks: []NetworkAttachment{{Network: LabNetwork, IP: 3}}}},
+			err:       InvalidFixedIPErr.Error(),
+		},
+		{
+			name:      "Router on one network",
+			instances: []ExerciseInstanceConfig{{Image: "router", Router: true, Networks: []NetworkAttachment{{Network: LabNetwork}}}},
+			err:       RouterNetworksErr.Error(),
+		},
+		{
+			name:      "Virtual machine on segment",
+			networks:  dmz,
+			instances: []ExerciseInstanceConfig{{Image: "kali.ova", Networks: []NetworkAttachment{{Network: "dmz"}}}},
+			err:       VMNetworkErr.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			e := Exercise{Tag: "topology", Networks: tc.networks, Instance: tc.instances}
+			err := e.Validate()
+			if err != nil {
+				if tc.err == "" {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err.Error() != tc.err {
+					t.Fatalf("expected error (%s), got: %v", tc.err, err)
+				}
+				return
+			}
+			if tc.err != "" {
+				t.Fatalf("expected error (%s)", tc.err)
+			}
+		})
+	}
+}
+
+func TestExerciseValidateServices(t *testing.T) {
+	web := func(expose ...ExposeConfig) ExerciseInstanceConfig {
+		return ExerciseInstanceConfig{
+			Image:   "web",
+			Records: []RecordConfig{{Type: "A", Name: "web.local", Expose: expose}},
+		}
+	}
+
+	tt := []struct {
+		name      string
+		instances []ExerciseInstanceConfig
+		err       string
+	}{
+		{
+			name:      "HTTP and TCP",
+			instances: []ExerciseInstanceConfig{web(ExposeConfig{Name: "web1", Port: 80}, ExposeConfig{Name: "shell", Port: 4444, Protocol: TCPService})},
+		},
+		{
+			name:      "Invalid name",
+			instances: []ExerciseInstanceConfig{web(ExposeConfig{Name: "Web.1", Port: 80})},
+			err:       ServiceNameErr.Error(),
+		},
+		{
+			name:      "Missing port",
+			instances: []ExerciseInstanceConfig{web(ExposeConfig{Name: "web1"})},
+			err:       "Port cannot be empty for Exposed service",
+		},
+		{
+			name:      "Unknown protocol",
+			instances: []ExerciseInstanceConfig{web(ExposeConfig{Name: "web1", Port: 53, Protocol: "udp"})},
+			err:       "unsupported service protocol: udp",
+		},
+		{
+			name:      "Duplicate name",
+			instances: []ExerciseInstanceConfig{web(ExposeConfig{Name: "web1", Port: 80}), web(ExposeConfig{Name: "web1", Port: 8080})},
+			err:       DuplicateServiceErr.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			e := Exercise{Tag: "services", Instance: tc.instances}
+			err := e.Validate()
+			if err != nil {
+				if tc.err == "" {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err.Error() != tc.err {
+					t.Fatalf("expected error (%s), got: %v", tc.err, err)
+				}
+				return
+			}
+			if tc.err != "" {
+				t.Fatalf("expected error (%s)", tc.err)
+			}
+		})
+	}
+
+	segment := Exercise{
+		Tag:      "services",
+		Networks: []NetworkConfig{{Name: "dmz"}},
+		Instance: []ExerciseInstanceConfig{{
+			Image:    "web",
+			Networks: []NetworkAttachment{{Network: "dmz"}},
+			Records:  []RecordConfig{{Type: "A", Name: "web.local", Expose: []ExposeConfig{{Name: "web1", Port: 80}}}},
+		}},
+	}
+	if err := segment.Validate(); err != ServiceNetworkErr {
+		t.Fatalf("expected error (%v), got: %v", ServiceNetworkErr, err)
+	}
+}
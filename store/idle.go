@@ -0,0 +1,221 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	IdleSuspend = IdleAction("suspend")
+	IdleStop    = IdleAction("stop")
+
+	DefaultIdleThreshold     = 8 * time.Hour
+	DefaultIdleCheckInterval = 15 * time.Minute
+
+	// sources of team activity
+	LoginActivity     = "login"
+	GuacamoleActivity = "guacamole"
+	VPNActivity       = "vpn"
+	FlagActivity      = "flag"
+	ForwardActivity   = "forward"
+	TerminalActivity  = "terminal"
+)
+
+// IdleAction is applied on labs of teams which are idle
+type IdleAction string
+
+// IdlePolicy defines when labs of an event are considered
+// to be idle and what should happen to them
+type IdlePolicy struct {
+	Threshold     time.Duration `json:"threshold"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	Action        IdleAction    `json:"action"`
+	ExemptTeams   []string      `json:"exemptTeams,omitempty"`
+}
+
+// WithDefaults returns the policy where unset values are
+// replaced by the defaults
+func (p IdlePolicy) WithDefaults() IdlePolicy {
+	if p.Threshold <= 0 {
+		p.Threshold = DefaultIdleThreshold
+	}
+	if p.CheckInterval <= 0 {
+		p.CheckInterval = DefaultIdleCheckInterval
+	}
+	if p.Action == "" {
+		p.Action = IdleSuspend
+	}
+	return p
+}
+
+func (p IdlePolicy) Validate() error {
+	switch p.Action {
+	case "", IdleSuspend, IdleStop:
+	default:
+		return fmt.Errorf("unknown idle action %q, use %q or %q", p.Action, IdleSuspend, IdleStop)
+	}
+	if p.Threshold < 0 || p.CheckInterval < 0 {
+		return fmt.Errorf("idle threshold and check interval cannot be negative")
+	}
+	return nil
+}
+
+// IsExempt returns true when labs of the team are never suspended
+func (p IdlePolicy) IsExempt(teamId string) bool {
+	for _, id := range p.ExemptTeams {
+		if id == teamId {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the policy in the format it is saved to the database
+func (p IdlePolicy) String() string {
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// ParseIdlePolicy parses a policy from the database, an empty
+// string is parsed into the default policy
+func ParseIdlePolicy(s string) (IdlePolicy, error) {
+	var p IdlePolicy
+	if s == "" {
+		return p.WithDefaults(), nil
+	}
+	if err := json.Unmarshal([]byte(s), &p); err != nil {
+		return p.WithDefaults(), err
+	}
+	return p.WithDefaults(), nil
+}
+
+// idlePolicyYAML is the policy in yaml files, where
+// durations are kept in a human readable format
+type idlePolicyYAML struct {
+	Threshold     string     `yaml:"threshold,omitempty"`
+	CheckInterval string     `yaml:"check-interval,omitempty"`
+	Action        IdleAction `yaml:"action,omitempty"`
+	ExemptTeams   []string   `yaml:"exempt-teams,omitempty"`
+}
+
+func (p IdlePolicy) MarshalYAML() (interface{}, error) {
+	py := idlePolicyYAML{
+		Action:      p.Action,
+		ExemptTeams: p.ExemptTeams,
+	}
+	if p.Threshold > 0 {
+		py.Threshold = p.Threshold.String()
+	}
+	if p.CheckInterval > 0 {
+		py.CheckInterval = p.CheckInterval.String()
+	}
+	return py, nil
+}
+
+func (p *IdlePolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var py idlePolicyYAML
+	if err := unmarshal(&py); err != nil {
+		return err
+	}
+
+	var err error
+	*p = IdlePolicy{
+		Action:      py.Action,
+		ExemptTeams: py.ExemptTeams,
+	}
+	if py.Threshold != "" {
+		if p.Threshold, err = time.ParseDuration(py.Threshold); err != nil {
+			return fmt.Errorf("invalid idle threshold: %v", err)
+		}
+	}
+	if py.CheckInterval != "" {
+		if p.CheckInterval, err = time.ParseDuration(py.CheckInterval); err != nil {
+			return fmt.Errorf("invalid idle check interval: %v", err)
+		}
+	}
+	return nil
+}
+
+// IdleDecision is recorded on a team whenever the idle
+// policy has been applied on the lab of the team
+type IdleDecision struct {
+	Action       IdleAction
+	At           time.Time
+	LastActivity time.Time
+	Source       string
+	Threshold    time.Duration
+	ResumedAt    time.Time
+}
+
+func (d IdleDecision) String() string {
+	s := fmt.Sprintf("%s at %s, no activity for %s (threshold %s)",
+		d.Action,
+		d.At.Format(displayTimeFormat),
+		d.At.Sub(d.LastActivity).Round(time.Minute),
+		d.Threshold)
+	if d.Source != "" {
+		s += fmt.Sprintf(", last activity: %s at %s", d.Source, d.LastActivity.Format(displayTimeFormat))
+	}
+	if !d.ResumedAt.IsZero() {
+		s += fmt.Sprintf(", resumed at %s", d.ResumedAt.Format(displayTimeFormat))
+	}
+	return s
+}
+
+// MarkActive registers activity of the team, it is kept
+// in memory as it is updated very frequently
+func (t *Team) MarkActive(source string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.lastActivity = time.Now()
+	t.activitySource = source
+}
+
+// LastActivity returns the time and the source of the latest
+// activity, the last access time is used when no activity is registered
+func (t *Team) LastActivity() (time.Time, string) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	if t.lastActivity.IsZero() {
+		return t.lastAccess, ""
+	}
+	return t.lastActivity, t.activitySource
+}
+
+func (t *Team) SetIdleDecision(d IdleDecision) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.idleDecision = &d
+}
+
+// IdleDecision returns the latest idle decision of the team
+func (t *Team) IdleDecision() (IdleDecision, bool) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	if t.idleDecision == nil {
+		return IdleDecision{}, false
+	}
+	return *t.idleDecision, true
+}
+
+// IsIdle returns true when the lab of the team is suspended
+// or stopped by the idle policy and has not been resumed yet
+func (t *Team) IsIdle() bool {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return t.idleDecision != nil && t.idleDecision.ResumedAt.IsZero()
+}
+
+// ResumeFromIdle marks the latest idle decision as resumed
+func (t *Team) ResumeFromIdle() {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.idleDecision != nil && t.idleDecision.ResumedAt.IsZero() {
+		t.idleDecision.ResumedAt = time.Now()
+	}
+}
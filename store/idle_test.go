@@ -0,0 +1,77 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseIdlePolicy(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    string
+		expected IdlePolicy
+		err      bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			expected: IdlePolicy{
+				Threshold:     DefaultIdleThreshold,
+				CheckInterval: DefaultIdleCheckInterval,
+				Action:        IdleSuspend,
+			},
+		},
+		{
+			name: "Normal",
+			input: IdlePolicy{
+				Threshold: 2 * time.Hour,
+				Action:    IdleStop,
+			}.String(),
+			expected: IdlePolicy{
+				Threshold:     2 * time.Hour,
+				CheckInterval: DefaultIdleCheckInterval,
+				Action:        IdleStop,
+			},
+		},
+		{
+			name:  "Invalid",
+			input: "suspend",
+			expected: IdlePolicy{
+				Threshold:     DefaultIdleThreshold,
+				CheckInterval: DefaultIdleCheckInterval,
+				Action:        IdleSuspend,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParseIdlePolicy(tc.input)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error (%t), but got: %v", tc.err, err)
+			}
+			if p.Threshold != tc.expected.Threshold ||
+				p.CheckInterval != tc.expected.CheckInterval ||
+				p.Action != tc.expected.Action {
+				t.Fatalf("expected policy %+v, but got %+v", tc.expected, p)
+			}
+		})
+	}
+}
+
+func TestIdlePolicyValidate(t *testing.T) {
+	if err := (IdlePolicy{Action: "pause"}).Validate(); err == nil {
+		t.Fatalf("expected error on unknown action")
+	}
+	if err := (IdlePolicy{Threshold: -time.Minute}).Validate(); err == nil {
+		t.Fatalf("expected error on negative threshold")
+	}
+	if err := (IdlePolicy{}).Validate(); err != nil {
+		t.Fatalf("expected empty policy to be valid, but got: %v", err)
+	}
+}
@@ -31,6 +31,15 @@ type Exercise struct {
 	Instance       []ExerciseInstanceConfig `json:"instance,omitempty"`
 	Status         int                      `json:"status,omitempty"`
 	OrgDescription string                   `json:"organizerDescription,omitempty"`
+	// Networks are the segments of the exercise next to the lab network,
+	// e.g. a DMZ and an internal network, instances attach to them by name
+	Networks []NetworkConfig `json:"networks,omitempty"`
+}
+
+// NetworkConfig is a network segment of an exercise, which is only
+// reachable from the lab network through the routers of the exercise
+type NetworkConfig struct {
+	Name string `json:"name,omitempty"`
 }
 
 type ExerciseInstanceConfig struct {
@@ -40,6 +49,60 @@ type ExerciseInstanceConfig struct {
 	Envs     []EnvVarConfig       `json:"envs,omitempty"`
 	Flags    []ChildrenChalConfig `json:"children,omitempty"`
 	Records  []RecordConfig       `json:"records,omitempty"`
+	// Connection gives teams a browser connection into the instance
+	Connection *ConnectionConfig `json:"connection,omitempty"`
+	Limits     *ResourceLimits   `json:"limits,omitempty"`
+	// Egress grants the instance access beyond the egress policy of the event
+	Egress *ExerciseEgress `json:"egress,omitempty"`
+	// Networks attaches the instance to the lab network and segments of
+	// the exercise, instances without networks attach to the lab network
+	Networks []NetworkAttachment `json:"networks,omitempty"`
+	// Router forwards traffic between the networks of the instance
+	Router bool `json:"router,omitempty"`
+}
+
+// NetworkAttachment attaches an instance to a network, the instance
+// gets the fixed address x.y.z.IP when IP is set
+type NetworkAttachment struct {
+	Network string `json:"network,omitempty"`
+	IP      int    `json:"ip,omitempty"`
+}
+
+// ExerciseEgress is the egress of an exercise instance which needs
+// to reach the internet or specific domains, e.g. to fetch packages
+type ExerciseEgress struct {
+	Internet bool     `json:"internet,omitempty"`
+	Allow    []string `json:"allow,omitempty"`
+}
+
+// ResourceLimits restricts the resources of an exercise instance
+// in addition to its memory and cpu
+type ResourceLimits struct {
+	PidsLimit   int64             `json:"pidsLimit,omitempty"`
+	StorageMB   uint              `json:"storage,omitempty"`
+	BlkioWeight uint              `json:"blkioWeight,omitempty"`
+	Ulimits     []UlimitConfig    `json:"ulimits,omitempty"`
+	Tmpfs       map[string]string `json:"tmpfs,omitempty"`
+	ReadOnly    bool              `json:"readOnly,omitempty"`
+	CapDrop     []string          `json:"capDrop,omitempty"`
+	// Seccomp is a path to a seccomp profile on the host or "unconfined"
+	Seccomp string `json:"seccomp,omitempty"`
+}
+
+type UlimitConfig struct {
+	Name string `json:"name,omitempty"`
+	Soft int64  `json:"soft,omitempty"`
+	Hard int64  `json:"hard,omitempty"`
+}
+
+// ConnectionConfig describes the SSH or VNC server of an exercise
+// instance, the instance receives per team credentials through the
+// HKN_SSH_USER, HKN_SSH_PASSWORD and HKN_SSH_AUTHORIZED_KEY or the
+// HKN_VNC_PASSWORD environment variables
+type ConnectionConfig struct {
+	Protocol string `json:"protocol,omitempty"`
+	Port     uint   `json:"port,omitempty"`
+	Username string `json:"username,omitempty"`
 }
 
 type ChildrenChalConfig struct {
@@ -59,6 +122,18 @@ type RecordConfig struct {
 	Type  string `json:"type,omitempty"`
 	Name  string `json:"name,omitempty"`
 	RData string `json:"data,omitempty"`
+	// Expose lists the services of the host of the record which are
+	// forwarded to the teams of events using port forwarding
+	Expose []ExposeConfig `json:"expose,omitempty"`
+}
+
+// ExposeConfig is a service forwarded to teams, HTTP services are served
+// on the subdomain given by the name (e.g. web1.<team>.<event>.<host>)
+// and TCP services on a port of the port range of the team
+type ExposeConfig struct {
+	Name     string `json:"name,omitempty"`
+	Port     uint   `json:"port,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
 }
 
 type EnvVarConfig struct {
@@ -70,4 +145,10 @@ type InstanceConfig struct {
 	Image    string  `yaml:"image"`
 	MemoryMB uint    `yaml:"memoryMB"`
 	CPU      float64 `yaml:"cpu"`
+	// Provider of the instance when used as a frontend
+	// (vbox, docker or libvirt), defaults to vbox
+	Provider string `yaml:"provider,omitempty"`
+	// Protocol of the remote desktop of the frontend (rdp or vnc),
+	// defaults to rdp
+	Protocol string `yaml:"protocol,omitempty"`
 }
@@ -22,6 +22,7 @@ type StoreClient interface {
 	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*InsertResponse, error)
 	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*InsertResponse, error)
 	AddProfile(ctx context.Context, in *AddProfileRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	AddSubnet(ctx context.Context, in *Subnet, opts ...grpc.CallOption) (*InsertResponse, error)
 	//Select
 	GetEvents(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	GetEventByUser(ctx context.Context, in *GetEventByUserReq, opts ...grpc.CallOption) (*GetEventResponse, error)
@@ -32,6 +33,7 @@ type StoreClient interface {
 	DropEvent(ctx context.Context, in *DropEventReq, opts ...grpc.CallOption) (*DropEventResp, error)
 	GetEventID(ctx context.Context, in *GetEventIDReq, opts ...grpc.CallOption) (*GetEventIDResp, error)
 	GetProfiles(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetProfilesResp, error)
+	GetSubnets(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetSubnetsResponse, error)
 	SetEventStatus(ctx context.Context, in *SetEventStatusRequest, opts ...grpc.CallOption) (*EventStatusStore, error)
 	//Update
 	UpdateCloseEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
@@ -40,9 +42,14 @@ type StoreClient interface {
 	UpdateTeamPassword(ctx context.Context, in *UpdateTeamPassRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	UpdateExercises(ctx context.Context, in *UpdateExerciseRequest, opts ...grpc.CallOption) (*UpdateExerciseResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	UpdateIdlePolicy(ctx context.Context, in *UpdateIdlePolicyRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	UpdateDNSRecords(ctx context.Context, in *UpdateDNSRecordsRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	UpdateTeamCheckpoint(ctx context.Context, in *UpdateTeamCheckpointRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	UpdateTeamVPNProfiles(ctx context.Context, in *UpdateTeamVPNProfilesRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	// Delete
 	DeleteTeam(ctx context.Context, in *DelTeamRequest, opts ...grpc.CallOption) (*DelTeamResp, error)
 	DeleteProfile(ctx context.Context, in *DelProfileRequest, opts ...grpc.CallOption) (*DelProfileResp, error)
+	ReleaseSubnet(ctx context.Context, in *ReleaseSubnetRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 }
 
 type storeClient struct {
@@ -80,6 +87,15 @@ func (c *storeClient) AddProfile(ctx context.Context, in *AddProfileRequest, opt
 	return out, nil
 }
 
+func (c *storeClient) AddSubnet(ctx context.Context, in *Subnet, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/AddSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) GetEvents(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
 	out := new(GetEventResponse)
 	err := c.cc.Invoke(ctx, "/store.Store/GetEvents", in, out, opts...)
@@ -161,6 +177,15 @@ func (c *storeClient) GetProfiles(ctx context.Context, in *EmptyRequest, opts ..
 	return out, nil
 }
 
+func (c *storeClient) GetSubnets(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetSubnetsResponse, error) {
+	out := new(GetSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/GetSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) SetEventStatus(ctx context.Context, in *SetEventStatusRequest, opts ...grpc.CallOption) (*EventStatusStore, error) {
 	out := new(EventStatusStore)
 	err := c.cc.Invoke(ctx, "/store.Store/SetEventStatus", in, out, opts...)
@@ -224,6 +249,42 @@ func (c *storeClient) UpdateProfile(ctx context.Context, in *UpdateProfileReques
 	return out, nil
 }
 
+func (c *storeClient) UpdateIdlePolicy(ctx context.Context, in *UpdateIdlePolicyRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/UpdateIdlePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) UpdateDNSRecords(ctx context.Context, in *UpdateDNSRecordsRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/UpdateDNSRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) UpdateTeamCheckpoint(ctx context.Context, in *UpdateTeamCheckpointRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/UpdateTeamCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) UpdateTeamVPNProfiles(ctx context.Context, in *UpdateTeamVPNProfilesRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/UpdateTeamVPNProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) DeleteTeam(ctx context.Context, in *DelTeamRequest, opts ...grpc.CallOption) (*DelTeamResp, error) {
 	out := new(DelTeamResp)
 	err := c.cc.Invoke(ctx, "/store.Store/DeleteTeam", in, out, opts...)
@@ -242,6 +303,15 @@ func (c *storeClient) DeleteProfile(ctx context.Context, in *DelProfileRequest,
 	return out, nil
 }
 
+func (c *storeClient) ReleaseSubnet(ctx context.Context, in *ReleaseSubnetRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/ReleaseSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreServer is the server API for Store service.
 // All implementations must embed UnimplementedStoreServer
 // for forward compatibility
@@ -250,6 +320,7 @@ type StoreServer interface {
 	AddEvent(context.Context, *AddEventRequest) (*InsertResponse, error)
 	AddTeam(context.Context, *AddTeamRequest) (*InsertResponse, error)
 	AddProfile(context.Context, *AddProfileRequest) (*InsertResponse, error)
+	AddSubnet(context.Context, *Subnet) (*InsertResponse, error)
 	//Select
 	GetEvents(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	GetEventByUser(context.Context, *GetEventByUserReq) (*GetEventResponse, error)
@@ -260,6 +331,7 @@ type StoreServer interface {
 	DropEvent(context.Context, *DropEventReq) (*DropEventResp, error)
 	GetEventID(context.Context, *GetEventIDReq) (*GetEventIDResp, error)
 	GetProfiles(context.Context, *EmptyRequest) (*GetProfilesResp, error)
+	GetSubnets(context.Context, *EmptyRequest) (*GetSubnetsResponse, error)
 	SetEventStatus(context.Context, *SetEventStatusRequest) (*EventStatusStore, error)
 	//Update
 	UpdateCloseEvent(context.Context, *UpdateEventRequest) (*UpdateResponse, error)
@@ -268,9 +340,14 @@ type StoreServer interface {
 	UpdateTeamPassword(context.Context, *UpdateTeamPassRequest) (*UpdateResponse, error)
 	UpdateExercises(context.Context, *UpdateExerciseRequest) (*UpdateExerciseResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateResponse, error)
+	UpdateIdlePolicy(context.Context, *UpdateIdlePolicyRequest) (*UpdateResponse, error)
+	UpdateDNSRecords(context.Context, *UpdateDNSRecordsRequest) (*UpdateResponse, error)
+	UpdateTeamCheckpoint(context.Context, *UpdateTeamCheckpointRequest) (*UpdateResponse, error)
+	UpdateTeamVPNProfiles(context.Context, *UpdateTeamVPNProfilesRequest) (*UpdateResponse, error)
 	// Delete
 	DeleteTeam(context.Context, *DelTeamRequest) (*DelTeamResp, error)
 	DeleteProfile(context.Context, *DelProfileRequest) (*DelProfileResp, error)
+	ReleaseSubnet(context.Context, *ReleaseSubnetRequest) (*UpdateResponse, error)
 	mustEmbedUnimplementedStoreServer()
 }
 
@@ -287,6 +364,9 @@ func (UnimplementedStoreServer) AddTeam(context.Context, *AddTeamRequest) (*Inse
 func (UnimplementedStoreServer) AddProfile(context.Context, *AddProfileRequest) (*InsertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProfile not implemented")
 }
+func (UnimplementedStoreServer) AddSubnet(context.Context, *Subnet) (*InsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubnet not implemented")
+}
 func (UnimplementedStoreServer) GetEvents(context.Context, *GetEventRequest) (*GetEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
@@ -314,6 +394,9 @@ func (UnimplementedStoreServer) GetEventID(context.Context, *GetEventIDReq) (*Ge
 func (UnimplementedStoreServer) GetProfiles(context.Context, *EmptyRequest) (*GetProfilesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfiles not implemented")
 }
+func (UnimplementedStoreServer) GetSubnets(context.Context, *EmptyRequest) (*GetSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubnets not implemented")
+}
 func (UnimplementedStoreServer) SetEventStatus(context.Context, *SetEventStatusRequest) (*EventStatusStore, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEventStatus not implemented")
 }
@@ -335,12 +418,27 @@ func (UnimplementedStoreServer) UpdateExercises(context.Context, *UpdateExercise
 func (UnimplementedStoreServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedStoreServer) UpdateIdlePolicy(context.Context, *UpdateIdlePolicyRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdlePolicy not implemented")
+}
+func (UnimplementedStoreServer) UpdateDNSRecords(context.Context, *UpdateDNSRecordsRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDNSRecords not implemented")
+}
+func (UnimplementedStoreServer) UpdateTeamCheckpoint(context.Context, *UpdateTeamCheckpointRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamCheckpoint not implemented")
+}
+func (UnimplementedStoreServer) UpdateTeamVPNProfiles(context.Context, *UpdateTeamVPNProfilesRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamVPNProfiles not implemented")
+}
 func (UnimplementedStoreServer) DeleteTeam(context.Context, *DelTeamRequest) (*DelTeamResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
 }
 func (UnimplementedStoreServer) DeleteProfile(context.Context, *DelProfileRequest) (*DelProfileResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
 }
+func (UnimplementedStoreServer) ReleaseSubnet(context.Context, *ReleaseSubnetRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSubnet not implemented")
+}
 func (UnimplementedStoreServer) mustEmbedUnimplementedStoreServer() {}
 
 // UnsafeStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -408,6 +506,24 @@ func _Store_AddProfile_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_AddSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).AddSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/AddSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).AddSubnet(ctx, req.(*Subnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEventRequest)
 	if err := dec(in); err != nil {
@@ -570,6 +686,24 @@ func _Store_GetProfiles_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_GetSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).GetSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/GetSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).GetSubnets(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_SetEventStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetEventStatusRequest)
 	if err := dec(in); err != nil {
@@ -696,6 +830,78 @@ func _Store_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_UpdateIdlePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIdlePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).UpdateIdlePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/UpdateIdlePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).UpdateIdlePolicy(ctx, req.(*UpdateIdlePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_UpdateDNSRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDNSRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).UpdateDNSRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/UpdateDNSRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).UpdateDNSRecords(ctx, req.(*UpdateDNSRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_UpdateTeamCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).UpdateTeamCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/UpdateTeamCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).UpdateTeamCheckpoint(ctx, req.(*UpdateTeamCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_UpdateTeamVPNProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamVPNProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).UpdateTeamVPNProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/UpdateTeamVPNProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).UpdateTeamVPNProfiles(ctx, req.(*UpdateTeamVPNProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelTeamRequest)
 	if err := dec(in); err != nil {
@@ -732,6 +938,24 @@ func _Store_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_ReleaseSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).ReleaseSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/ReleaseSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).ReleaseSubnet(ctx, req.(*ReleaseSubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Store_ServiceDesc is the grpc.ServiceDesc for Store service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -751,6 +975,10 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddProfile",
 			Handler:    _Store_AddProfile_Handler,
 		},
+		{
+			MethodName: "AddSubnet",
+			Handler:    _Store_AddSubnet_Handler,
+		},
 		{
 			MethodName: "GetEvents",
 			Handler:    _Store_GetEvents_Handler,
@@ -787,6 +1015,10 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfiles",
 			Handler:    _Store_GetProfiles_Handler,
 		},
+		{
+			MethodName: "GetSubnets",
+			Handler:    _Store_GetSubnets_Handler,
+		},
 		{
 			MethodName: "SetEventStatus",
 			Handler:    _Store_SetEventStatus_Handler,
@@ -815,6 +1047,22 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _Store_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "UpdateIdlePolicy",
+			Handler:    _Store_UpdateIdlePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateDNSRecords",
+			Handler:    _Store_UpdateDNSRecords_Handler,
+		},
+		{
+			MethodName: "UpdateTeamCheckpoint",
+			Handler:    _Store_UpdateTeamCheckpoint_Handler,
+		},
+		{
+			MethodName: "UpdateTeamVPNProfiles",
+			Handler:    _Store_UpdateTeamVPNProfiles_Handler,
+		},
 		{
 			MethodName: "DeleteTeam",
 			Handler:    _Store_DeleteTeam_Handler,
@@ -823,6 +1071,10 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProfile",
 			Handler:    _Store_DeleteProfile_Handler,
 		},
+		{
+			MethodName: "ReleaseSubnet",
+			Handler:    _Store_ReleaseSubnet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "store.proto",
@@ -0,0 +1,78 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RecordingPolicy records the guacamole connections of the teams
+// of an event, so that organizers can replay them afterwards
+type RecordingPolicy struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Retention is how long recordings are kept,
+	// they are never removed when it is not set
+	Retention time.Duration `json:"retention,omitempty"`
+}
+
+func (p RecordingPolicy) Validate() error {
+	if p.Retention < 0 {
+		return fmt.Errorf("retention of recordings cannot be negative")
+	}
+	return nil
+}
+
+// String returns the policy in the format it is saved to the database
+func (p RecordingPolicy) String() string {
+	if !p.Enabled {
+		return ""
+	}
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// ParseRecordingPolicy parses a policy from the database, an
+// empty string is parsed into a policy without recordings
+func ParseRecordingPolicy(s string) (RecordingPolicy, error) {
+	var p RecordingPolicy
+	if s == "" {
+		return p, nil
+	}
+	err := json.Unmarshal([]byte(s), &p)
+	return p, err
+}
+
+// recordingPolicyYAML is the policy in yaml files, where
+// the retention is kept in a human readable format
+type recordingPolicyYAML struct {
+	Enabled   bool   `yaml:"enabled,omitempty"`
+	Retention string `yaml:"retention,omitempty"`
+}
+
+func (p RecordingPolicy) MarshalYAML() (interface{}, error) {
+	py := recordingPolicyYAML{Enabled: p.Enabled}
+	if p.Retention > 0 {
+		py.Retention = p.Retention.String()
+	}
+	return py, nil
+}
+
+func (p *RecordingPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var py recordingPolicyYAML
+	if err := unmarshal(&py); err != nil {
+		return err
+	}
+
+	*p = RecordingPolicy{Enabled: py.Enabled}
+	if py.Retention != "" {
+		var err error
+		if p.Retention, err = time.ParseDuration(py.Retention); err != nil {
+			return fmt.Errorf("invalid retention of recordings: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecordingPolicy(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    string
+		expected RecordingPolicy
+		err      bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+		},
+		{
+			name:     "Normal",
+			input:    RecordingPolicy{Enabled: true, Retention: 30 * 24 * time.Hour}.String(),
+			expected: RecordingPolicy{Enabled: true, Retention: 30 * 24 * time.Hour},
+		},
+		{
+			name:  "Disabled",
+			input: RecordingPolicy{Retention: time.Hour}.String(),
+		},
+		{
+			name:  "Invalid",
+			input: "record",
+			err:   true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParseRecordingPolicy(tc.input)
+			if (err != nil) != tc.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.err && p != tc.expected {
+				t.Fatalf("expected policy %+v, got %+v", tc.expected, p)
+			}
+		})
+	}
+}
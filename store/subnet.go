@@ -0,0 +1,73 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aau-network-security/haaukins/network/subnet"
+	pbc "github.com/aau-network-security/haaukins/store/proto"
+)
+
+// subnetStore keeps the subnets allocated by the daemon in
+// the store service, such that they survive a restart
+type subnetStore struct {
+	dbc pbc.StoreClient
+}
+
+func NewSubnetStore(dbc pbc.StoreClient) subnet.Store {
+	return &subnetStore{dbc: dbc}
+}
+
+func (ss *subnetStore) Add(al subnet.Allocation) error {
+	resp, err := ss.dbc.AddSubnet(context.Background(), &pbc.Subnet{
+		Subnet:    al.Subnet,
+		Kind:      string(al.Kind),
+		Owner:     al.Owner,
+		CreatedAt: al.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return TranslateRPCErr(err)
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (ss *subnetStore) Release(s string) error {
+	resp, err := ss.dbc.ReleaseSubnet(context.Background(), &pbc.ReleaseSubnetRequest{Subnet: s})
+	if err != nil {
+		return TranslateRPCErr(err)
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (ss *subnetStore) List() ([]subnet.Allocation, error) {
+	resp, err := ss.dbc.GetSubnets(context.Background(), &pbc.EmptyRequest{})
+	if err != nil {
+		return nil, TranslateRPCErr(err)
+	}
+
+	var allocations []subnet.Allocation
+	for _, s := range resp.Subnets {
+		createdAt, _ := time.Parse(time.RFC3339, s.CreatedAt)
+		allocations = append(allocations, subnet.Allocation{
+			Subnet:    s.Subnet,
+			Kind:      subnet.Kind(s.Kind),
+			Owner:     s.Owner,
+			CreatedAt: createdAt,
+		})
+	}
+
+	return allocations, nil
+}
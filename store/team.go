@@ -153,16 +153,6 @@ func (es *teamstore) GetTeamByID(id string) (*Team, error) {
 	return t, nil
 }
 
-func (es *teamstore) GetVPNConn(teamid string) []string {
-	es.m.RLock()
-	t, ok := es.teams[teamid]
-	if !ok {
-		es.m.RUnlock()
-		return []string{}
-	}
-	return t.vpnConf
-}
-
 func (es *teamstore) GetTeamByUsername(username string) (*Team, error) {
 	es.m.RLock()
 
@@ -224,13 +214,21 @@ type Team struct {
 	lastAccess         time.Time
 	challenges         map[string]TeamChallenge
 	solvedChalsDB      []TeamChallenge //json got from the DB containing list of solved Challenges
-	vpnKeys            map[int]string
-	vpnConf            []string
+	vpnProfiles        map[int]VPNProfile
 	labSubnet          string
 	isLabAssigned      bool
 	hostsInfo          []string
 	disabledChallenges map[string][]string // list of disabled children challenge tags to be used for amigo frontend
 	allChallenges      map[string][]string
+	// activity registered from guacamole, vpn and flag submissions
+	// used by the idle policy of the event
+	lastActivity   time.Time
+	activitySource string
+	idleDecision   *IdleDecision
+	// resources consumed by the lab, sampled by the daemon
+	usage *LabUsage
+	// images of the latest checkpoint of the exercises of the lab
+	checkpoint Checkpoint
 }
 
 type TeamChallenge struct {
@@ -267,7 +265,7 @@ func NewTeam(email, name, password, id, hashedPass, solvedChalsDB string,
 		challenges:         map[string]TeamChallenge{},
 		solvedChalsDB:      solvedChals,
 		lastAccess:         lastAccessedT,
-		vpnKeys:            map[int]string{},
+		vpnProfiles:        map[int]VPNProfile{},
 		isLabAssigned:      false,
 		disabledChallenges: disabledChals,
 		allChallenges:      allChals,
@@ -381,32 +379,6 @@ func (t *Team) AddDisabledChal(parentTag string) {
 	}
 }
 
-// will be taken from amigo side
-func (t *Team) SetVPNConn(clientConfig []string) {
-	t.m.Lock()
-	t.vpnConf = clientConfig
-	t.m.Unlock()
-}
-
-func (t *Team) SetVPNKeys(id int, key string) {
-	t.m.Lock()
-	defer t.m.Unlock()
-
-	t.vpnKeys[id] = key
-}
-
-func (t *Team) GetVPNKeys() map[int]string {
-	t.m.RLock()
-	defer t.m.RUnlock()
-	return t.vpnKeys
-}
-
-func (t *Team) GetVPNConn() []string {
-	t.m.RLock()
-	defer t.m.RUnlock()
-	return t.vpnConf
-}
-
 func (t *Team) SetLabInfo(labSubnet string) {
 	t.m.Lock()
 	t.labSubnet = labSubnet
@@ -569,6 +541,30 @@ func (t *Team) UpdateTeamSolvedChallenges(chal TeamChallenge) error {
 	return nil
 }
 
+// Checkpoint returns the latest checkpoint of the lab of the team
+func (t *Team) Checkpoint() Checkpoint {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return t.checkpoint
+}
+
+// SetCheckpoint saves the checkpoint of the lab of the team on the DB
+func (t *Team) SetCheckpoint(c Checkpoint) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	_, err := t.dbc.UpdateTeamCheckpoint(context.Background(), &pbc.UpdateTeamCheckpointRequest{
+		TeamId:     t.id,
+		Checkpoint: c.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	t.checkpoint = c
+	return nil
+}
+
 func (t *Team) ID() string {
 	t.m.RLock()
 	id := t.id
@@ -3,6 +3,9 @@ package store
 import (
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/virtual"
 )
 
 func TestCopyMap(t *testing.T) {
@@ -28,3 +31,21 @@ func TestCopyMap(t *testing.T) {
 		t.Fatalf("Maps are not matching as expected, Expected: %v Actual: %v ", expected, m2)
 	}
 }
+
+func TestRecordUsage(t *testing.T) {
+	team := NewTeam("some@email.com", "some name", "password", "", "", "", time.Now(), nil, nil, nil)
+	if _, ok := team.Usage(); ok {
+		t.Fatalf("expected no usage before the first sample")
+	}
+
+	team.RecordUsage(virtual.Usage{CPUPercent: 50, MemoryBytes: 200}, time.Now())
+	team.RecordUsage(virtual.Usage{CPUPercent: 10, MemoryBytes: 300}, time.Now())
+
+	u, ok := team.Usage()
+	if !ok {
+		t.Fatalf("expected usage to be recorded")
+	}
+	if u.Last.CPUPercent != 10 || u.PeakCPUPercent != 50 || u.PeakMemory != 300 {
+		t.Fatalf("unexpected usage: %+v", u)
+	}
+}
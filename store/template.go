@@ -0,0 +1,291 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	TemplateExistsErr   = errors.New("Template already exists")
+	TemplateNotFoundErr = errors.New("Template not found")
+	NoTemplatesErr      = errors.New("No templates found")
+)
+
+// EventTemplate contains everything needed to create an
+// event except its tag and start and finish times
+type EventTemplate struct {
+	Name              string          `yaml:"name"`
+	Description       string          `yaml:"description,omitempty"`
+	EventName         string          `yaml:"event-name,omitempty"`
+	Frontends         []string        `yaml:"frontends,omitempty"`
+	Exercises         []string        `yaml:"exercises,omitempty"`
+	DisabledExercises []string        `yaml:"disabled-exercises,omitempty"`
+	Available         int             `yaml:"available,omitempty"`
+	Capacity          int             `yaml:"capacity,omitempty"`
+	OnlyVPN           int32           `yaml:"vpn,omitempty"` // 0 novpn 1 // vpn 2 // browser+vpn
+	SecretKey         string          `yaml:"secret-key,omitempty"`
+	IdlePolicy        IdlePolicy      `yaml:"idle-policy,omitempty"`
+	Backend           string          `yaml:"backend,omitempty"`
+	VPNProfiles       int             `yaml:"vpn-profiles,omitempty"`
+	Egress            EgressPolicy    `yaml:"egress,omitempty"`
+	IPv6              bool            `yaml:"ipv6,omitempty"`
+	PortForward       bool            `yaml:"port-forward,omitempty"`
+	TerminalExercises []string        `yaml:"terminal-exercises,omitempty"`
+	Recording         RecordingPolicy `yaml:"recording,omitempty"`
+	Capture           bool            `yaml:"capture,omitempty"`
+	CreatedBy         string          `yaml:"created-by,omitempty"`
+	CreatedAt         time.Time       `yaml:"created-at,omitempty"`
+	UpdatedAt         time.Time       `yaml:"updated-at,omitempty"`
+}
+
+func (et EventTemplate) Validate() error {
+	if err := Tag(et.Name).Validate(); err != nil {
+		return fmt.Errorf("invalid template name: %v", err)
+	}
+	if et.Available < 0 || et.Capacity < 0 {
+		return fmt.Errorf("available and capacity cannot be negative")
+	}
+	if et.Capacity > 0 && et.Available > et.Capacity {
+		return fmt.Errorf("available labs (%d) cannot exceed capacity (%d)", et.Available, et.Capacity)
+	}
+	if et.OnlyVPN < 0 || et.OnlyVPN > 2 {
+		return fmt.Errorf("unknown vpn mode %d", et.OnlyVPN)
+	}
+	for _, e := range append(append(et.Exercises, et.DisabledExercises...), et.TerminalExercises...) {
+		if err := Tag(e).Validate(); err != nil {
+			return err
+		}
+	}
+	switch et.Backend {
+	case "", DockerBackend, KubernetesBackend:
+	default:
+		return fmt.Errorf("unknown backend %q, use %q or %q", et.Backend, DockerBackend, KubernetesBackend)
+	}
+	if et.VPNProfiles < 0 || et.VPNProfiles > MaxVPNProfiles {
+		return fmt.Errorf("amount of VPN profiles should be between 1 and %d", MaxVPNProfiles)
+	}
+	if err := et.Egress.Validate(); err != nil {
+		return err
+	}
+	if err := et.Recording.Validate(); err != nil {
+		return err
+	}
+	return et.IdlePolicy.Validate()
+}
+
+// Export returns the template without information
+// on who created it and when
+func (et EventTemplate) Export() EventTemplate {
+	et.CreatedBy = ""
+	et.CreatedAt = time.Time{}
+	et.UpdatedAt = time.Time{}
+	return et
+}
+
+type templatesFile struct {
+	Templates []EventTemplate `yaml:"templates,omitempty"`
+}
+
+// MarshalTemplates returns templates in the format
+// of the templates file
+func MarshalTemplates(templates ...EventTemplate) ([]byte, error) {
+	return yaml.Marshal(templatesFile{Templates: templates})
+}
+
+// UnmarshalTemplates reads templates in the format of
+// the templates file or a single template
+func UnmarshalTemplates(b []byte) ([]EventTemplate, error) {
+	var file templatesFile
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return nil, err
+	}
+	if len(file.Templates) > 0 {
+		return file.Templates, nil
+	}
+
+	var et EventTemplate
+	if err := yaml.Unmarshal(b, &et); err != nil {
+		return nil, err
+	}
+	if et.Name == "" {
+		return nil, NoTemplatesErr
+	}
+
+	return []EventTemplate{et}, nil
+}
+
+type TemplateStore interface {
+	CreateTemplate(EventTemplate) error
+	UpdateTemplate(EventTemplate) error
+	DeleteTemplate(string) error
+	GetTemplate(string) (EventTemplate, error)
+	ListTemplates() []EventTemplate
+}
+
+type templatestore struct {
+	m         sync.RWMutex
+	templates map[string]EventTemplate
+	hooks     []func([]EventTemplate) error
+}
+
+func NewTemplateStore(templates []EventTemplate, hooks ...func([]EventTemplate) error) TemplateStore {
+	s := templatestore{
+		templates: map[string]EventTemplate{},
+		hooks:     hooks,
+	}
+
+	for _, t := range templates {
+		s.templates[t.Name] = t
+	}
+
+	return &s
+}
+
+func (ts *templatestore) CreateTemplate(t EventTemplate) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	ts.m.Lock()
+	defer ts.m.Unlock()
+
+	if _, ok := ts.templates[t.Name]; ok {
+		return TemplateExistsErr
+	}
+
+	t.CreatedAt = time.Now()
+	t.UpdatedAt = t.CreatedAt
+	ts.templates[t.Name] = t
+
+	return ts.runHooks()
+}
+
+// UpdateTemplate replaces an existing template while
+// keeping information on who created it
+func (ts *templatestore) UpdateTemplate(t EventTemplate) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	ts.m.Lock()
+	defer ts.m.Unlock()
+
+	existing, ok := ts.templates[t.Name]
+	if !ok {
+		return TemplateNotFoundErr
+	}
+
+	t.CreatedBy = existing.CreatedBy
+	t.CreatedAt = existing.CreatedAt
+	t.UpdatedAt = time.Now()
+	ts.templates[t.Name] = t
+
+	return ts.runHooks()
+}
+
+func (ts *templatestore) DeleteTemplate(name string) error {
+	ts.m.Lock()
+	defer ts.m.Unlock()
+
+	if _, ok := ts.templates[name]; !ok {
+		return TemplateNotFoundErr
+	}
+	delete(ts.templates, name)
+
+	return ts.runHooks()
+}
+
+func (ts *templatestore) GetTemplate(name string) (EventTemplate, error) {
+	ts.m.RLock()
+	defer ts.m.RUnlock()
+
+	t, ok := ts.templates[name]
+	if !ok {
+		return EventTemplate{}, TemplateNotFoundErr
+	}
+
+	return t, nil
+}
+
+// ListTemplates returns templates sorted by name
+func (ts *templatestore) ListTemplates() []EventTemplate {
+	ts.m.RLock()
+	defer ts.m.RUnlock()
+
+	return ts.list()
+}
+
+func (ts *templatestore) list() []EventTemplate {
+	templates := make([]EventTemplate, 0, len(ts.templates))
+	for _, t := range ts.templates {
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates
+}
+
+func (ts *templatestore) runHooks() error {
+	templates := ts.list()
+	for _, h := range ts.hooks {
+		if err := h(templates); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewTemplateFile(path string) (TemplateStore, error) {
+	var conf templatesFile
+
+	var m sync.Mutex
+	save := func() error {
+		m.Lock()
+		defer m.Unlock()
+
+		bytes, err := yaml.Marshal(conf)
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile(path, bytes, 0644)
+	}
+
+	// file exists
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		f, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		err = yaml.Unmarshal(f, &conf)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range conf.Templates {
+			if err := t.Validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return NewTemplateStore(conf.Templates, func(templates []EventTemplate) error {
+		conf.Templates = templates
+		return save()
+	}), nil
+}
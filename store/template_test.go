@@ -0,0 +1,163 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestTemplateStore(t *testing.T) {
+	ts := store.NewTemplateStore(nil)
+
+	tmpl := store.EventTemplate{
+		Name:      "course-intro",
+		EventName: "Introduction Course",
+		Frontends: []string{"kali"},
+		Exercises: []string{"scan", "sql"},
+		Available: 5,
+		Capacity:  10,
+		CreatedBy: "tkp",
+	}
+	if err := ts.CreateTemplate(tmpl); err != nil {
+		t.Fatalf("expected no error when creating template, got: %s", err)
+	}
+	if err := ts.CreateTemplate(tmpl); err != store.TemplateExistsErr {
+		t.Fatalf("expected template exists error, got: %v", err)
+	}
+
+	tmpl.Capacity = 20
+	tmpl.CreatedBy = "someone"
+	if err := ts.UpdateTemplate(tmpl); err != nil {
+		t.Fatalf("expected no error when updating template, got: %s", err)
+	}
+
+	got, err := ts.GetTemplate(tmpl.Name)
+	if err != nil {
+		t.Fatalf("expected no error when getting template, got: %s", err)
+	}
+	if got.Capacity != 20 {
+		t.Fatalf("expected updated capacity of 20, got: %d", got.Capacity)
+	}
+	if got.CreatedBy != "tkp" {
+		t.Fatalf("expected creator to be kept on update, got: %s", got.CreatedBy)
+	}
+
+	if err := ts.UpdateTemplate(store.EventTemplate{Name: "unknown"}); err != store.TemplateNotFoundErr {
+		t.Fatalf("expected template not found error, got: %v", err)
+	}
+	if err := ts.CreateTemplate(store.EventTemplate{Name: "Invalid Name"}); err == nil {
+		t.Fatalf("expected error on invalid template name")
+	}
+	if err := ts.CreateTemplate(store.EventTemplate{Name: "too-many", Available: 5, Capacity: 2}); err == nil {
+		t.Fatalf("expected error when available exceeds capacity")
+	}
+	if err := ts.CreateTemplate(store.EventTemplate{Name: "unknown-backend", Backend: "vmware"}); err == nil {
+		t.Fatalf("expected error on unknown backend")
+	}
+	if err := ts.CreateTemplate(store.EventTemplate{Name: "too-many-profiles", VPNProfiles: store.MaxVPNProfiles + 1}); err == nil {
+		t.Fatalf("expected error when exceeding the amount of VPN profiles")
+	}
+	if err := ts.CreateTemplate(store.EventTemplate{Name: "invalid-egress", Egress: store.EgressPolicy{Allow: []string{"not a domain"}}}); err == nil {
+		t.Fatalf("expected error on invalid egress policy")
+	}
+
+	if err := ts.DeleteTemplate(tmpl.Name); err != nil {
+		t.Fatalf("expected no error when deleting template, got: %s", err)
+	}
+	if n := len(ts.ListTemplates()); n != 0 {
+		t.Fatalf("expected no templates after delete, got: %d", n)
+	}
+}
+
+func TestMarshalTemplates(t *testing.T) {
+	templates := []store.EventTemplate{
+		{
+			Name:              "course-intro",
+			Frontends:         []string{"kali"},
+			Exercises:         []string{"scan"},
+			DisabledExercises: []string{"sql"},
+			Capacity:          10,
+			OnlyVPN:           2,
+			IdlePolicy: store.IdlePolicy{
+				Threshold: 2 * time.Hour,
+				Action:    store.IdleStop,
+			},
+			Backend:           store.KubernetesBackend,
+			VPNProfiles:       2,
+			Egress:            store.EgressPolicy{BlockInternet: true, Allow: []string{"github.com"}},
+			IPv6:              true,
+			PortForward:       true,
+			TerminalExercises: []string{"scan"},
+			Recording:         store.RecordingPolicy{Enabled: true, Retention: 30 * 24 * time.Hour},
+			Capture:           true,
+		},
+		{
+			Name:     "ctf",
+			Capacity: 50,
+		},
+	}
+
+	b, err := store.MarshalTemplates(templates...)
+	if err != nil {
+		t.Fatalf("expected no error when marshalling templates, got: %s", err)
+	}
+
+	got, err := store.UnmarshalTemplates(b)
+	if err != nil {
+		t.Fatalf("expected no error when unmarshalling templates, got: %s", err)
+	}
+	if !reflect.DeepEqual(got, templates) {
+		t.Fatalf("expected templates %+v, but got %+v", templates, got)
+	}
+
+	single := []byte(`
+name: single
+exercises: [scan, sql]
+idle-policy:
+  threshold: 30m
+`)
+	got, err = store.UnmarshalTemplates(single)
+	if err != nil {
+		t.Fatalf("expected no error when unmarshalling single template, got: %s", err)
+	}
+	if len(got) != 1 || got[0].Name != "single" || got[0].IdlePolicy.Threshold != 30*time.Minute {
+		t.Fatalf("unexpected single template: %+v", got)
+	}
+
+	if _, err := store.UnmarshalTemplates([]byte("capacity: 5")); err != store.NoTemplatesErr {
+		t.Fatalf("expected no templates error, got: %v", err)
+	}
+}
+
+func TestTemplateFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "templates.yml")
+	if err != nil {
+		t.Fatalf("expected no error when creating temporary file")
+	}
+	defer os.Remove(f.Name())
+
+	tf, err := store.NewTemplateFile(f.Name())
+	if err != nil {
+		t.Fatalf("expected no error when creating template file, got: %s", err)
+	}
+
+	if err := tf.CreateTemplate(store.EventTemplate{Name: "course-intro", Capacity: 10}); err != nil {
+		t.Fatalf("expected no error when storing template, got: %s", err)
+	}
+
+	tf, err = store.NewTemplateFile(f.Name())
+	if err != nil {
+		t.Fatalf("expected no error when reading template file, got: %s", err)
+	}
+	if _, err := tf.GetTemplate("course-intro"); err != nil {
+		t.Fatalf("expected template to be read from file, got: %s", err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"time"
+
+	"github.com/aau-network-security/haaukins/virtual"
+)
+
+// LabUsage is the resource consumption of the lab of a team
+type LabUsage struct {
+	Last           virtual.Usage
+	SampledAt      time.Time
+	PeakCPUPercent float64
+	PeakMemory     uint64
+}
+
+// RecordUsage stores a sample of the resources used by the lab of the team
+func (t *Team) RecordUsage(u virtual.Usage, at time.Time) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.usage == nil {
+		t.usage = &LabUsage{}
+	}
+	t.usage.Last = u
+	t.usage.SampledAt = at
+	if u.CPUPercent > t.usage.PeakCPUPercent {
+		t.usage.PeakCPUPercent = u.CPUPercent
+	}
+	if u.MemoryBytes > t.usage.PeakMemory {
+		t.usage.PeakMemory = u.MemoryBytes
+	}
+}
+
+// Usage returns the latest resource usage of the lab of the team
+func (t *Team) Usage() (LabUsage, bool) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	if t.usage == nil {
+		return LabUsage{}, false
+	}
+	return *t.usage, true
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	pbc "github.com/aau-network-security/haaukins/store/proto"
+)
+
+// VPNProfile is a wireguard peer of a team, the id is the slot
+// of the profile among the profiles the team is allowed to create
+type VPNProfile struct {
+	ID        int       `json:"id"`
+	PublicKey string    `json:"publicKey"`
+	KeyName   string    `json:"keyName"`
+	PeerIP    string    `json:"peerIP"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Config holds the private key of the peer,
+	// it is never saved to the database
+	Config string `json:"-"`
+}
+
+// SetVPNProfile saves the profile of the team on the DB
+func (t *Team) SetVPNProfile(p VPNProfile) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	profiles := copyVPNProfiles(t.vpnProfiles)
+	profiles[p.ID] = p
+	if err := t.saveVPNProfiles(profiles); err != nil {
+		return err
+	}
+
+	t.vpnProfiles = profiles
+	return nil
+}
+
+// RemoveVPNProfile removes the profile of the team from the DB
+func (t *Team) RemoveVPNProfile(id int) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	profiles := copyVPNProfiles(t.vpnProfiles)
+	delete(profiles, id)
+	if err := t.saveVPNProfiles(profiles); err != nil {
+		return err
+	}
+
+	t.vpnProfiles = profiles
+	return nil
+}
+
+func (t *Team) saveVPNProfiles(profiles map[int]VPNProfile) error {
+	_, err := t.dbc.UpdateTeamVPNProfiles(context.Background(), &pbc.UpdateTeamVPNProfilesRequest{
+		TeamId:      t.id,
+		VpnProfiles: VPNProfilesString(profiles),
+	})
+	return err
+}
+
+func (t *Team) GetVPNProfile(id int) (VPNProfile, bool) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	p, ok := t.vpnProfiles[id]
+	return p, ok
+}
+
+// GetVPNProfiles returns the created profiles of the team ordered by id
+func (t *Team) GetVPNProfiles() []VPNProfile {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	profiles := make([]VPNProfile, 0, len(t.vpnProfiles))
+	for _, p := range t.vpnProfiles {
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].ID < profiles[j].ID })
+
+	return profiles
+}
+
+func copyVPNProfiles(profiles map[int]VPNProfile) map[int]VPNProfile {
+	c := make(map[int]VPNProfile, len(profiles))
+	for id, p := range profiles {
+		c[id] = p
+	}
+	return c
+}
+
+// VPNProfilesString returns the profiles in the format they are saved to the database
+func VPNProfilesString(profiles map[int]VPNProfile) string {
+	if len(profiles) == 0 {
+		return ""
+	}
+	list := make([]VPNProfile, 0, len(profiles))
+	for _, p := range profiles {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	b, _ := json.Marshal(list)
+	return string(b)
+}
+
+// ParseVPNProfiles parses the profiles of a team from the
+// database, an empty string is parsed into no profiles
+func ParseVPNProfiles(s string) (map[int]VPNProfile, error) {
+	profiles := map[int]VPNProfile{}
+	if s == "" {
+		return profiles, nil
+	}
+	var list []VPNProfile
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return profiles, err
+	}
+	for _, p := range list {
+		profiles[p.ID] = p
+	}
+	return profiles, nil
+}
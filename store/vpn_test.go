@@ -0,0 +1,37 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseVPNProfiles(t *testing.T) {
+	createdAt := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	profiles := map[int]VPNProfile{
+		0: {ID: 0, PublicKey: "pub0", KeyName: "ev_team_10_20_240_2", PeerIP: "10.20.240.2/32", CreatedAt: createdAt},
+		2: {ID: 2, PublicKey: "pub2", KeyName: "ev_team_10_20_240_3", PeerIP: "10.20.240.3/32", CreatedAt: createdAt},
+	}
+
+	s := VPNProfilesString(profiles)
+	got, err := ParseVPNProfiles(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, profiles) {
+		t.Fatalf("expected profiles %+v, got %+v", profiles, got)
+	}
+
+	withConfig := map[int]VPNProfile{0: {ID: 0, Config: "PrivateKey = secret"}}
+	if got, _ := ParseVPNProfiles(VPNProfilesString(withConfig)); got[0].Config != "" {
+		t.Fatalf("expected configuration not to be saved")
+	}
+
+	if got, err := ParseVPNProfiles(""); err != nil || len(got) != 0 {
+		t.Fatalf("expected no profiles, got %v (%v)", got, err)
+	}
+}
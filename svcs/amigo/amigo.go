@@ -1,7 +1,6 @@
 package amigo
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,7 +9,6 @@ import (
 	"net/http"
 	"os"
 	"regexp"
-	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -43,6 +41,8 @@ var (
 	ErrEmailToLarge         = errors.New("Email is NOT within the defined character limit")
 	ErrEmailCharacters      = errors.New("Non alphabetic characters are NOT allowed in email address such as - , { [ _   ")
 	ErrProtectedEvent       = errors.New("UNABLE TO SIGNUP: WRONG SECRET KEY FOR PROTECTED EVENT ! \n ASK EVENT ADMINISTRATOR FOR SECRET KEY FOR THIS EVENT ! ")
+	ErrTeamQueued           = errors.New("No available labs, team is waiting in the queue")
+	ErrMissingAssets        = errors.New("vendored assets are missing, run scripts/vendor-assets.sh")
 	teamNameRegex           = "^[A-Za-z0-9]+$"
 	emailRegex              = "^[a-zA-Z0-9.!#$%&'*+^\\{|}~]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$"
 	wd                      = GetWd()
@@ -55,6 +55,9 @@ var (
 type Hosts struct {
 	Domain string
 	IP     string
+	// Network is the lab network or the segment
+	// of an exercise which the host is attached to
+	Network string
 }
 
 type siteInfo struct {
@@ -68,6 +71,12 @@ type siteInfo struct {
 	Notification  Notification
 	LabSubnet     string
 	SignupPaused  bool // this is used when add challenge is executed in the platform
+	// CaptureDownload shows the download of the traffic capture of the lab
+	CaptureDownload bool
+	// Services forwarded from the lab of the team
+	Services []ForwardedService
+	// Terminal shows the terminal of the lab in the browser
+	Terminal bool
 }
 
 type team struct {
@@ -91,6 +100,9 @@ type Amigo struct {
 	wgClient     wg.WireguardClient
 	notification Notification
 	FrontEndData *FrontendData
+	// queueUpdatePeriod is the period of sending the
+	// status of the waiting queue to waiting teams
+	queueUpdatePeriod time.Duration
 }
 
 type AmigoOpt func(*Amigo)
@@ -101,6 +113,12 @@ func WithMaxReadBytes(b int64) AmigoOpt {
 	}
 }
 
+func WithQueueUpdatePeriod(d time.Duration) AmigoOpt {
+	return func(am *Amigo) {
+		am.queueUpdatePeriod = d
+	}
+}
+
 func WithEventName(eventName string) AmigoOpt {
 	return func(am *Amigo) {
 		am.globalInfo.EventName = eventName
@@ -120,8 +138,9 @@ func NewAmigo(ts store.Event, chals []store.ChildrenChalConfig, reCaptchaKey str
 			EventSecret:   ts.SecretKey,
 			IsSecretEvent: ts.SecretKey != "",
 		},
-		recaptcha: NewRecaptcha(reCaptchaKey),
-		wgClient:  wgClient,
+		recaptcha:         NewRecaptcha(reCaptchaKey),
+		wgClient:          wgClient,
+		queueUpdatePeriod: defaultQueueUpdatePeriod,
 	}
 
 	for _, opt := range opts {
@@ -162,19 +181,34 @@ type Hooks struct {
 	StartStopExercise func(t *store.Team, challengeTag string, state bool) error
 	ResetFrontend     func(t *store.Team) error
 	ResumeTeamLab     func(t *store.Team) error
+	QueueStatus       func(t *store.Team) (int, time.Duration)
+	Snapshots         SnapshotHooks
+	VPN               VPNHooks
+	Connections       func(t *store.Team) []LabConnection
+	// Capture writes the traffic capture of the lab of the team,
+	// teams cannot download their capture when nil
+	Capture func(t *store.Team, w io.Writer) error
+	// Services returns the services forwarded from the lab of the
+	// team, services are not forwarded for the event when nil
+	Services func(t *store.Team) []ForwardedService
+	// Terminal opens a shell in the attacker container of the lab
+	// of the team, the event has no terminal when nil
+	Terminal func(t *store.Team) (TerminalSession, error)
 }
 
 func (am *Amigo) Handler(hooks Hooks, guacHandler http.Handler) http.Handler {
 	fd := NewFrontendData(am.TeamStore, am.challenges...)
 	go fd.RunFrontendData()
 	am.FrontEndData = fd
+	am.globalInfo.CaptureDownload = hooks.Capture != nil
+	am.globalInfo.Terminal = hooks.Terminal != nil
 	m := http.NewServeMux()
 
 	m.HandleFunc("/", am.handleIndex())
 	m.HandleFunc("/info", am.handleInfo())
 	m.HandleFunc("/challenges", am.handleChallenges())
 	m.HandleFunc("/teams", am.handleTeams())
-	m.HandleFunc("/hosts", am.handleHostsInformation())
+	m.HandleFunc("/hosts", am.handleHostsInformation(hooks.Services))
 	m.HandleFunc("/scoreboard", am.handleScoreBoard())
 	m.HandleFunc("/signup", am.handleSignup(hooks.AssignLab))
 	m.HandleFunc("/login", am.handleLogin(hooks.ResumeTeamLab))
@@ -185,11 +219,29 @@ func (am *Amigo) Handler(hooks Hooks, guacHandler http.Handler) http.Handler {
 	m.HandleFunc("/reset/challenge", am.handleResetChallenge(hooks.ResetExercise))
 	m.HandleFunc("/manage/challenge", am.handleStartStopChallenge(hooks.StartStopExercise))
 	m.HandleFunc("/reset/frontend", am.handleResetFrontend(hooks.ResetFrontend))
+	m.HandleFunc("/snapshots", am.handleListSnapshots(hooks.Snapshots.List))
+	m.HandleFunc("/snapshots/take", am.handleSnapshot(hooks.Snapshots.Take))
+	m.HandleFunc("/snapshots/restore", am.handleSnapshot(hooks.Snapshots.Restore))
+	m.HandleFunc("/snapshots/delete", am.handleSnapshot(hooks.Snapshots.Delete))
+	m.HandleFunc("/capture/download", am.handleCaptureDownload(hooks.Capture))
 	m.HandleFunc("/vpn/status", am.handleVPNStatus(hooks.AssignLab))
 	m.HandleFunc("/vpn/download", am.handleVPNFiles())
+	m.HandleFunc("/vpn/create", am.handleVPNProfile(hooks.VPN.Create))
+	m.HandleFunc("/vpn/revoke", am.handleVPNProfile(hooks.VPN.Revoke))
+	m.HandleFunc("/vpn/regenerate", am.handleVPNProfile(hooks.VPN.Regenerate))
 	m.HandleFunc("/get/labsubnet", am.handleLabInfo())
+	m.HandleFunc("/waiting", am.handleWaiting())
+	m.HandleFunc("/waiting/status", am.handleWaitingStatus(hooks.QueueStatus))
+	if hooks.Services != nil {
+		m.HandleFunc("/forward", am.handleForward())
+	}
+	if hooks.Terminal != nil {
+		m.HandleFunc("/terminal", am.handleTerminal())
+		m.HandleFunc("/terminal/session", am.handleTerminalSession(hooks.Terminal))
+	}
 	if am.TeamStore.OnlyVPN == 0 || am.TeamStore.OnlyVPN == 2 {
-		m.Handle("/guaclogin", am.handleGuacConnection(hooks.AssignLab, guacHandler))
+		m.Handle("/guaclogin", am.handleGuacConnection(hooks.AssignLab, hooks.ResumeTeamLab, guacHandler))
+		m.HandleFunc("/connections", am.handleConnections(hooks.Connections))
 		m.Handle("/guacamole", guacHandler)
 		m.Handle("/guacamole/", guacHandler)
 	}
@@ -223,7 +275,7 @@ func (am *Amigo) handleIndex() http.HandlerFunc {
 	}
 }
 
-func (am *Amigo) handleHostsInformation() http.HandlerFunc {
+func (am *Amigo) handleHostsInformation(services func(t *store.Team) []ForwardedService) http.HandlerFunc {
 	type data struct {
 		hostinfo string
 	}
@@ -251,11 +303,13 @@ func (am *Amigo) handleHostsInformation() http.HandlerFunc {
 		var hostInfo []Hosts
 		if hosts != nil {
 			for _, r := range hosts {
-				record := strings.Split(r, "\t")
-				hostInfo = append(hostInfo, Hosts{IP: record[0], Domain: record[1]})
+				hostInfo = append(hostInfo, parseHost(r))
 			}
 			data.Hosts = hostInfo
 		}
+		if services != nil {
+			data.Services = services(t)
+		}
 		if err := tmpl.Execute(w, data); err != nil {
 			log.Println("template err index: ", err)
 		}
@@ -263,7 +317,22 @@ func (am *Amigo) handleHostsInformation() http.HandlerFunc {
 
 }
 
-func (am *Amigo) handleGuacConnection(hook func(t *store.Team) error, next http.Handler) http.HandlerFunc {
+// parseHost parses a hosts record of a team, records
+// without a network are hosts on the lab network
+func parseHost(r string) Hosts {
+	record := strings.Split(r, "\t")
+	h := Hosts{IP: record[0], Network: store.LabNetwork}
+	if len(record) > 1 {
+		h.Domain = record[1]
+	}
+	if len(record) > 2 {
+		h.Network = strings.TrimSpace(record[2])
+	}
+
+	return h
+}
+
+func (am *Amigo) handleGuacConnection(hook func(t *store.Team) error, resumeLabHook func(t *store.Team) error, next http.Handler) http.HandlerFunc {
 
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
@@ -274,6 +343,10 @@ func (am *Amigo) handleGuacConnection(hook func(t *store.Team) error, next http.
 		}
 		if !team.IsLabAssigned() {
 			if err := hook(team); err != nil {
+				if err == ErrTeamQueued {
+					http.Redirect(w, r, "/waiting", http.StatusSeeOther)
+					return
+				}
 				w.WriteHeader(http.StatusServiceUnavailable)
 				w.Header().Set("Content-Type", "text/html; charset=utf-8")
 				w.Write([]byte(waitingHTMLTemplate))
@@ -281,10 +354,51 @@ func (am *Amigo) handleGuacConnection(hook func(t *store.Team) error, next http.
 			}
 		}
 
+		// lab might have been suspended while the team was idle
+		if team.IsIdle() {
+			team.MarkActive(store.GuacamoleActivity)
+			if err := resumeLabHook(team); err != nil {
+				logger.Error().Str("Team id: ", team.ID()).
+					Msgf("Error on resuming team resource %v", err)
+			}
+		}
+
 		next.ServeHTTP(w, r)
 	}
 }
 
+func (am *Amigo) handleWaiting() http.HandlerFunc {
+	waitingTemplate := wd + "/svcs/amigo/resources/private/waiting.tmpl.html"
+	tmpl, err := parseTemplates(waitingTemplate)
+	if err != nil {
+		log.Println("error waiting tmpl: ", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		if r.URL.Path != "/waiting" {
+			http.NotFound(w, r)
+			return
+		}
+
+		t, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+			return
+		}
+
+		if t.IsLabAssigned() {
+			http.Redirect(w, r, "/", http.StatusSeeOther)
+			return
+		}
+
+		data := am.getSiteInfo(w, r)
+		if err := tmpl.Execute(w, data); err != nil {
+			log.Println("template err waiting: ", err)
+		}
+	}
+}
+
 func (am *Amigo) handleInfo() http.HandlerFunc {
 	infoTemplate := wd + "/svcs/amigo/resources/private/info.tmpl.html"
 	tmpl, err := parseTemplates(infoTemplate)
@@ -308,12 +422,15 @@ func (am *Amigo) handleInfo() http.HandlerFunc {
 		var ip string
 		data.LabSubnet = "LAB IS NOT ASSIGNED YET"
 		if len(hosts) != 0 {
+			// hosts on segments of exercises are not in the lab subnet
 			for _, r := range hosts {
-				record := strings.Split(r, "\t")
-				ip = record[0]
-				break
+				h := parseHost(r)
+				ip = h.IP
+				if h.Network == store.LabNetwork {
+					break
+				}
 			}
-			ipParts := strings.Split(ip, ".")
+			ipParts := strings.Split(strings.TrimSpace(ip), ".")
 			data.LabSubnet = fmt.Sprintf("%s.%s.%s.%s", ipParts[0], ipParts[1], ipParts[2], "0/24")
 		}
 
@@ -350,120 +467,6 @@ func (am *Amigo) handleChallenges() http.HandlerFunc {
 	}
 }
 
-func (am *Amigo) handleVPNStatus(hook func(t *store.Team) error) http.HandlerFunc {
-	// data to be sent
-	type vpnStatus struct {
-		VPNConfID string `json:"vpnConnID"`
-		Status    string `json:"status"` // this could be returned to stream
-	}
-	ctx := context.Background()
-
-	endpoint := func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
-		team, err := am.getTeamFromRequest(w, r)
-		if err != nil {
-			replyJsonRequestErr(w, err)
-			return
-		}
-
-		if !team.IsLabAssigned() {
-			if err := hook(team); err != nil {
-				w.WriteHeader(http.StatusServiceUnavailable)
-				w.Header().Set("Content-Type", "text/html; charset=utf-8")
-				return
-			}
-		}
-
-		vpnConfig := team.GetVPNConn()
-		teamVPNKeys := team.GetVPNKeys()
-
-		if len(vpnConfig) == 0 {
-			replyJsonRequestErr(w, fmt.Errorf("Error, no vpn information found on on team err %v", err))
-		}
-		//eventTag := string(am.TeamStore.Tag)
-		var listOfStatus []vpnStatus
-		// status of vpn should be retrieved from wg client. for PoC it is ok to write ok.
-
-		for i, _ := range vpnConfig {
-			id := fmt.Sprintf("conn")
-			resp, err := am.wgClient.GetPeerStatus(ctx, &wg.PeerStatusReq{
-				NicName:   string(am.TeamStore.Tag),
-				PublicKey: teamVPNKeys[i],
-			})
-			status := vpnStatus{VPNConfID: id + "_" + strconv.Itoa(i), Status: "N/U"}
-			if err != nil {
-				log.Printf("Error on retrieving back information from wg %s", err.Error())
-				replyJsonRequestErr(w, err)
-				return
-			}
-			if resp.Status {
-				status.Status = "USED"
-			}
-			listOfStatus = append(listOfStatus, status)
-
-		}
-
-		replyJson(http.StatusOK, w, listOfStatus)
-	}
-	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
-		endpoint = mw(endpoint)
-	}
-
-	return endpoint
-}
-
-// handleVPNFiles will give chance to download their configuration files
-func (am *Amigo) handleVPNFiles() http.HandlerFunc {
-
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
-		type vpnStatus struct {
-			VPNConfID string `json:"vpnConnID"`
-			Status    string `json:"status"` // this could be returned to stream
-		}
-		team, err := am.getTeamFromRequest(w, r)
-		if err != nil {
-			replyJsonRequestErr(w, err)
-			return
-		}
-		vpnConfig := team.GetVPNConn()
-		if len(vpnConfig) == 0 {
-			replyJsonRequestErr(w, fmt.Errorf("Error, no vpn information found on on team err %v", err))
-		}
-		var vpnConn vpnStatus
-		if err := safeReadJson(w, r, &vpnConn, am.maxReadBytes); err != nil {
-			replyJsonRequestErr(w, err)
-			return
-		}
-
-		confID, err := strconv.Atoi(strings.Split(vpnConn.VPNConfID, "_")[1])
-		if err != nil {
-			replyJsonRequestErr(w, err)
-		}
-
-		//log.Printf("Trunced conf id %d", confID)
-		writeConfig := func(id int) {
-			w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
-			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fmt.Sprintf("conn_%d.conf", id)))
-			b := strings.NewReader(vpnConfig[id])
-			//stream the body to the client without fully loading it into memory
-			io.Copy(w, b)
-		}
-
-		switch confID {
-		case 0:
-			writeConfig(0)
-		case 1:
-			writeConfig(1)
-		case 2:
-			writeConfig(2)
-		case 3:
-			writeConfig(3)
-		}
-
-	}
-}
-
 func (am *Amigo) handleTeams() http.HandlerFunc {
 	teamsTemplate := wd + "/svcs/amigo/resources/private/teams.tmpl.html"
 	tmpl, err := parseTemplates(teamsTemplate)
@@ -536,6 +539,7 @@ func (am *Amigo) handleFlagVerify(stopExercise func(t *store.Team, challengeTag
 			replyJsonRequestErr(w, err)
 			return
 		}
+		team.MarkActive(store.FlagActivity)
 
 		flag := strings.TrimSpace(msg.Flag)
 		parentTag := getParentChallengeTag(msg.Tag)
@@ -705,10 +709,6 @@ func (am *Amigo) handleSignupPOST(hook func(t *store.Team) error) http.HandlerFu
 			return
 		}
 
-		if err := am.loginTeam(w, r, t); err != nil {
-			displayErr(w, params, err)
-			return
-		}
 		token, err := store.GetTokenForTeam(am.signingKey, t)
 		if err != nil {
 			logger.Debug().Msgf("Error on getting token from amigo %s", token)
@@ -720,8 +720,20 @@ func (am *Amigo) handleSignupPOST(hook func(t *store.Team) error) http.HandlerFu
 			return
 		}
 
+		redirect := "/"
 		if err := hook(t); err != nil { // assigning lab
-			logger.Debug().Msgf("Problem in assing lab !! %s ", err)
+			if err == ErrTeamQueued {
+				// team is created, lab will be assigned
+				// when it is produced by the lab hub
+				redirect = "/waiting"
+			} else {
+				logger.Debug().Msgf("Problem in assing lab !! %s ", err)
+			}
+		}
+
+		if err := am.loginTeam(w, r, t, redirect); err != nil {
+			displayErr(w, params, err)
+			return
 		}
 	}
 }
@@ -967,7 +979,7 @@ func (am *Amigo) handleLoginPOST(resumeLabHook func(t *store.Team) error) http.H
 			return
 		}
 
-		if err := am.loginTeam(w, r, t); err != nil {
+		if err := am.loginTeam(w, r, t, "/"); err != nil {
 			displayErr(w, params, err)
 			return
 		}
@@ -988,16 +1000,17 @@ func (am *Amigo) handleLogout() http.HandlerFunc {
 	}
 }
 
-func (am *Amigo) loginTeam(w http.ResponseWriter, r *http.Request, t *store.Team) error {
+func (am *Amigo) loginTeam(w http.ResponseWriter, r *http.Request, t *store.Team, redirect string) error {
 	token, err := store.GetTokenForTeam(am.signingKey, t)
 	if err != nil {
 		return err
 	}
 	w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
 	http.SetCookie(w, &http.Cookie{Name: "session", Value: token, MaxAge: am.cookieTTL})
-	http.Redirect(w, r, "/", http.StatusSeeOther)
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
 
 	//Set teams last access time
+	t.MarkActive(store.LoginActivity)
 	err = t.UpdateTeamAccessed(time.Now())
 	if err != nil {
 		logger.Warn().
@@ -1154,6 +1167,32 @@ func GetWd() string {
 	return path
 }
 
+// vendoredAssets are the libraries of the pages which are
+// vendored into the public assets by scripts/vendor-assets.sh
+var vendoredAssets = []string{
+	"vendor/xterm/xterm.css",
+	"vendor/xterm/xterm.js",
+	"vendor/xterm-addon-fit/xterm-addon-fit.js",
+	// the replay page of recordings served by the daemon
+	"vendor/guacamole-common-js/guacamole-common.js",
+}
+
+// CheckVendoredAssets returns an error naming the
+// vendored assets missing from the public assets
+func CheckVendoredAssets() error {
+	var missing []string
+	for _, a := range vendoredAssets {
+		if _, err := os.Stat(wd + "/svcs/amigo/resources/public/" + a); err != nil {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%v: %s", ErrMissingAssets, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
 func parseTemplates(givenTemplate string) (*template.Template, error) {
 	var tmpl *template.Template
 	var err error
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -211,3 +212,32 @@ func Test_checkTeamName(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckVendoredAssets(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	prev := wd
+	wd = tmp
+	defer func() { wd = prev }()
+
+	if err := CheckVendoredAssets(); err == nil {
+		t.Fatalf("expected error when vendored assets are missing")
+	}
+
+	for _, a := range vendoredAssets {
+		path := tmp + "/svcs/amigo/resources/public/" + a
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unable to create directory of asset: %v", err)
+		}
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatalf("unable to create asset: %v", err)
+		}
+	}
+	if err := CheckVendoredAssets(); err != nil {
+		t.Fatalf("expected no error when vendored assets exist, got: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrCaptureUnavailable = errors.New("Traffic capture is not available for this event")
+)
+
+// captureResponse sets the headers of the download on the first
+// write, such that errors before the capture is sent are replied
+type captureResponse struct {
+	w       http.ResponseWriter
+	name    string
+	started bool
+}
+
+func (cr *captureResponse) Write(p []byte) (int, error) {
+	if !cr.started {
+		cr.started = true
+		cr.w.Header().Set("Content-Type", "application/gzip")
+		cr.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", cr.name))
+	}
+	return cr.w.Write(p)
+}
+
+// handleCaptureDownload sends the captured traffic of the lab of
+// the team as a gzipped tarball of pcap files, the hook writes
+// the capture
+func (am *Amigo) handleCaptureDownload(captureHook func(t *store.Team, w io.Writer) error) http.HandlerFunc {
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if captureHook == nil {
+			replyJsonRequestErr(w, ErrCaptureUnavailable)
+			return
+		}
+		if !team.IsLabAssigned() {
+			replyJsonRequestErr(w, fmt.Errorf("Lab is NOT assigned to team [ %s ] on event [ %s ]", team.Name(), am.TeamStore.Tag))
+			return
+		}
+
+		cr := &captureResponse{
+			w:    w,
+			name: fmt.Sprintf("capture-%s-%s.tar.gz", am.TeamStore.Tag, time.Now().Format("20060102-150405")),
+		}
+		// nothing is written before the capture is known to exist
+		if err := captureHook(team, cr); err != nil {
+			if !cr.started {
+				replyJsonRequestErr(w, err)
+				return
+			}
+			log.Warn().Str("team", team.ID()).Msgf("Unable to send capture: %v", err)
+		}
+	}
+
+	return GETEndpoint(endpoint)
+}
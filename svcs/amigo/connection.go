@@ -0,0 +1,48 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"net/http"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+// LabConnection is a guacamole connection of a team,
+// shown as a tab on the lab page
+type LabConnection struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+}
+
+func (am *Amigo) handleConnections(connHook func(t *store.Team) []LabConnection) http.HandlerFunc {
+
+	type replyMsg struct {
+		Connections []LabConnection `json:"connections"`
+	}
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if connHook == nil || !team.IsLabAssigned() {
+			replyJson(http.StatusOK, w, replyMsg{})
+			return
+		}
+
+		replyJson(http.StatusOK, w, replyMsg{connHook(team)})
+	}
+
+	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
+		endpoint = mw(endpoint)
+	}
+
+	return endpoint
+}
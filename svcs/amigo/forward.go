@@ -0,0 +1,231 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	jwt "github.com/golang-jwt/jwt/v4"
+	logger "github.com/rs/zerolog/log"
+)
+
+const (
+	// forwardCookie keeps the session of a team on the
+	// subdomain of a forwarded service, it is not passed
+	// to the service itself
+	forwardCookie   = "hkn_forward"
+	forwardAuthPath = "/.haaukins/auth"
+	forwardHostKey  = "H"
+	// forwardTokenTTL bounds the use of the token
+	// handed to the subdomain in the redirect
+	forwardTokenTTL = time.Minute
+)
+
+var (
+	ErrForwardHost    = errors.New("host is not a forwarded service of a team")
+	ErrForwardSession = errors.New("session does not belong to the team of the service")
+)
+
+// ForwardedService is a service of the lab of a team
+// which is forwarded by the daemon
+type ForwardedService struct {
+	Name     string
+	Protocol string
+	// Address is the host and port of a TCP service,
+	// HTTP services are served on the subdomain of the team
+	Address string
+}
+
+// handleForward hands the session of the team to the subdomain
+// of a forwarded HTTP service, e.g. web1.<team>.<event>.<host>
+func (am *Amigo) handleForward() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		t, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+			return
+		}
+
+		service := r.URL.Query().Get("service")
+		if service == "" || strings.ContainsAny(service, "./:") {
+			http.NotFound(w, r)
+			return
+		}
+
+		host := fmt.Sprintf("%s.%s.%s", service, t.ID(), r.Host)
+		token, err := am.forwardToken(t.ID(), host, forwardTokenTTL)
+		if err != nil {
+			logger.Warn().Str("team", t.ID()).Msgf("Unable to create token of forwarded service: %v", err)
+			http.Error(w, "unable to reach service", http.StatusInternalServerError)
+			return
+		}
+
+		u := url.URL{
+			Scheme:   scheme(r),
+			Host:     host,
+			Path:     forwardAuthPath,
+			RawQuery: url.Values{"token": {token}, "path": {localPath(r.URL.Query().Get("path"))}}.Encode(),
+		}
+		http.Redirect(w, r, u.String(), http.StatusSeeOther)
+	}
+}
+
+// ForwardHandler authenticates the requests to the subdomains of forwarded
+// HTTP services, which are passed to the handler of the service given by
+// the lookup when the session belongs to the team of the subdomain
+func (am *Amigo) ForwardHandler(lookup func(t *store.Team, service string) (http.Handler, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.SplitN(r.Host, ".", 3)
+		if len(parts) != 3 {
+			http.Error(w, ErrForwardHost.Error(), http.StatusNotFound)
+			return
+		}
+		service, teamID, eventHost := parts[0], parts[1], parts[2]
+
+		if r.URL.Path == forwardAuthPath {
+			am.handleForwardAuth(w, r, teamID)
+			return
+		}
+
+		t, err := am.getForwardTeam(r, teamID)
+		if err != nil {
+			// the session is handed over by amigo of the event
+			u := url.URL{
+				Scheme:   scheme(r),
+				Host:     eventHost,
+				Path:     "/forward",
+				RawQuery: url.Values{"service": {service}, "path": {r.URL.RequestURI()}}.Encode(),
+			}
+			http.Redirect(w, r, u.String(), http.StatusSeeOther)
+			return
+		}
+
+		h, err := lookup(t, service)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		removeCookie(r, forwardCookie)
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (am *Amigo) handleForwardAuth(w http.ResponseWriter, r *http.Request, teamID string) {
+	id, err := am.forwardTeam(r.URL.Query().Get("token"), r.Host)
+	if err != nil || id != teamID {
+		http.Error(w, ErrForwardSession.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	token, err := am.forwardToken(id, r.Host, time.Duration(am.cookieTTL)*time.Second)
+	if err != nil {
+		http.Error(w, "unable to create session", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     forwardCookie,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   am.cookieTTL,
+		HttpOnly: true,
+	})
+	http.Redirect(w, r, localPath(r.URL.Query().Get("path")), http.StatusSeeOther)
+}
+
+func (am *Amigo) getForwardTeam(r *http.Request, teamID string) (*store.Team, error) {
+	c, err := r.Cookie(forwardCookie)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	id, err := am.forwardTeam(c.Value, r.Host)
+	if err != nil {
+		return nil, err
+	}
+	if id != teamID {
+		return nil, ErrForwardSession
+	}
+
+	return am.TeamStore.GetTeamByID(id)
+}
+
+// forwardToken returns a token of the team which
+// is only valid on the host of a forwarded service
+func (am *Amigo) forwardToken(teamID, host string, ttl time.Duration) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		ID_KEY:         teamID,
+		forwardHostKey: host,
+		"exp":          time.Now().Add(ttl).Unix(),
+	})
+
+	return token.SignedString(am.signingKey)
+}
+
+// forwardTeam returns the team of a token of a forwarded service
+func (am *Amigo) forwardTeam(token, host string) (string, error) {
+	jwtToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return am.signingKey, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok || !jwtToken.Valid {
+		return "", ErrInvalidTokenFormat
+	}
+
+	if h, _ := claims[forwardHostKey].(string); h != host {
+		return "", ErrForwardSession
+	}
+
+	id, ok := claims[ID_KEY].(string)
+	if !ok {
+		return "", ErrInvalidTokenFormat
+	}
+
+	return id, nil
+}
+
+// removeCookie drops a cookie from the request
+func removeCookie(r *http.Request, name string) {
+	cookies := r.Cookies()
+	r.Header.Del("Cookie")
+	for _, c := range cookies {
+		if c.Name != name {
+			r.AddCookie(c)
+		}
+	}
+}
+
+// localPath only allows redirects within the same host
+func localPath(p string) string {
+	if !strings.HasPrefix(p, "/") || strings.HasPrefix(p, "//") || strings.HasPrefix(p, "/\\") {
+		return "/"
+	}
+
+	return p
+}
+
+func scheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+
+	return "http"
+}
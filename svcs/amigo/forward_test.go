@@ -0,0 +1,143 @@
+package amigo
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	pb "github.com/aau-network-security/haaukins/store/proto"
+	mockserver "github.com/aau-network-security/haaukins/testing"
+	"google.golang.org/grpc"
+)
+
+func TestForwardHandler(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dialer, close := mockserver.Create()
+	defer close()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewStoreClient(conn)
+
+	ts, err := store.NewEventStore(store.EventConfig{Name: "Test Event", Tag: "test"}, tmp, client)
+	if err != nil {
+		t.Fatalf("unable to create event store: %v", err)
+	}
+	team := store.NewTeam("some@email.com", "somename", "password",
+		"", "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, client)
+	if err := ts.SaveTeam(team); err != nil {
+		t.Fatalf("unable to save team: %v", err)
+	}
+
+	am := NewAmigo(ts, nil, "", nil)
+	var service string
+	handler := am.ForwardHandler(func(t *store.Team, s string) (http.Handler, error) {
+		service = s
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := r.Cookie(forwardCookie); err == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(t.ID()))
+		}), nil
+	})
+
+	host := "web1." + team.ID() + ".test.localhost"
+	serve := func(h http.Handler, target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", target, nil)
+		for _, c := range cookies {
+			r.AddCookie(c)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	location := func(w *httptest.ResponseRecorder) *url.URL {
+		if w.Code != http.StatusSeeOther {
+			t.Fatalf("expected redirect, got status %d", w.Code)
+		}
+		u, err := url.Parse(w.Header().Get("Location"))
+		if err != nil {
+			t.Fatalf("invalid redirect: %v", err)
+		}
+		return u
+	}
+
+	// requests without session are redirected to amigo of the event
+	u := location(serve(handler, "http://"+host+"/page?q=1"))
+	if u.Host != "test.localhost" || u.Path != "/forward" || u.Query().Get("service") != "web1" || u.Query().Get("path") != "/page?q=1" {
+		t.Fatalf("unexpected redirect to amigo: %s", u)
+	}
+
+	token, err := store.GetTokenForTeam(am.signingKey, team)
+	if err != nil {
+		t.Fatalf("unable to create session token: %v", err)
+	}
+	session := &http.Cookie{Name: "session", Value: token}
+	u = location(serve(am.handleForward(), u.String(), session))
+	if u.Host != host || u.Path != forwardAuthPath {
+		t.Fatalf("unexpected redirect to service: %s", u)
+	}
+
+	// token of the team is only valid on its own subdomain
+	other := "web1.otherteam.test.localhost"
+	if w := serve(handler, "http://"+other+u.RequestURI()); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected token to be rejected on other host, got status %d", w.Code)
+	}
+
+	w := serve(handler, u.String())
+	if p := location(w); p.String() != "/page?q=1" {
+		t.Fatalf("expected redirect to requested page, got %s", p)
+	}
+	var cookie *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == forwardCookie {
+			cookie = c
+		}
+	}
+	if cookie == nil {
+		t.Fatalf("expected session cookie of forwarded service")
+	}
+
+	w = serve(handler, "http://"+host+"/page", cookie)
+	if w.Code != http.StatusOK || w.Body.String() != team.ID() || service != "web1" {
+		t.Fatalf("expected request to be forwarded to web1 of team, got status %d (%s)", w.Code, w.Body.String())
+	}
+
+	// the cookie is bound to the subdomain of the service
+	if w := serve(handler, "http://web2."+team.ID()+".test.localhost/", cookie); w.Code != http.StatusSeeOther {
+		t.Fatalf("expected cookie to be rejected on other service, got status %d", w.Code)
+	}
+}
+
+func TestLocalPath(t *testing.T) {
+	tt := map[string]string{
+		"/page?q=1":        "/page?q=1",
+		"":                 "/",
+		"//evil.com":       "/",
+		"/\\evil.com":      "/",
+		"https://evil.com": "/",
+	}
+	for p, expected := range tt {
+		if res := localPath(p); res != expected {
+			t.Fatalf("expected %s for %s, got %s", expected, p, res)
+		}
+	}
+}
@@ -10,6 +10,8 @@ import Scoreboard from './components/Scoreboard.vue'
 import ChallegesPage from "./components/ChallegesPage";
 import TeamsPage from "./components/TeamsPage";
 import ResetFrontend from "@/components/ResetFrontend";
+import FrontendSnapshots from "@/components/FrontendSnapshots";
+import LabConnections from "@/components/LabConnections";
 import VPNDropdown from "./components/VPNDropdown";
 
 Vue.use(BootstrapVue)
@@ -34,6 +36,18 @@ if (document.getElementById("reset-frontend")) {
   }).$mount('#reset-frontend')
 }
 
+if (document.getElementById("frontend-snapshots")) {
+  new Vue({
+    render: h => h(FrontendSnapshots),
+  }).$mount('#frontend-snapshots')
+}
+
+if (document.getElementById("lab-connections")) {
+  new Vue({
+    render: h => h(LabConnections),
+  }).$mount('#lab-connections')
+}
+
 if (document.getElementById("flagchecker")) {
   new Vue({
     render: h => h(FlagChecker),
@@ -0,0 +1,120 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+var (
+	ErrSnapshotsUnavailable = errors.New("Snapshots are not available for this event")
+)
+
+// FrontendSnapshot is a snapshot of the frontends of a team
+type FrontendSnapshot struct {
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+	Size      int64  `json:"size"`
+}
+
+type SnapshotHooks struct {
+	List    func(t *store.Team) ([]FrontendSnapshot, error)
+	Take    func(t *store.Team, name string) error
+	Restore func(t *store.Team, name string) error
+	Delete  func(t *store.Team, name string) error
+}
+
+func (am *Amigo) handleListSnapshots(listHook func(t *store.Team) ([]FrontendSnapshot, error)) http.HandlerFunc {
+
+	type replyMsg struct {
+		Snapshots []FrontendSnapshot `json:"snapshots"`
+	}
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if listHook == nil {
+			replyJsonRequestErr(w, ErrSnapshotsUnavailable)
+			return
+		}
+		if !team.IsLabAssigned() {
+			replyJson(http.StatusOK, w, replyMsg{})
+			return
+		}
+
+		snapshots, err := listHook(team)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		replyJson(http.StatusOK, w, replyMsg{snapshots})
+	}
+
+	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
+		endpoint = mw(endpoint)
+	}
+
+	return endpoint
+}
+
+// handleSnapshot takes, restores or deletes the
+// snapshot given by name depending on the hook
+func (am *Amigo) handleSnapshot(hook func(t *store.Team, name string) error) http.HandlerFunc {
+
+	type snapshotMsg struct {
+		Name string `json:"name"`
+	}
+
+	type replyMsg struct {
+		Status string `json:"status"`
+	}
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		var msg snapshotMsg
+		if err := safeReadJson(w, r, &msg, am.maxReadBytes); err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if hook == nil {
+			replyJsonRequestErr(w, ErrSnapshotsUnavailable)
+			return
+		}
+		if !team.IsLabAssigned() {
+			replyJsonRequestErr(w, fmt.Errorf("Lab is NOT assigned to team [ %s ] on event [ %s ]", team.Name(), am.TeamStore.Tag))
+			return
+		}
+
+		if err := hook(team, msg.Name); err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		replyJson(http.StatusOK, w, replyMsg{"ok"})
+	}
+
+	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
+		endpoint = mw(endpoint)
+	}
+
+	return endpoint
+}
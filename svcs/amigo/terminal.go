@@ -0,0 +1,150 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/gorilla/websocket"
+	logger "github.com/rs/zerolog/log"
+)
+
+const (
+	// Maximum size of the input of the terminal in a message.
+	terminalMaxMessageSize = 64 * 1024
+
+	// Size of the output of the shell sent in a message.
+	terminalBufferSize = 32 * 1024
+)
+
+// TerminalSession is a shell in the attacker container of
+// the lab of a team, which is closed when the browser leaves
+type TerminalSession interface {
+	io.ReadWriteCloser
+	Resize(rows, cols uint16) error
+}
+
+// terminalResize is sent by the browser as a text message when
+// the size of the terminal changes, other messages are input
+type terminalResize struct {
+	Rows uint16 `json:"rows"`
+	Cols uint16 `json:"cols"`
+}
+
+// handleTerminal shows the terminal together with the
+// challenges of the exercises which are solved from it
+func (am *Amigo) handleTerminal() http.HandlerFunc {
+	terminalTemplate := wd + "/svcs/amigo/resources/private/terminal.tmpl.html"
+	tmpl, err := parseTemplates(terminalTemplate)
+	if err != nil {
+		log.Println("error terminal tmpl: ", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		if _, err := am.getTeamFromRequest(w, r); err != nil {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+			return
+		}
+
+		data := am.getSiteInfo(w, r)
+		data.Content = am.terminalChallenges()
+		if err := tmpl.Execute(w, data); err != nil {
+			log.Println("template err terminal: ", err)
+		}
+	}
+}
+
+// terminalChallenges returns the challenges of the terminal exercises
+func (am *Amigo) terminalChallenges() []store.ChildrenChalConfig {
+	var res []store.ChildrenChalConfig
+	for _, tag := range am.TeamStore.Lab.TerminalExercises {
+		for _, child := range am.TeamStore.AllChallenges[string(tag)] {
+			for _, c := range am.challenges {
+				if string(c.Tag) == child {
+					res = append(res, c)
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// handleTerminalSession attaches the websocket of the browser to a
+// shell of the team, shell output is sent as binary messages
+func (am *Amigo) handleTerminalSession(hook func(t *store.Team) (TerminalSession, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			http.Error(w, ErrUnauthorized.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		shell, err := hook(t)
+		if err != nil {
+			logger.Warn().Str("team", t.ID()).Msgf("Unable to open terminal: %v", err)
+			http.Error(w, "terminal is not available", http.StatusServiceUnavailable)
+			return
+		}
+		defer shell.Close()
+
+		// the origin is checked, as the session cookie authenticates the team
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logger.Warn().Str("team", t.ID()).Msgf("Unable to upgrade terminal connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		conn.SetReadLimit(terminalMaxMessageSize)
+
+		go func() {
+			buf := make([]byte, terminalBufferSize)
+			for {
+				n, err := shell.Read(buf)
+				if n > 0 {
+					conn.SetWriteDeadline(time.Now().Add(writeWait))
+					if werr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+						conn.Close()
+						return
+					}
+				}
+				if err != nil {
+					conn.SetWriteDeadline(time.Now().Add(writeWait))
+					conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "shell exited"))
+					conn.Close()
+					return
+				}
+			}
+		}()
+
+		for {
+			msgType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			switch msgType {
+			case websocket.TextMessage:
+				var size terminalResize
+				if err := json.Unmarshal(data, &size); err != nil || size.Rows == 0 || size.Cols == 0 {
+					continue
+				}
+				if err := shell.Resize(size.Rows, size.Cols); err != nil {
+					logger.Debug().Str("team", t.ID()).Msgf("Unable to resize terminal: %v", err)
+				}
+			case websocket.BinaryMessage:
+				if _, err := shell.Write(data); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package amigo
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	pb "github.com/aau-network-security/haaukins/store/proto"
+	mockserver "github.com/aau-network-security/haaukins/testing"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+)
+
+// echoSession returns the input of the terminal as its output
+type echoSession struct {
+	m      sync.Mutex
+	r      *io.PipeReader
+	w      *io.PipeWriter
+	resize []uint16
+}
+
+func newEchoSession() *echoSession {
+	r, w := io.Pipe()
+	return &echoSession{r: r, w: w}
+}
+
+func (es *echoSession) Read(p []byte) (int, error)  { return es.r.Read(p) }
+func (es *echoSession) Write(p []byte) (int, error) { return es.w.Write(p) }
+func (es *echoSession) Close() error                { return es.w.Close() }
+
+func (es *echoSession) Resize(rows, cols uint16) error {
+	es.m.Lock()
+	defer es.m.Unlock()
+	es.resize = []uint16{rows, cols}
+	return nil
+}
+
+func (es *echoSession) size() []uint16 {
+	es.m.Lock()
+	defer es.m.Unlock()
+	return es.resize
+}
+
+func TestTerminalSession(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dialer, close := mockserver.Create()
+	defer close()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewStoreClient(conn)
+
+	ts, err := store.NewEventStore(store.EventConfig{Name: "Test Event", Tag: "test"}, tmp, client)
+	if err != nil {
+		t.Fatalf("unable to create event store: %v", err)
+	}
+	team := store.NewTeam("some@email.com", "somename", "password",
+		"", "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, client)
+	if err := ts.SaveTeam(team); err != nil {
+		t.Fatalf("unable to save team: %v", err)
+	}
+
+	am := NewAmigo(ts, nil, "", nil)
+	session := newEchoSession()
+	var opened string
+	srv := httptest.NewServer(am.handleTerminalSession(func(t *store.Team) (TerminalSession, error) {
+		opened = t.ID()
+		return session, nil
+	}))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	// the terminal is only opened for teams with a session
+	if _, resp, err := websocket.DefaultDialer.Dial(url, nil); err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected terminal to be refused without session")
+	}
+
+	token, err := store.GetTokenForTeam(am.signingKey, team)
+	if err != nil {
+		t.Fatalf("unable to create session token: %v", err)
+	}
+	header := http.Header{}
+	header.Add("Cookie", (&http.Cookie{Name: "session", Value: token}).String())
+	ws, _, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		t.Fatalf("unable to open terminal: %v", err)
+	}
+	defer ws.Close()
+	if opened != team.ID() {
+		t.Fatalf("expected terminal of team %s, got %s", team.ID(), opened)
+	}
+
+	if err := ws.WriteMessage(websocket.TextMessage, []byte(`{"rows": 24, "cols": 80}`)); err != nil {
+		t.Fatalf("unable to resize terminal: %v", err)
+	}
+	if err := ws.WriteMessage(websocket.BinaryMessage, []byte("id\n")); err != nil {
+		t.Fatalf("unable to write to terminal: %v", err)
+	}
+	ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msgType, data, err := ws.ReadMessage()
+	if err != nil {
+		t.Fatalf("unable to read from terminal: %v", err)
+	}
+	if msgType != websocket.BinaryMessage || string(data) != "id\n" {
+		t.Fatalf("expected output of the shell, got %q", data)
+	}
+	if size := session.size(); len(size) != 2 || size[0] != 24 || size[1] != 80 {
+		t.Fatalf("expected terminal to be resized to 24x80, got %v", size)
+	}
+
+	// the browser is disconnected when the shell exits
+	session.Close()
+	if _, _, err := ws.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Fatalf("expected terminal to be closed, got %v", err)
+	}
+}
@@ -24,6 +24,10 @@ const (
 	// Maximum message size allowed from peer.
 	maxMessageSize = 512
 
+	// Send waiting queue status to the team with this period,
+	// unless another period is given by WithQueueUpdatePeriod.
+	defaultQueueUpdatePeriod = 5 * time.Second
+
 	waitingHTMLTemplate = `
 <html lang="en" dir="ltr">
 		  <meta http-equiv="refresh" content="10" />
@@ -275,6 +279,56 @@ func (c *Client) writePump() {
 	}
 }
 
+type queueStatus struct {
+	Position      int  `json:"position"`
+	ETA           int  `json:"eta"` // in seconds
+	IsLabAssigned bool `json:"isLabAssigned"`
+}
+
+// handleWaitingStatus pushes position and estimated waiting time
+// to a team in the waiting queue until a lab is assigned to it
+func (am *Amigo) handleWaitingStatus(status func(t *store.Team) (int, time.Duration)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		go func() {
+			ticker := time.NewTicker(am.queueUpdatePeriod)
+			defer func() {
+				ticker.Stop()
+				conn.Close()
+			}()
+			for {
+				msg := queueStatus{IsLabAssigned: t.IsLabAssigned()}
+				if !msg.IsLabAssigned && status != nil {
+					pos, eta := status(t)
+					msg.Position = pos
+					msg.ETA = int(eta.Seconds())
+				}
+
+				conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if err := conn.WriteJSON(msg); err != nil {
+					return
+				}
+				if msg.IsLabAssigned {
+					conn.WriteMessage(websocket.CloseMessage, []byte{})
+					return
+				}
+				<-ticker.C
+			}
+		}()
+	}
+}
+
 //todo change this function. there are 2 same function in amigo
 func getTeamInfoFromToken(token string) (*team, error) {
 	jwtToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
@@ -0,0 +1,212 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package amigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	wg "github.com/aau-network-security/haaukins/network/vpn"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrVPNUnavailable   = errors.New("VPN profiles are not available for this event")
+	ErrInvalidVPNConnID = errors.New("Invalid VPN connection id")
+)
+
+// VPNHooks manage the VPN profiles of a team,
+// profiles are identified by their slot
+type VPNHooks struct {
+	Create     func(t *store.Team, id int) error
+	Revoke     func(t *store.Team, id int) error
+	Regenerate func(t *store.Team, id int) error
+}
+
+type vpnConnMsg struct {
+	VPNConfID string `json:"vpnConnID"`
+}
+
+// vpnConnID identifies a profile towards the frontend, e.g. conn_0
+func vpnConnID(id int) string {
+	return fmt.Sprintf("conn_%d", id)
+}
+
+func parseVPNConnID(s string) (int, error) {
+	parts := strings.Split(s, "_")
+	if len(parts) != 2 {
+		return 0, ErrInvalidVPNConnID
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, ErrInvalidVPNConnID
+	}
+
+	return id, nil
+}
+
+// handleVPNStatus lists the profiles a team is allowed to create, created
+// profiles have the last handshake of their peer when the VPN reports it
+func (am *Amigo) handleVPNStatus(hook func(t *store.Team) error) http.HandlerFunc {
+	// data to be sent
+	type vpnStatus struct {
+		VPNConfID     string `json:"vpnConnID"`
+		Created       bool   `json:"created"`
+		Status        string `json:"status"` // N/A not created, N/U not used
+		LastHandshake string `json:"lastHandshake"`
+	}
+	ctx := context.Background()
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if !team.IsLabAssigned() {
+			if err := hook(team); err != nil {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				return
+			}
+		}
+
+		listOfStatus := []vpnStatus{}
+		for i := 0; i < am.TeamStore.TeamVPNProfiles(); i++ {
+			status := vpnStatus{VPNConfID: vpnConnID(i), Status: "N/A"}
+			p, ok := team.GetVPNProfile(i)
+			if !ok {
+				listOfStatus = append(listOfStatus, status)
+				continue
+			}
+
+			resp, err := am.wgClient.GetPeerStatus(ctx, &wg.PeerStatusReq{
+				NicName:   string(am.TeamStore.Tag),
+				PublicKey: p.PublicKey,
+			})
+			if err != nil {
+				log.Printf("Error on retrieving back information from wg %s", err.Error())
+				replyJsonRequestErr(w, err)
+				return
+			}
+			status.Created = true
+			status.Status = "N/U"
+			if resp.Status {
+				status.Status = "USED"
+			}
+			if resp.LastHandshake > 0 {
+				status.LastHandshake = time.Unix(resp.LastHandshake, 0).Format(time.RFC3339)
+			}
+			listOfStatus = append(listOfStatus, status)
+		}
+
+		replyJson(http.StatusOK, w, listOfStatus)
+	}
+	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
+		endpoint = mw(endpoint)
+	}
+
+	return endpoint
+}
+
+// handleVPNFiles will give chance to download their configuration files
+func (am *Amigo) handleVPNFiles() http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+		var vpnConn vpnConnMsg
+		if err := safeReadJson(w, r, &vpnConn, am.maxReadBytes); err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		id, err := parseVPNConnID(vpnConn.VPNConfID)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+		p, ok := team.GetVPNProfile(id)
+		if !ok {
+			replyJsonRequestErr(w, fmt.Errorf("Error, no vpn information found on team for %s", vpnConn.VPNConfID))
+			return
+		}
+		// configurations are not saved, profiles restored
+		// after a restart have to be regenerated
+		if p.Config == "" {
+			replyJsonRequestErr(w, fmt.Errorf("Error, configuration of %s is not available anymore, regenerate the profile", vpnConn.VPNConfID))
+			return
+		}
+
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.conf", vpnConnID(id)))
+		//stream the body to the client without fully loading it into memory
+		io.Copy(w, strings.NewReader(p.Config))
+	}
+}
+
+// handleVPNProfile creates, revokes or regenerates the
+// profile given by its connection id depending on the hook
+func (am *Amigo) handleVPNProfile(hook func(t *store.Team, id int) error) http.HandlerFunc {
+
+	type replyMsg struct {
+		Status string `json:"status"`
+	}
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
+		team, err := am.getTeamFromRequest(w, r)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		var msg vpnConnMsg
+		if err := safeReadJson(w, r, &msg, am.maxReadBytes); err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		if hook == nil || am.TeamStore.OnlyVPN == 0 {
+			replyJsonRequestErr(w, ErrVPNUnavailable)
+			return
+		}
+		if !team.IsLabAssigned() {
+			replyJsonRequestErr(w, fmt.Errorf("Lab is NOT assigned to team [ %s ] on event [ %s ]", team.Name(), am.TeamStore.Tag))
+			return
+		}
+
+		id, err := parseVPNConnID(msg.VPNConfID)
+		if err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+		if err := hook(team, id); err != nil {
+			replyJsonRequestErr(w, err)
+			return
+		}
+
+		replyJson(http.StatusOK, w, replyMsg{"ok"})
+	}
+
+	for _, mw := range []Middleware{JSONEndpoint, POSTEndpoint} {
+		endpoint = mw(endpoint)
+	}
+
+	return endpoint
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+// CheckpointTeamLab saves the state of the exercise containers of the
+// lab of the team, the checkpoint is kept in the store such that it can
+// be restored after the lab is closed or replaced
+func (ev *event) CheckpointTeamLab(ctx context.Context, t *store.Team) error {
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
+
+	c, err := l.Environment().Checkpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	prev := t.Checkpoint()
+	if err := t.SetCheckpoint(c); err != nil {
+		ev.removeImages(c.Images(), nil)
+		return fmt.Errorf("unable to save checkpoint: %v", err)
+	}
+	ev.removeImages(prev.Images(), c.Images())
+
+	log.Info().
+		Str("event", string(ev.store.Tag)).
+		Str("team", t.ID()).
+		Str("lab", l.Tag()).
+		Msg("Took checkpoint of lab")
+
+	return nil
+}
+
+// RestoreTeamLab recreates the exercises of the lab of the
+// team from the latest checkpoint of the team
+func (ev *event) RestoreTeamLab(ctx context.Context, t *store.Team) error {
+	c := t.Checkpoint()
+	if len(c) == 0 {
+		return exercise.NoCheckpointErr
+	}
+
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
+
+	if err := l.Environment().Restore(ctx, c); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", string(ev.store.Tag)).
+		Str("team", t.ID()).
+		Str("lab", l.Tag()).
+		Msg("Restored lab from checkpoint")
+
+	return nil
+}
+
+// removeCheckpoints removes the images of the
+// checkpoints of all teams of the event
+func (ev *event) removeCheckpoints() {
+	for _, t := range ev.GetTeams() {
+		ev.removeImages(t.Checkpoint().Images(), nil)
+	}
+}
+
+// removeImages removes the given images except the ones to keep
+func (ev *event) removeImages(images, keep []string) {
+	kept := map[string]struct{}{}
+	for _, img := range keep {
+		kept[img] = struct{}{}
+	}
+	for _, img := range images {
+		if _, ok := kept[img]; ok {
+			continue
+		}
+		if err := ev.dockerHost.RemoveImage(img); err != nil {
+			log.Warn().Msgf("error while removing checkpoint image: %s", err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	pb "github.com/aau-network-security/haaukins/store/proto"
+	mockserver "github.com/aau-network-security/haaukins/testing"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"google.golang.org/grpc"
+)
+
+type checkpointEnv struct {
+	exercise.Environment
+	checkpoints int
+	restored    store.Checkpoint
+}
+
+func (env *checkpointEnv) Checkpoint(context.Context) (store.Checkpoint, error) {
+	env.checkpoints++
+	return store.Checkpoint{"sql": {fmt.Sprintf("hkn-checkpoint:sql-%d", env.checkpoints)}}, nil
+}
+
+func (env *checkpointEnv) Restore(ctx context.Context, c store.Checkpoint) error {
+	env.restored = c
+	return nil
+}
+
+type checkpointLab struct {
+	lab.Lab
+	env *checkpointEnv
+}
+
+func (l *checkpointLab) Tag() string                       { return "elated_turing" }
+func (l *checkpointLab) Environment() exercise.Environment { return l.env }
+
+type checkpointDockerHost struct {
+	docker.Host
+	removed []string
+}
+
+func (h *checkpointDockerHost) RemoveImage(name string) error {
+	h.removed = append(h.removed, name)
+	return nil
+}
+
+func TestCheckpointTeamLab(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dialer, close := mockserver.Create()
+	defer close()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+
+	es, err := store.NewEventStore(store.EventConfig{Tag: "test"}, tmp, pb.NewStoreClient(conn))
+	if err != nil {
+		t.Fatalf("unexpected error when creating event store: %v", err)
+	}
+	team := store.NewTeam("some@email.com", "some name", "password",
+		"team", "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, pb.NewStoreClient(conn))
+	if err := es.SaveTeam(team); err != nil {
+		t.Fatalf("unexpected error when saving team: %v", err)
+	}
+
+	dhost := &checkpointDockerHost{}
+	first := &checkpointLab{env: &checkpointEnv{}}
+	ev := &event{
+		store:      es,
+		dockerHost: dhost,
+		labs:       map[string]lab.Lab{team.ID(): first},
+	}
+	ctx := context.Background()
+
+	if err := ev.RestoreTeamLab(ctx, team); err != exercise.NoCheckpointErr {
+		t.Fatalf("expected no checkpoint error, got: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := ev.CheckpointTeamLab(ctx, team); err != nil {
+			t.Fatalf("unexpected error when taking checkpoint: %v", err)
+		}
+	}
+	expected := store.Checkpoint{"sql": {"hkn-checkpoint:sql-2"}}
+	if !reflect.DeepEqual(team.Checkpoint(), expected) {
+		t.Fatalf("expected checkpoint %v of team, got: %v", expected, team.Checkpoint())
+	}
+	if !reflect.DeepEqual(dhost.removed, []string{"hkn-checkpoint:sql-1"}) {
+		t.Fatalf("expected images of previous checkpoint to be removed, got: %v", dhost.removed)
+	}
+
+	// the checkpoint is restored into another lab of the team
+	second := &checkpointLab{env: &checkpointEnv{}}
+	ev.labs[team.ID()] = second
+	if err := ev.RestoreTeamLab(ctx, team); err != nil {
+		t.Fatalf("unexpected error when restoring lab: %v", err)
+	}
+	if !reflect.DeepEqual(second.env.restored, expected) {
+		t.Fatalf("expected lab to be restored from %v, got: %v", expected, second.env.restored)
+	}
+
+	ev.removeCheckpoints()
+	if !reflect.DeepEqual(dhost.removed, []string{"hkn-checkpoint:sql-1", "hkn-checkpoint:sql-2"}) {
+		t.Fatalf("expected images of checkpoints to be removed with the event, got: %v", dhost.removed)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"unicode"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+func (ev *event) GetDNSRecords() []store.RecordConfig {
+	ev.dnsM.Lock()
+	defer ev.dnsM.Unlock()
+	return ev.dnsRecords
+}
+
+// SetDNSRecords replaces the records the event adds to the DNS
+// of every lab, e.g. fake domains of a phishing scenario, the
+// records are updated without restarting the DNS of the labs
+// and are saved, so they are kept when the event is reloaded
+func (ev *event) SetDNSRecords(ctx context.Context, records []store.RecordConfig) error {
+	for _, r := range records {
+		if err := validateDNSRecord(r); err != nil {
+			return err
+		}
+	}
+
+	ev.dnsM.Lock()
+	defer ev.dnsM.Unlock()
+	if err := ev.store.SetDNSRecords(records); err != nil {
+		return fmt.Errorf("unable to save DNS records: %v", err)
+	}
+	ev.dnsRecords = records
+
+	var firstErr error
+	for tid, l := range ev.GetAssignedLabs() {
+		if err := l.Environment().SetDNSRecords(ctx, records); err != nil {
+			log.Warn().Str("team", tid).Msgf("Unable to update DNS records of lab: %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// validateDNSRecord only accepts records which are safe to write to
+// the zone of the DNS of labs, the data has to match the record type
+func validateDNSRecord(r store.RecordConfig) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.RData == "" {
+		return &store.EmptyVarErr{Var: "Data", Type: "Record config"}
+	}
+	if !isDomainName(r.Name) {
+		return fmt.Errorf("name of record is not a valid domain name: %q", r.Name)
+	}
+	if strings.IndexFunc(r.RData, invalidRecordRune) >= 0 {
+		return fmt.Errorf("data of %s record %s contains whitespace or control characters", r.Type, r.Name)
+	}
+
+	switch r.Type {
+	case "A":
+		if ip := net.ParseIP(r.RData); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("data of A record %s is not an IPv4 address: %s", r.Name, r.RData)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(r.RData); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("data of AAAA record %s is not an IPv6 address: %s", r.Name, r.RData)
+		}
+	case "CNAME":
+		if !isDomainName(r.RData) {
+			return fmt.Errorf("data of CNAME record %s is not a domain name: %s", r.Name, r.RData)
+		}
+	case "TXT":
+		if len(r.RData) > 255 {
+			return fmt.Errorf("data of TXT record %s is longer than 255 characters", r.Name)
+		}
+	default:
+		return fmt.Errorf("unsupported type of record %s: %q, use A, AAAA, CNAME or TXT", r.Name, r.Type)
+	}
+
+	return nil
+}
+
+func invalidRecordRune(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r) || r > unicode.MaxASCII
+}
+
+// isDomainName returns true for names consisting of labels of letters,
+// digits, hyphens and underscores, with an optional trailing dot
+func isDomainName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	pb "github.com/aau-network-security/haaukins/store/proto"
+	"google.golang.org/grpc"
+)
+
+type dnsEnv struct {
+	records []store.RecordConfig
+	exercise.Environment
+}
+
+func (env *dnsEnv) SetDNSRecords(ctx context.Context, records []store.RecordConfig) error {
+	env.records = records
+	return nil
+}
+
+type dnsLab struct {
+	env *dnsEnv
+	lab.Lab
+}
+
+func (l *dnsLab) Environment() exercise.Environment { return l.env }
+
+type dnsStore struct {
+	pb.StoreClient
+	saved string
+	err   error
+}
+
+func (s *dnsStore) GetEventTeams(context.Context, *pb.GetEventTeamsRequest, ...grpc.CallOption) (*pb.GetEventTeamsResponse, error) {
+	return &pb.GetEventTeamsResponse{}, nil
+}
+
+func (s *dnsStore) UpdateDNSRecords(ctx context.Context, in *pb.UpdateDNSRecordsRequest, opts ...grpc.CallOption) (*pb.UpdateResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.saved = in.DnsRecords
+	return &pb.UpdateResponse{}, nil
+}
+
+func TestSetDNSRecords(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dbc := &dnsStore{}
+	es, err := store.NewEventStore(store.EventConfig{Tag: "test"}, tmp, dbc)
+	if err != nil {
+		t.Fatalf("unexpected error when creating event store: %v", err)
+	}
+	env := &dnsEnv{}
+	ev := &event{store: es, labs: map[string]lab.Lab{"a": &dnsLab{env: env}}}
+
+	tt := []struct {
+		name    string
+		records []store.RecordConfig
+		err     bool
+	}{
+		{name: "Normal", records: []store.RecordConfig{
+			{Name: "formalbank-login.com", Type: "A", RData: "10.10.10.5"},
+			{Name: "mail.formalbank.com", Type: "CNAME", RData: "formalbank.com"},
+			{Name: "formalbank-login.com", Type: "AAAA", RData: "fd00:10:10::5"},
+			{Name: "_verify.formalbank.com", Type: "TXT", RData: "token=3f9a"},
+		}},
+		{name: "Invalid address", records: []store.RecordConfig{{Name: "formalbank-login.com", Type: "A", RData: "formalbank.com"}}, err: true},
+		{name: "Empty data", records: []store.RecordConfig{{Name: "formalbank-login.com", Type: "TXT"}}, err: true},
+		{name: "Unsupported type", records: []store.RecordConfig{{Name: "formalbank.com", Type: "MX", RData: "mail.formalbank.com"}}, err: true},
+		{name: "Whitespace in name", records: []store.RecordConfig{{Name: "formalbank.com 60 IN", Type: "A", RData: "10.10.10.5"}}, err: true},
+		{name: "Control character in name", records: []store.RecordConfig{{Name: "formalbank.com\nevil.com", Type: "A", RData: "10.10.10.5"}}, err: true},
+		{name: "Invalid name", records: []store.RecordConfig{{Name: "formal;bank.com", Type: "A", RData: "10.10.10.5"}}, err: true},
+		{name: "Whitespace in data", records: []store.RecordConfig{{Name: "formalbank.com", Type: "TXT", RData: "v=spf1 -all"}}, err: true},
+		{name: "Control character in data", records: []store.RecordConfig{{Name: "formalbank.com", Type: "TXT", RData: "token\nevil.com"}}, err: true},
+		{name: "IPv4 in AAAA record", records: []store.RecordConfig{{Name: "formalbank.com", Type: "AAAA", RData: "10.10.10.5"}}, err: true},
+		{name: "Invalid CNAME target", records: []store.RecordConfig{{Name: "mail.formalbank.com", Type: "CNAME", RData: "10.10.10.5/24"}}, err: true},
+		{name: "Clear"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			prev := ev.GetDNSRecords()
+			err := ev.SetDNSRecords(context.Background(), tc.records)
+			if (err != nil) != tc.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := tc.records
+			if tc.err {
+				expected = prev
+			}
+			if !reflect.DeepEqual(ev.GetDNSRecords(), expected) || !reflect.DeepEqual(env.records, expected) {
+				t.Fatalf("expected records %v, got %v (lab: %v)", expected, ev.GetDNSRecords(), env.records)
+			}
+			if saved, _ := store.ParseDNSRecords(dbc.saved); !reflect.DeepEqual(saved, expected) {
+				t.Fatalf("expected records %v to be saved, got %v", expected, saved)
+			}
+		})
+	}
+}
+
+func TestSetDNSRecordsNotSaved(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	es, err := store.NewEventStore(store.EventConfig{Tag: "test"}, tmp, &dnsStore{err: errors.New("store is down")})
+	if err != nil {
+		t.Fatalf("unexpected error when creating event store: %v", err)
+	}
+	env := &dnsEnv{}
+	ev := &event{store: es, labs: map[string]lab.Lab{"a": &dnsLab{env: env}}}
+
+	records := []store.RecordConfig{{Name: "formalbank-login.com", Type: "A", RData: "10.10.10.5"}}
+	if err := ev.SetDNSRecords(context.Background(), records); err == nil {
+		t.Fatalf("expected error when records cannot be saved")
+	}
+	if len(ev.GetDNSRecords()) != 0 || len(env.records) != 0 {
+		t.Fatalf("expected records not to be changed when they cannot be saved")
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"math/rand"
 	"net"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/aau-network-security/haaukins/exercise"
@@ -21,6 +20,9 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	eproto "github.com/aau-network-security/haaukins/exercise/ex-proto"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/network/forward"
 	wg "github.com/aau-network-security/haaukins/network/vpn"
 
 	"net/http"
@@ -36,7 +38,9 @@ import (
 	"github.com/aau-network-security/haaukins/store"
 	"github.com/aau-network-security/haaukins/svcs/amigo"
 	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/kube"
 	"github.com/aau-network-security/haaukins/virtual/vbox"
+	"github.com/aau-network-security/haaukins/worker"
 	"github.com/rs/zerolog/log"
 )
 
@@ -52,8 +56,12 @@ const (
 type State int
 
 var (
-	RdpConfErr      = errors.New("error too few rdp connections")
-	StartingGuacErr = errors.New("error while starting guac")
+	RdpConfErr         = errors.New("error too few rdp connections")
+	StartingGuacErr    = errors.New("error while starting guac")
+	NoClusterErr       = errors.New("kubernetes backend is not configured for the daemon")
+	NoTerminalErr      = errors.New("terminal is not configured for the daemon")
+	TerminalBackendErr = errors.New("terminal is only available on the docker backend")
+	NoCaptureErr       = errors.New("traffic capture is not configured for the daemon")
 	//EmptyNameErr    = errors.New("event requires a name")
 	//EmptyTagErr     = errors.New("event requires a tag")
 
@@ -75,14 +83,48 @@ type Host interface {
 	CreateEventFromConfig(context.Context, store.EventConfig, string) (Event, error)
 }
 
-func NewHost(vlib vbox.Library, elib eproto.ExerciseStoreClient, eDir string, dbc pbc.StoreClient, config wg.WireGuardConfig) Host {
+// HostConfig contains the services of the daemon
+// which are shared by the events of the host
+type HostConfig struct {
+	VBoxLibrary vbox.Library
+	Frontends   lab.FrontendProviders
+	// Cluster is nil when no kubernetes cluster is configured
+	Cluster *kube.Cluster
+	// Workers is nil when labs only run on the daemon host
+	Workers       *worker.Pool
+	ExerciseStore eproto.ExerciseStoreClient
+	EventsDir     string
+	Store         pbc.StoreClient
+	VPNConfig     wg.WireGuardConfig
+	VPN           wg.WireguardClient
+	Snapshots     lab.SnapshotPolicy
+	Capture       *capture.Config
+	Firewall      firewall.Firewall
+	Ports         *forward.Ports
+	Terminal      *lab.TerminalConfig
+	// QueueUpdate is the period of updating teams
+	// about their position in the waiting queue
+	QueueUpdate time.Duration
+}
+
+func NewHost(conf HostConfig) Host {
 	return &eventHost{
-		ctx:       context.Background(),
-		dbc:       dbc,
-		vlib:      vlib,
-		elib:      elib,
-		dir:       eDir,
-		vpnConfig: config,
+		ctx:         context.Background(),
+		dbc:         conf.Store,
+		vlib:        conf.VBoxLibrary,
+		frontends:   conf.Frontends,
+		cluster:     conf.Cluster,
+		workers:     conf.Workers,
+		elib:        conf.ExerciseStore,
+		dir:         conf.EventsDir,
+		vpnConfig:   conf.VPNConfig,
+		vpn:         conf.VPN,
+		snapshots:   conf.Snapshots,
+		capture:     conf.Capture,
+		fw:          conf.Firewall,
+		ports:       conf.Ports,
+		terminal:    conf.Terminal,
+		queueUpdate: conf.QueueUpdate,
 	}
 }
 
@@ -90,9 +132,20 @@ type eventHost struct {
 	ctx       context.Context
 	dbc       pbc.StoreClient
 	vlib      vbox.Library
+	frontends lab.FrontendProviders
+	cluster   *kube.Cluster
+	workers   *worker.Pool
 	elib      eproto.ExerciseStoreClient
 	vpnConfig wg.WireGuardConfig
+	vpn       wg.WireguardClient
+	snapshots lab.SnapshotPolicy
+	capture   *capture.Config
+	fw        firewall.Firewall
+	ports     *forward.Ports
+	terminal  *lab.TerminalConfig
 	dir       string
+	// queueUpdate is zero for the default period of amigo
+	queueUpdate time.Duration
 }
 
 //Create the event configuration for the event got from the DB
@@ -149,18 +202,62 @@ func (eh *eventHost) CreateEventFromEventDB(ctx context.Context, conf store.Even
 		labConf.Frontends = conf.Lab.Frontends
 	}
 
+	labConf.Snapshots = eh.snapshots
+	// captures of the labs are kept per event
+	var captureConf *capture.Config
+	if conf.Capture {
+		if eh.capture == nil {
+			return nil, NoCaptureErr
+		}
+		c := *eh.capture
+		c.Directory = filepath.Join(c.Directory, string(conf.Tag))
+		captureConf = &c
+	}
+	labConf.Capture = captureConf
+	labConf.IPv6 = conf.IPv6
+	if len(conf.Lab.TerminalExercises) > 0 {
+		if eh.terminal == nil {
+			return nil, NoTerminalErr
+		}
+		// the attacker container joins the docker network of the lab
+		if conf.Backend == store.KubernetesBackend {
+			return nil, TerminalBackendErr
+		}
+		labConf.Terminal = eh.terminal
+		labConf.TerminalExercises = conf.Lab.TerminalExercises
+	}
 	flags := labConf.Flags()
 
 	lh := lab.LabHost{
-		Vlib: eh.vlib,
-		Conf: labConf,
+		Vlib:      eh.vlib,
+		Providers: eh.frontends,
+		Conf:      labConf,
 	}
-	hub, err := lab.NewHub(&lh, conf.Available, conf.Capacity, conf.OnlyVPN)
+	if conf.Backend == store.KubernetesBackend {
+		if eh.cluster == nil {
+			return nil, NoClusterErr
+		}
+		lh.Cluster = eh.cluster
+	}
+
+	// labs are placed on workers when the daemon has any, labs of
+	// events restricting their egress stay on the daemon host as
+	// their traffic only passes its firewall when they are local
+	var creator lab.Creator = &lh
+	if eh.workers != nil && !conf.Egress.IsSet() {
+		creator = eh.workers.Creator(&lh)
+	}
+	hub, err := lab.NewHub(creator, conf.Available, conf.Capacity, conf.OnlyVPN)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewEvent(eh.ctx, es, hub, flags, reCaptchaKey)
+	var amigoOpts []amigo.AmigoOpt
+	if eh.queueUpdate > 0 {
+		amigoOpts = append(amigoOpts, amigo.WithQueueUpdatePeriod(eh.queueUpdate))
+	}
+
+	return NewEvent(eh.ctx, es, hub, flags, reCaptchaKey, captureConf, eh.fw, eh.vpn, eh.ports, amigoOpts...)
 }
 
 func protobufToJson(message proto.Message) (string, error) {
@@ -184,6 +281,10 @@ func (eh *eventHost) CreateEventFromConfig(ctx context.Context, conf store.Event
 	for _, e := range conf.Lab.DisabledExercises {
 		disabledExercises = append(disabledExercises, string(e))
 	}
+	var terminalExercises []string
+	for _, e := range conf.Lab.TerminalExercises {
+		terminalExercises = append(terminalExercises, string(e))
+	}
 
 	_, err := eh.dbc.AddEvent(ctx, &pbc.AddEventRequest{
 		Name:               conf.Name,
@@ -199,6 +300,15 @@ func (eh *eventHost) CreateEventFromConfig(ctx context.Context, conf store.Event
 		OnlyVPN:            conf.OnlyVPN,
 		SecretKey:          conf.SecretKey,
 		DisabledExercises:  strings.Join(disabledExercises, ","),
+		IdlePolicy:         conf.IdlePolicy.String(),
+		Backend:            conf.Backend,
+		VpnProfiles:        int32(conf.VPNProfiles),
+		EgressPolicy:       conf.Egress.String(),
+		Ipv6:               conf.IPv6,
+		PortForward:        conf.PortForward,
+		TerminalExercises:  strings.Join(terminalExercises, ","),
+		RecordingPolicy:    conf.Recording.String(),
+		Capture:            conf.Capture,
 	})
 
 	log.Debug().Str("event tag", string(conf.Tag)).
@@ -222,6 +332,7 @@ type Event interface {
 	Finish(string)
 	AssignLab(*store.Team, lab.Lab) error
 	Handler() http.Handler
+	ForwardHandler() http.Handler
 	PauseSignup(bool)
 	AddNotification(message string, loggedInUsers bool) error
 	SetStatus(int32)
@@ -235,31 +346,56 @@ type Event interface {
 	GetAssignedLabs() map[string]lab.Lab
 	GetFrontendData() *amigo.FrontendData
 	DeleteTeam(id string) (bool, error)
+	GetWaitingTeams() []*store.Team
+	ReplaceLab(context.Context, *store.Team) error
+	GetIdlePolicy() store.IdlePolicy
+	SetIdlePolicy(store.IdlePolicy) error
+	CheckIdleTeams(context.Context)
+	ResumeTeamLab(context.Context, *store.Team) error
+	CheckpointTeamLab(context.Context, *store.Team) error
+	RestoreTeamLab(context.Context, *store.Team) error
+	GetDNSRecords() []store.RecordConfig
+	SetDNSRecords(context.Context, []store.RecordConfig) error
+	GetRecordings() RecordingPool
 }
 
 type event struct {
 	amigo         *amigo.Amigo
 	guac          Guacamole
 	labhub        lab.Hub
-	ipT           IPTables
+	labsM         sync.RWMutex
 	labs          map[string]lab.Lab
 	store         store.Event
 	keyLoggerPool KeyLoggerPool
-
-	ipRules       map[string]ipRules
-	ipAddrs       []int
+	// terminalLoggerPool is nil when the labs have no terminal
+	terminalLoggerPool TerminalLoggerPool
+	// recordings is nil when connections are not recorded
+	recordings RecordingPool
+
+	fw            firewall.Firewall
+	fwLabs        map[string]string
+	vpnAddrs      *vpnPool
+	vpnM          sync.Mutex
 	wg            wg.WireguardClient
 	guacUserStore *GuacUserStore
 	dockerHost    docker.Host
-
+	waitingQueue  *teamQueue
+	assignM       sync.Mutex
+	idleM         sync.Mutex
+	connM         sync.RWMutex
+	conns         map[string][]amigo.LabConnection
+	capture       *capture.Config
+	dnsM          sync.Mutex
+	dnsRecords    []store.RecordConfig
+	ports         *forward.Ports
+	fwdM          sync.Mutex
+	tcpFwds       map[string]map[string]*tcpForward
+	proxies       map[string]http.Handler
+
+	stop    chan struct{}
 	closers []io.Closer
 }
 
-type ipRules struct {
-	labsubnet string
-	vpnIps    string
-}
-
 type labNetInfo struct {
 	dns             string
 	subnet          string
@@ -267,22 +403,11 @@ type labNetInfo struct {
 	wgInterfacePort int
 }
 
-func NewEvent(ctx context.Context, e store.Event, hub lab.Hub, flags []store.ChildrenChalConfig, reCaptchaKey string) (Event, error) {
+func NewEvent(ctx context.Context, e store.Event, hub lab.Hub, flags []store.ChildrenChalConfig, reCaptchaKey string, captureConf *capture.Config, fw firewall.Firewall, wgClient wg.WireguardClient, ports *forward.Ports, amigoOpts ...amigo.AmigoOpt) (Event, error) {
 	guac, err := New(ctx, Config{}, e.OnlyVPN, string(e.Tag))
 	if err != nil {
 		return nil, err
 	}
-	// New wireguard gRPC client connection
-	wgClient, err := wg.NewGRPCVPNClient(e.WireGuardConfig)
-	if err != nil {
-		log.Error().Msgf("Connection error on wireguard service error %v ", err)
-		return nil, err
-	}
-
-	ipT := IPTables{
-		sudo:     true,
-		execFunc: shellExec,
-	}
 
 	dirname, err := store.GetDirNameForEvent(e.Dir, e.Tag, e.StartedAt)
 	if err != nil {
@@ -296,26 +421,52 @@ func NewEvent(ctx context.Context, e store.Event, hub lab.Hub, flags []store.Chi
 		return nil, err
 	}
 
-	var eventVPNIPs []int
-	ipAddrs := makeRange(2, 254)
-	for i := 0; i < 4; i++ {
-		eventVPNIPs = append(eventVPNIPs, ipAddrs...)
-	}
-
 	ev := &event{
 		store:         e,
 		labhub:        hub,
-		amigo:         amigo.NewAmigo(e, flags, reCaptchaKey, wgClient, amigoOpt),
+		amigo:         amigo.NewAmigo(e, flags, reCaptchaKey, wgClient, append(amigoOpts, amigoOpt)...),
 		guac:          guac,
-		ipAddrs:       eventVPNIPs,
+		vpnAddrs:      newVPNPool(e.VPNAddress),
+		dnsRecords:    e.DNSRecords,
 		labs:          map[string]lab.Lab{},
 		guacUserStore: NewGuacUserStore(),
 		wg:            wgClient,
 		closers:       []io.Closer{guac, hub, keyLoggerPool},
 		dockerHost:    dockerHost,
 		keyLoggerPool: keyLoggerPool,
-		ipT:           ipT,
-		ipRules:       map[string]ipRules{},
+		fw:            fw,
+		fwLabs:        map[string]string{},
+		waitingQueue:  newTeamQueue(),
+		conns:         map[string][]amigo.LabConnection{},
+		capture:       captureConf,
+		ports:         ports,
+		tcpFwds:       map[string]map[string]*tcpForward{},
+		proxies:       map[string]http.Handler{},
+		stop:          make(chan struct{}),
+	}
+	// profiles restored from the store keep their addresses
+	for _, t := range e.GetTeams() {
+		for _, p := range t.GetVPNProfiles() {
+			ev.vpnAddrs.reserve(p.PeerIP)
+		}
+	}
+
+	if len(e.Lab.TerminalExercises) > 0 {
+		terminalLoggerPool, err := NewTerminalLoggerPool(filepath.Join(e.Dir, dirname, "terminal"))
+		if err != nil {
+			return nil, err
+		}
+		ev.terminalLoggerPool = terminalLoggerPool
+		ev.closers = append(ev.closers, terminalLoggerPool)
+	}
+
+	if e.Recording.Enabled {
+		recordings, err := NewRecordingPool(filepath.Join(e.Dir, dirname, "recordings"), e.Recording.Retention)
+		if err != nil {
+			return nil, err
+		}
+		ev.recordings = recordings
+		ev.closers = append(ev.closers, recordings)
 	}
 
 	return ev, nil
@@ -337,8 +488,28 @@ func (ev *event) PauseSignup(pause bool) {
 func (ev *event) GetFrontendData() *amigo.FrontendData {
 	return ev.amigo.FrontEndData
 }
+// GetAssignedLabs returns a copy of the labs by team
 func (ev *event) GetAssignedLabs() map[string]lab.Lab {
-	return ev.labs
+	ev.labsM.RLock()
+	defer ev.labsM.RUnlock()
+
+	labs := make(map[string]lab.Lab, len(ev.labs))
+	for tid, l := range ev.labs {
+		labs[tid] = l
+	}
+	return labs
+}
+
+func (ev *event) setLab(teamId string, l lab.Lab) {
+	ev.labsM.Lock()
+	ev.labs[teamId] = l
+	ev.labsM.Unlock()
+}
+
+func (ev *event) removeLab(teamId string) {
+	ev.labsM.Lock()
+	delete(ev.labs, teamId)
+	ev.labsM.Unlock()
 }
 
 // SetStatus sets status of event in cache
@@ -367,6 +538,9 @@ func (ev *event) DeleteTeam(id string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if ev.waitingQueue.Remove(t.ID()) {
+		log.Debug().Str("team", t.ID()).Msg("Team is removed from waiting queue")
+	}
 	if err := ev.store.DeleteTeam(t.ID(), string(ev.GetConfig().Tag)); err != nil {
 		log.Debug().Msgf("Error on DeleteTeam: [ %s ] ", err.Error())
 		return false, err
@@ -384,12 +558,20 @@ func (ev *event) Start(ctx context.Context) error {
 		ev.store.EndPointPort = port
 		log.Info().Msgf("Connection established with VPN service on port %d", port)
 		log.Info().Msgf("Initializing VPN endpoint for event ")
+		// only the peers of the profiles of teams are restored
+		var peers []string
+		for _, t := range ev.store.GetTeams() {
+			for _, p := range t.GetVPNProfiles() {
+				peers = append(peers, p.PublicKey)
+			}
+		}
 		_, err := ev.wg.InitializeI(context.Background(), &wg.IReq{
-			Address:    ev.store.VPNAddress, // this should be randomized and should not collide with lab subnet like 124.5.6.0/24
-			ListenPort: uint32(port),        // this should be randomized and should not collide with any used ports by host
+			Address:    ev.vpnAddresses(ev.store.VPNAddress), // this should be randomized and should not collide with lab subnet like 124.5.6.0/24
+			ListenPort: uint32(port),                         // this should be randomized and should not collide with any used ports by host
 			SaveConfig: true,
 			Eth:        "eth0",
 			IName:      string(ev.store.Tag),
+			Peers:      peers,
 		})
 
 		if err := ev.guac.Start(ctx); err != nil {
@@ -421,164 +603,140 @@ func (ev *event) Start(ctx context.Context) error {
 		}
 	}
 
+	// teams restored from the store are waiting
+	// for their labs in the same queue as new signups
 	for _, team := range ev.store.GetTeams() {
-		lab, ok := <-ev.labhub.Queue()
-		if !ok {
-			return ErrMaxLabs
-		}
-
-		if err := ev.AssignLab(team, lab); err != nil {
-			log.
-				Debug().
-				Err(err).
-				Msgf("lab issue for team %s", team.ID())
-			return err
-		}
-
+		ev.waitingQueue.Push(team)
 	}
 
+	go ev.assignWaitingTeams()
+
 	return nil
 }
 
-//CreateVPNConn will generate VPN Connection configuration per team.
-func (ev *event) CreateVPNConn(t *store.Team, labInfo *labNetInfo) ([]string, error) {
-	var teamConfigFiles []string
-	lowBound := 240
-	upperBound := 244
-	ctx := context.Background()
-	var vpnIPs []string
-	vpnInstructions := getContent(vpnInfo)
-	evTag := string(ev.GetConfig().Tag)
-	teamID := t.ID()
-	var hosts string
-	for _, r := range labInfo.dnsrecords {
-		for ip, arecord := range r.Record {
-			hosts += fmt.Sprintf("# %s \t %s \n", ip, arecord)
+// assignWaitingTeams hands out the labs produced by the lab hub
+// to the teams in the waiting queue, in the order they arrived
+func (ev *event) assignWaitingTeams() {
+	for {
+		select {
+		case <-ev.waitingQueue.Wait():
+		case <-ev.stop:
+			return
 		}
 
-	}
-	labSubnet := fmt.Sprintf("%s/24", labInfo.subnet)
-	// random.random.240.1/22
-	subnet := ev.store.VPNAddress
+		for ev.waitingQueue.Len() > 0 {
+			var l lab.Lab
+			select {
+			case lb, ok := <-ev.labhub.Queue():
+				if !ok {
+					log.Warn().Msgf("%v, %d team(s) left in waiting queue", ErrMaxLabs, ev.waitingQueue.Len())
+					return
+				}
+				l = lb
+			case <-ev.stop:
+				return
+			}
 
-	// retrieve domain from configuration file
-	endpoint := fmt.Sprintf("%s.%s:%d", evTag, ev.store.Host, ev.store.EndPointPort)
+			// registering teams do not take labs of the hub
+			// until the first waiting team has its lab
+			ev.assignM.Lock()
+			t, ok := ev.waitingQueue.Pop()
+			if !ok {
+				ev.assignM.Unlock()
+				// the waiting team has been deleted in the meantime
+				// lab is given back to the hub
+				lb := make(chan lab.Lab, 1)
+				lb <- l
+				go ev.labhub.Update(lb)
+				break
+			}
 
-	// get public key of server
-	log.Info().Msg("Getting server public key...")
-	serverPubKey, err := ev.wg.GetPublicKey(ctx, &wg.PubKeyReq{PubKeyName: evTag, PrivKeyName: evTag})
-	if err != nil {
-		return []string{}, err
+			if err := ev.AssignLab(t, l); err != nil {
+				log.Error().
+					Err(err).
+					Msgf("lab issue for waiting team %s", t.ID())
+
+				// the team keeps its position and
+				// the lab is given back to the hub
+				ev.removeLab(t.ID())
+				ev.waitingQueue.Requeue(t)
+				ev.assignM.Unlock()
+				lb := make(chan lab.Lab, 1)
+				lb <- l
+				go ev.labhub.Update(lb)
+
+				select {
+				case <-time.After(assignRetryInterval):
+				case <-ev.stop:
+					return
+				}
+				continue
+			}
+			ev.waitingQueue.Assigned()
+			ev.assignM.Unlock()
+			log.Info().
+				Str("team", t.ID()).
+				Int("waiting", ev.waitingQueue.Len()).
+				Msg("Lab is assigned to team from waiting queue")
+		}
 	}
+}
 
-	// create 4 different config file for 1 user
-	for i := lowBound; i < upperBound; i++ {
+// ReplaceLab assigns a lab from the hub to a team whose lab could
+// not be repaired, the previous lab is discarded by the hub
+func (ev *event) ReplaceLab(ctx context.Context, t *store.Team) error {
+	prev, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
 
-		// generate client privatekey
-		ipAddr := pop(&ev.ipAddrs)
+	var l lab.Lab
+	select {
+	case lb, ok := <-ev.labhub.Queue():
+		if !ok {
+			return ErrMaxLabs
+		}
+		l = lb
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 
-		log.Info().Msgf("Generating privatekey for team %s", evTag+"_"+teamID)
-		_, err = ev.wg.GenPrivateKey(ctx, &wg.PrivKeyReq{PrivateKeyName: evTag + "_" + teamID + "_" + strconv.Itoa(ipAddr)})
-		if err != nil {
-			return []string{}, err
+	for _, c := range ev.GetConnections(t) {
+		if err := ev.guac.DeleteConn(c.Id); err != nil {
+			log.Warn().Str("team", t.ID()).Msgf("Unable to delete guacamole connection %s: %v", c.Id, err)
 		}
+	}
 
-		// generate client public key
-		log.Info().Msgf("Generating public key for team %s", evTag+"_"+teamID+"_"+strconv.Itoa(ipAddr))
-		_, err = ev.wg.GenPublicKey(ctx, &wg.PubKeyReq{PubKeyName: evTag + "_" + teamID + "_" + strconv.Itoa(ipAddr), PrivKeyName: evTag + "_" + teamID + "_" + strconv.Itoa(ipAddr)})
-		if err != nil {
-			return []string{}, err
+	if err := ev.AssignLab(t, l); err != nil {
+		// the team keeps its previous lab, which is
+		// replaced again on the next health check
+		ev.setLab(t.ID(), prev)
+		if ev.store.OnlyVPN != docker.OnlyVPN {
+			if err := ev.createGuacConn(t, prev); err != nil {
+				log.Error().Str("team", t.ID()).Msgf("Unable to restore guacamole connections: %v", err)
+			}
 		}
-		// get client public key
-		log.Info().Msgf("Retrieving public key for teaam %s", evTag+"_"+teamID+"_"+strconv.Itoa(ipAddr))
-		resp, err := ev.wg.GetPublicKey(ctx, &wg.PubKeyReq{PubKeyName: evTag + "_" + teamID + "_" + strconv.Itoa(ipAddr)})
-		if err != nil {
-			log.Error().Msgf("Error on GetPublicKey %v", err)
-			return []string{}, err
-		}
-
-		peerIP := strings.Replace(subnet, "240.1/22", fmt.Sprintf("%d.%d/32", i, ipAddr), 2)
-		gwIP := strings.Replace(subnet, "1/22", fmt.Sprintf("1/32"), 1)
-		log.Info().Str("NIC", evTag).
-			Str("AllowedIPs", peerIP).
-			Str("PublicKey ", resp.Message).Msgf("Generating ip address for peer %s, ip address of peer is %s ", teamID, peerIP)
-		addPeerResp, err := ev.wg.AddPeer(ctx, &wg.AddPReq{
-			Nic:        evTag,
-			AllowedIPs: peerIP,
-			PublicKey:  resp.Message,
-		})
-		if err != nil {
-			log.Error().Msgf("Error on adding peer to interface %v", err)
-			return []string{}, err
-		}
-		log.Info().Str("Event: ", evTag).
-			Str("Peer: ", teamID).Msgf("Message : %s", addPeerResp.Message)
-		//get client privatekey
-		log.Info().Msgf("Retrieving private key for team %s", teamID)
-		teamPrivKey, err := ev.wg.GetPrivateKey(ctx, &wg.PrivKeyReq{PrivateKeyName: evTag + "_" + teamID + "_" + strconv.Itoa(ipAddr)})
-		if err != nil {
-			return []string{}, err
-		}
-		log.Info().Msgf("Private key for team %s is %s ", teamID, teamPrivKey.Message)
-		log.Info().Msgf("Client configuration is created for server %s", endpoint)
-		// creating client configuration file
-		clientConfig := fmt.Sprintf(
-			`[Interface]
-Address = %s
-PrivateKey = %s
-DNS = 1.1.1.1
-MTU = 1420
-[Peer]
-PublicKey = %s
-AllowedIps = %s,%s
-Endpoint =  %s
-PersistentKeepalive = 25
-
-# --------------------------------------------------------------------------
-#  YOUR LAB SUBNET IS:  %s 													
-# --------------------------------------------------------------------------
-
-######### << USER SCRIPTS >> #####
-#  
-#	Use following scripts to install wireguard and connect to lab. 
-#
-#   Install Wireguard: %s 
-#  	
-#	Connect Event:  %s
-#
-#   The scripts are automating steps which you do manually. Use them with your responsibility.
-#   If you notice outdated information, help us to update it :) 
-#
-####################
-
-##### HOSTS INFORMATION #############
-#   Append given IP Address(es) with Domain(s) to your /etc/hosts file
-#   It enables you to browse domain of challenge through VPN 
-#   when you connected to internet. 
-#   (* If you used the script given above, your /etc/hosts file is updated already, you can skip this.)
-###################################
-
-%s
-
-####### SETTING VPN CONFIGURATION #########
-
-%s
-
-`, peerIP, teamPrivKey.Message, serverPubKey.Message, labSubnet, gwIP, endpoint, labSubnet, installWireguard, connectWireguard, hosts, vpnInstructions)
-		t.SetVPNKeys(i, resp.Message)
-		teamConfigFiles = append(teamConfigFiles, clientConfig)
-		vpnIPs = append(vpnIPs, peerIP)
-	}
-
-	vpnIPs = append(vpnIPs, labSubnet)
-	ev.ipT.createRejectRule(labSubnet)
-	ev.ipT.createStateRule(labSubnet)
-	ev.ipT.createAcceptRule(labSubnet, strings.Join(vpnIPs, ","))
-	ev.ipRules[teamID] = ipRules{
-		labsubnet: labSubnet,
-		vpnIps:    strings.Join(vpnIPs, ","),
-	}
-	return teamConfigFiles, nil
+		lb := make(chan lab.Lab, 1)
+		lb <- l
+		go ev.labhub.Update(lb)
+		return err
+	}
+	go ev.labhub.Discard(prev)
+
+	log.Info().
+		Str("team", t.ID()).
+		Str("previous", prev.Tag()).
+		Str("lab", l.Tag()).
+		Msg("Lab of team is replaced")
+
+	// progress of the team is brought back from its checkpoint
+	if len(t.Checkpoint()) > 0 {
+		if err := ev.RestoreTeamLab(ctx, t); err != nil {
+			log.Warn().Str("team", t.ID()).Msgf("Unable to restore checkpoint of replaced lab: %v", err)
+		}
+	}
+
+	return nil
 }
 
 //Suspend function suspends event by using from event hub.
@@ -624,6 +782,7 @@ func (ev *event) Resume(ctx context.Context) error {
 
 func (ev *event) Close() error {
 	var waitGroup sync.WaitGroup
+	close(ev.stop)
 
 	for _, closer := range ev.closers {
 		waitGroup.Add(1)
@@ -637,21 +796,13 @@ func (ev *event) Close() error {
 	waitGroup.Wait()
 	if ev.store.OnlyVPN == docker.OnlyVPN || ev.store.OnlyVPN == docker.VPNBrowser {
 		ev.removeVPNConfs()
-		ev.removeIPTableRules()
 	}
+	ev.removeFirewallRules()
+	ev.closeForwards()
 
 	return nil
 }
 
-func (ev *event) removeIPTableRules() {
-	for tid, ipR := range ev.ipRules {
-		log.Debug().Str("Team ID ", tid).Msgf("iptables are removing... ")
-		ev.ipT.removeRejectRule(ipR.labsubnet)
-		ev.ipT.removeStateRule(ipR.labsubnet)
-		ev.ipT.removeAcceptRule(ipR.labsubnet, ipR.vpnIps)
-	}
-}
-
 func (ev *event) removeVPNConfs() {
 	evTag := string(ev.GetConfig().Tag)
 	log.Debug().Msgf("Closing VPN connection for event %s", evTag)
@@ -676,6 +827,7 @@ func (ev *event) Finish(newTag string) {
 	if err != nil {
 		log.Warn().Msgf("error while archiving event: %s", err)
 	}
+	ev.removeCheckpoints()
 }
 
 func removeVPNConfigs(confFile string) error {
@@ -698,8 +850,9 @@ func (ev *event) createGuacConn(t *store.Team, lab lab.Lab) error {
 	createDrivePath := true
 	// Drive path is the home folder inside the docker guacamole
 	drivePath := "/home/" + t.ID()
-	rdpPorts := lab.RdpConnPorts()
-	if n := len(rdpPorts); n == 0 {
+	frontendConns := lab.FrontendConns()
+	exerConns := lab.Environment().Connections()
+	if n := len(frontendConns) + len(exerConns); n == 0 {
 		log.
 			Debug().
 			Int("amount", n).
@@ -712,50 +865,135 @@ func (ev *event) createGuacConn(t *store.Team, lab lab.Lab) error {
 		Password: t.GetHashedPassword(),
 	}
 
-	if err := ev.guac.CreateUser(u.Username, u.Password); err != nil {
-		log.
-			Debug().
-			Str("err", err.Error()).
-			Msg("Unable to create guacamole user")
-		return err
-	}
+	// the user exists when the lab of the team is replaced
+	if _, err := ev.guacUserStore.GetUserForTeam(t.ID()); err != nil {
+		if err := ev.guac.CreateUser(u.Username, u.Password); err != nil {
+			log.
+				Debug().
+				Str("err", err.Error()).
+				Msg("Unable to create guacamole user")
+			return err
+		}
 
-	ev.guacUserStore.CreateUserForTeam(t.ID(), u)
+		ev.guacUserStore.CreateUserForTeam(t.ID(), u)
+	}
 	hostIp, err := ev.dockerHost.GetDockerHostIP()
 	if err != nil {
 		return err
 	}
 
-	for i, port := range rdpPorts {
+	var labConns []amigo.LabConnection
+	for i, fc := range frontendConns {
 		num := i + 1
 		name := fmt.Sprintf("%s-client%d", t.ID(), num)
 
-		log.Debug().Str("team", t.Name()).Uint("port", port).Msg("Creating RDP Connection for group")
-		if err := ev.guac.CreateRDPConn(CreateRDPConnOpts{
-			Host:            hostIp,
-			Port:            port,
-			Name:            name,
-			GuacUser:        u.Username,
-			Username:        &u.Username,
-			Password:        &u.Password,
-			EnableWallPaper: &enableWallPaper,
-			EnableDrive:     &enableDrive,
-			CreateDrivePath: &createDrivePath,
-			DrivePath:       &drivePath,
-		}); err != nil {
+		var id string
+		switch fc.Protocol {
+		case store.VNCProtocol:
+			log.Debug().Str("team", t.Name()).Uint("port", fc.Port).Msg("Creating VNC Connection for group")
+			id, err = ev.guac.CreateVNCConn(CreateVNCConnOpts{
+				Host:     hostIp,
+				Port:     fc.Port,
+				Name:     name,
+				GuacUser: u.Username,
+			})
+		default:
+			log.Debug().Str("team", t.Name()).Uint("port", fc.Port).Msg("Creating RDP Connection for group")
+			id, err = ev.guac.CreateRDPConn(CreateRDPConnOpts{
+				Host:            hostIp,
+				Port:            fc.Port,
+				Name:            name,
+				GuacUser:        u.Username,
+				Username:        &u.Username,
+				Password:        &u.Password,
+				EnableWallPaper: &enableWallPaper,
+				EnableDrive:     &enableDrive,
+				CreateDrivePath: &createDrivePath,
+				DrivePath:       &drivePath,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		labConns = append(labConns, amigo.LabConnection{
+			Id:       id,
+			Name:     fmt.Sprintf("Client %d", num),
+			Protocol: fc.Protocol,
+		})
+	}
+
+	if len(exerConns) > 0 {
+		// exercise servers are only reachable on the lab network
+		if err := ev.guac.ConnectNetwork(lab.Environment().Network()); err != nil {
 			return err
 		}
 	}
 
+	for _, c := range exerConns {
+		c := c
+		name := fmt.Sprintf("%s-%s-%s", t.ID(), c.Tag, c.Protocol)
+
+		var id string
+		switch c.Protocol {
+		case store.SSHProtocol:
+			log.Debug().Str("team", t.Name()).Str("exercise", string(c.Tag)).Msg("Creating SSH Connection for group")
+			id, err = ev.guac.CreateSSHConn(CreateSSHConnOpts{
+				Host:       c.IP,
+				Port:       c.Port,
+				Name:       name,
+				GuacUser:   u.Username,
+				Username:   &c.Username,
+				PrivateKey: &c.PrivateKey,
+			})
+		case store.VNCProtocol:
+			log.Debug().Str("team", t.Name()).Str("exercise", string(c.Tag)).Msg("Creating VNC Connection for group")
+			id, err = ev.guac.CreateVNCConn(CreateVNCConnOpts{
+				Host:     c.IP,
+				Port:     c.Port,
+				Name:     name,
+				GuacUser: u.Username,
+				Password: &c.Password,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		labConns = append(labConns, amigo.LabConnection{
+			Id:       id,
+			Name:     string(c.Tag),
+			Protocol: c.Protocol,
+		})
+	}
+
+	ev.connM.Lock()
+	ev.conns[t.ID()] = labConns
+	ev.connM.Unlock()
+
 	instanceInfo := lab.InstanceInfo()
 	// Will not handle error below since this is not a critical function
 	_ = vbox.CreateUserFolder(t.ID(), string(ev.store.Tag))
 
-	_ = vbox.CreateFolderLink(instanceInfo[0].Id, string(ev.store.Tag), t.ID())
+	// shared folders are only supported by VirtualBox frontends
+	for _, info := range instanceInfo {
+		if info.Type == vbox.InstanceType {
+			_ = vbox.CreateFolderLink(info.Id, string(ev.store.Tag), t.ID())
+			break
+		}
+	}
 
 	return nil
 }
 
+// GetConnections returns the guacamole connections of the team
+func (ev *event) GetConnections(t *store.Team) []amigo.LabConnection {
+	ev.connM.RLock()
+	defer ev.connM.RUnlock()
+
+	return ev.conns[t.ID()]
+}
+
 func getDNSRecords(l []*exercise.DNSRecord) []string {
 	var hosts []string
 	for _, r := range l {
@@ -766,14 +1004,41 @@ func getDNSRecords(l []*exercise.DNSRecord) []string {
 	return hosts
 }
 
+// getHostsInfo formats the records for the hosts page of the
+// team, which also shows the network segment of each host
+func getHostsInfo(l []*exercise.DNSRecord) []string {
+	var hosts []string
+	for _, r := range l {
+		network := r.Network
+		if network == "" {
+			network = store.LabNetwork
+		}
+		for ip, arecord := range r.Record {
+			hosts = append(hosts, fmt.Sprintf("%s \t %s \t %s", ip, arecord, network))
+		}
+	}
+	return hosts
+}
+
 func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
+	// buffered labs are not captured before they are assigned
+	if err := lab.Environment().StartCapture(); err != nil {
+		log.Warn().Str("team", t.ID()).Msgf("Unable to capture traffic of lab: %v", err)
+	}
+
+	if records := ev.GetDNSRecords(); len(records) > 0 {
+		if err := lab.Environment().SetDNSRecords(context.Background(), records); err != nil {
+			log.Warn().Str("team", t.ID()).Msgf("Unable to add DNS records of event to lab: %v", err)
+		}
+	}
+
 	var hosts []string
 	labInfo := &labNetInfo{
 		dns:        lab.Environment().LabDNS(),
 		subnet:     lab.Environment().LabSubnet(),
 		dnsrecords: lab.Environment().DNSRecords(),
 	}
-	hosts = getDNSRecords(labInfo.dnsrecords)
+	hosts = getHostsInfo(labInfo.dnsrecords)
 
 	if ev.store.OnlyVPN == docker.NoVPN {
 		if err := ev.createGuacConn(t, lab); err != nil {
@@ -797,27 +1062,19 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 				return err
 			}
 		}
-		clientConf, err := ev.CreateVPNConn(t, labInfo)
-		if err != nil {
-			return err
-		}
-		//todo[VPN]: update writeToFile function to take directory of conf files
-		// writing configuration into file !
-		//log.Info().Msgf("Client configuration\n %s ", clientConf)
-		//client configuration is written to given dir with following pattern : <event-name>_<team-id>.conf
-		for _, c := range clientConf {
-			if err := writeToFile(ev.store.WireGuardConfig.Dir+string(ev.store.Tag)+"_"+t.ID()+".conf", c); err != nil {
-				log.Error().Msgf("Configuration file create error %v", err)
-			}
-			log.Info().Msg("Client configuration file written to wg dir")
-		}
-		hosts = getDNSRecords(labInfo.dnsrecords)
+		// profiles are created by the team on demand
 		t.SetLabInfo(labInfo.subnet)
-		t.SetVPNConn(clientConf)
 		t.SetHostsInfo(hosts)
 	}
 
-	ev.labs[t.ID()] = lab
+	ev.setLab(t.ID(), lab)
+	ev.vpnM.Lock()
+	err := ev.applyFirewall(t)
+	ev.vpnM.Unlock()
+	if err != nil {
+		log.Error().Msgf("Error on applying firewall rules for team %s: %v", t.ID(), err)
+		return err
+	}
 	chals := lab.Environment().Challenges()
 
 	for _, chal := range chals {
@@ -831,6 +1088,10 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 			Str("chal-val", chal.Value).
 			Msgf("Flag is created for team %s [assignlab function] ", t.Name())
 	}
+	if ev.store.PortForward {
+		// TCP services are reachable before the team opens the hosts page
+		ev.forwardedServices(t)
+	}
 	t.CorrectedAssignedLab()
 	return nil
 }
@@ -838,23 +1099,47 @@ func (ev *event) AssignLab(t *store.Team, lab lab.Lab) error {
 func (ev *event) Handler() http.Handler {
 
 	reghook := func(t *store.Team) error {
+		// checking the waiting queue and taking a lab of the hub is
+		// atomic, such that teams are served in the order they came
+		ev.assignM.Lock()
+		defer ev.assignM.Unlock()
+
+		// teams which are already waiting are served first
+		if ev.waitingQueue.Len() > 0 {
+			ev.waitingQueue.Push(t)
+			return amigo.ErrTeamQueued
+		}
+
 		select {
 		case l, ok := <-ev.labhub.Queue():
 			if !ok {
 				return ErrMaxLabs
 			}
 			if err := ev.AssignLab(t, l); err != nil {
+				ev.removeLab(t.ID())
+				lb := make(chan lab.Lab, 1)
+				lb <- l
+				go ev.labhub.Update(lb)
 				return err
 			}
 
 		default:
-
-			return ErrNoAvailableLabs
+			pos := ev.waitingQueue.Push(t)
+			log.Info().
+				Str("team", t.ID()).
+				Int("position", pos).
+				Msg("No available labs, team is added to waiting queue")
+			return amigo.ErrTeamQueued
 		}
 
 		return nil
 	}
 
+	queueStatus := func(t *store.Team) (int, time.Duration) {
+		pos := ev.waitingQueue.Position(t.ID())
+		return pos, ev.waitingQueue.ETA(pos)
+	}
+
 	resetHook := func(t *store.Team, challengeTag string) error {
 		teamLab, ok := ev.GetLabByTeam(t.ID())
 		if !ok {
@@ -899,21 +1184,14 @@ func (ev *event) Handler() http.Handler {
 		return startStopErr
 	}
 
-	// resume labs in login of amigo
+	// resume labs in login of amigo and on guacamole connections
 	resumeTeamLab := func(t *store.Team) error {
-		var waitGroup sync.WaitGroup
-		lab, ok := ev.GetLabByTeam(t.ID())
-		if !ok {
+		if _, ok := ev.GetLabByTeam(t.ID()); !ok {
 			return errors.New("Lab could not found for given team, error on loginhook")
 		}
-		waitGroup.Add(1)
-		go func() {
-			defer waitGroup.Done()
-			if err := lab.Resume(context.Background()); err != nil {
-				log.Error().Msgf("Error on lab resume %v", err)
-			}
-		}()
-		waitGroup.Wait()
+		if err := ev.ResumeTeamLab(context.Background(), t); err != nil {
+			log.Error().Msgf("Error on lab resume %v", err)
+		}
 		return nil
 	}
 
@@ -928,19 +1206,100 @@ func (ev *event) Handler() http.Handler {
 		return nil
 	}
 
+	snapshotHooks := amigo.SnapshotHooks{
+		List: func(t *store.Team) ([]amigo.FrontendSnapshot, error) {
+			teamLab, ok := ev.GetLabByTeam(t.ID())
+			if !ok {
+				return nil, fmt.Errorf("Not found suitable team for given id: %s", t.ID())
+			}
+			var snapshots []amigo.FrontendSnapshot
+			for _, s := range teamLab.Snapshots() {
+				snapshots = append(snapshots, amigo.FrontendSnapshot{
+					Name:      s.Name,
+					CreatedAt: s.CreatedAt.Format(displayTimeFormat),
+					Size:      s.Size,
+				})
+			}
+			return snapshots, nil
+		},
+		Take: func(t *store.Team, name string) error {
+			teamLab, ok := ev.GetLabByTeam(t.ID())
+			if !ok {
+				return fmt.Errorf("Not found suitable team for given id: %s", t.ID())
+			}
+			_, err := teamLab.TakeSnapshot(context.Background(), name)
+			return err
+		},
+		Restore: func(t *store.Team, name string) error {
+			teamLab, ok := ev.GetLabByTeam(t.ID())
+			if !ok {
+				return fmt.Errorf("Not found suitable team for given id: %s", t.ID())
+			}
+			return teamLab.RestoreSnapshot(context.Background(), name, string(ev.store.Tag), t.ID())
+		},
+		Delete: func(t *store.Team, name string) error {
+			teamLab, ok := ev.GetLabByTeam(t.ID())
+			if !ok {
+				return fmt.Errorf("Not found suitable team for given id: %s", t.ID())
+			}
+			return teamLab.DeleteSnapshot(context.Background(), name)
+		},
+	}
+
+	// teams can only download their capture when allowed
+	var captureHook func(t *store.Team, w io.Writer) error
+	if ev.capture != nil && ev.capture.TeamDownload {
+		captureHook = func(t *store.Team, w io.Writer) error {
+			teamLab, ok := ev.GetLabByTeam(t.ID())
+			if !ok {
+				return fmt.Errorf("Not found suitable team for given id: %s", t.ID())
+			}
+			if err := teamLab.Capture(context.Background(), w); err != nil {
+				if err == lab.ErrNoCapture {
+					return amigo.ErrCaptureUnavailable
+				}
+				return err
+			}
+			return nil
+		}
+	}
+
+	vpnHooks := amigo.VPNHooks{
+		Create:     ev.CreateVPNProfile,
+		Revoke:     ev.RevokeVPNProfile,
+		Regenerate: ev.RegenerateVPNProfile,
+	}
+
 	hooks := amigo.Hooks{
 		AssignLab:         reghook,
 		ResetExercise:     resetHook,
 		StartStopExercise: startStopHook,
 		ResetFrontend:     resetFrontendHook,
 		ResumeTeamLab:     resumeTeamLab,
+		QueueStatus:       queueStatus,
+		Snapshots:         snapshotHooks,
+		VPN:               vpnHooks,
+		Connections:       ev.GetConnections,
+		Capture:           captureHook,
+	}
+	if ev.store.PortForward {
+		hooks.Services = ev.forwardedServices
+	}
+	if ev.terminalLoggerPool != nil {
+		hooks.Terminal = ev.openTerminal
 	}
 
-	guacHandler := ev.guac.ProxyHandler(ev.guacUserStore, ev.keyLoggerPool, ev.amigo, ev)(ev.store)
+	guacHandler := ev.guac.ProxyHandler(ev.guacUserStore, ev.keyLoggerPool, ev.recordings, ev.amigo, ev)(ev.store)
 
 	return ev.amigo.Handler(hooks, guacHandler)
 }
 
+// GetRecordings returns the recordings of the connections
+// of the teams, it is nil when they are not recorded
+func (ev *event) GetRecordings() RecordingPool {
+	return ev.recordings
+}
+
 func (ev *event) GetHub() lab.Hub {
 	return ev.labhub
 }
@@ -961,7 +1320,16 @@ func (ev *event) GetTeamById(teamid string) (*store.Team, error) {
 	return t, nil
 }
 
+// GetWaitingTeams returns teams which are waiting for a lab
+// in the order they will be assigned
+func (ev *event) GetWaitingTeams() []*store.Team {
+	return ev.waitingQueue.Teams()
+}
+
 func (ev *event) GetLabByTeam(teamId string) (lab.Lab, bool) {
+	ev.labsM.RLock()
+	defer ev.labsM.RUnlock()
+
 	lab, ok := ev.labs[teamId]
 	return lab, ok
 }
@@ -980,28 +1348,12 @@ func writeToFile(filename string, data string) error {
 	return file.Sync()
 }
 
-// creates range of ip addresses per event
-func makeRange(min, max int) []int {
-	a := make([]int, max-min+1)
-	for i := range a {
-		a[i] = min + i
-	}
-	return a
-}
-
-//pop function is somehow same with python pop function
-func pop(alist *[]int) int {
-	f := len(*alist)
-	rv := (*alist)[f-1]
-	*alist = append((*alist)[:f-1])
-	return rv
-}
-
 // get page content
 func getContent(link string) string {
 	res, err := http.Get(link)
 	if err != nil {
 		log.Debug().Msgf("Error on retrieving link [ %s ] Err: [ %v ]", link, err)
+		return ""
 	}
 	content, err := ioutil.ReadAll(res.Body)
 	res.Body.Close()
@@ -90,10 +90,12 @@ func TestEvent_StartAndClose(t *testing.T) {
 		}, tmp, client)
 
 		ev := event{
-			guac:    &guac,
-			labhub:  &hub,
-			closers: []io.Closer{&guac, &hub},
-			store:   ts,
+			guac:         &guac,
+			labhub:       &hub,
+			closers:      []io.Closer{&guac, &hub},
+			store:        ts,
+			waitingQueue: newTeamQueue(),
+			stop:         make(chan struct{}),
 		}
 
 		ev.Start(context.Background())
@@ -113,3 +115,27 @@ func TestEvent_StartAndClose(t *testing.T) {
 	})
 
 }
+
+func TestGetAssignedLabs(t *testing.T) {
+	ev := &event{labs: map[string]lab.Lab{"a": &testLab{}}}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			ev.setLab("b", &testLab{})
+			ev.removeLab("b")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		for range ev.GetAssignedLabs() {
+		}
+	}
+	<-done
+
+	labs := ev.GetAssignedLabs()
+	delete(labs, "a")
+	if _, ok := ev.GetLabByTeam("a"); !ok {
+		t.Fatalf("expected assigned labs to be a copy")
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+// restrictsEgress returns true when the labs of the event
+// need firewall rules, labs of VPN events are only reachable
+// from the VPN peers of their team
+func (ev *event) restrictsEgress() bool {
+	return ev.store.OnlyVPN != docker.NoVPN || ev.store.Egress.IsSet()
+}
+
+// labRules returns the firewall rules of the lab of the team
+func (ev *event) labRules(t *store.Team) (firewall.LabRules, bool) {
+	lab, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return firewall.LabRules{}, false
+	}
+
+	subnet := lab.Environment().LabSubnet()
+	if !strings.Contains(subnet, "/") {
+		subnet = fmt.Sprintf("%s/24", subnet)
+	}
+	policy := firewall.Policy{
+		BlockInternet: ev.store.Egress.BlockInternet,
+		Allow:         ev.store.Egress.Allow,
+		Isolate:       ev.store.Egress.Isolate,
+	}
+	var peers, peers6 []string
+	if ev.store.OnlyVPN != docker.NoVPN {
+		policy.BlockInternet = true
+		for _, p := range t.GetVPNProfiles() {
+			peers = append(peers, p.PeerIP)
+			if ev.store.IPv6 {
+				peers6 = append(peers6, vpnIPv6(p.PeerIP))
+			}
+		}
+	}
+
+	return firewall.LabRules{
+		Name:      lab.Tag(),
+		Subnet:    subnet,
+		Subnet6:   lab.Environment().LabSubnet6(),
+		Peers:     peers,
+		Peers6:    peers6,
+		Policy:    policy,
+		Instances: lab.Environment().InstanceEgress(),
+	}, true
+}
+
+// applyFirewall creates or replaces the firewall rules of the lab of
+// the team, the caller holds vpnM which guards the rules of all labs
+func (ev *event) applyFirewall(t *store.Team) error {
+	if !ev.restrictsEgress() {
+		return nil
+	}
+	rules, ok := ev.labRules(t)
+	if !ok {
+		return nil
+	}
+
+	if prev, ok := ev.fwLabs[t.ID()]; ok && prev != rules.Name {
+		if err := ev.fw.Remove(prev); err != nil {
+			log.Warn().Str("team", t.ID()).Msgf("Unable to remove firewall rules of previous lab: %v", err)
+		}
+	}
+	if err := ev.fw.Apply(rules); err != nil {
+		return err
+	}
+	ev.fwLabs[t.ID()] = rules.Name
+
+	return nil
+}
+
+func (ev *event) removeFirewallRules() {
+	ev.vpnM.Lock()
+	defer ev.vpnM.Unlock()
+
+	for tid, name := range ev.fwLabs {
+		log.Debug().Str("Team ID ", tid).Msgf("firewall rules are removing... ")
+		if err := ev.fw.Remove(name); err != nil {
+			log.Warn().Str("team", tid).Msgf("Unable to remove firewall rules: %v", err)
+		}
+		delete(ev.fwLabs, tid)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/network/forward"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/amigo"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	UnknownServiceErr = errors.New("lab of the team has no such service")
+)
+
+// tcpForward is a TCP service of a team forwarded to a port of its block
+type tcpForward struct {
+	port    int
+	address string
+	tcp     *forward.TCP
+}
+
+// ForwardHandler serves the HTTP services of the labs on the
+// subdomains of their teams, e.g. web1.<team>.<event>.<host>
+func (ev *event) ForwardHandler() http.Handler {
+	return ev.amigo.ForwardHandler(ev.lookupService)
+}
+
+func (ev *event) lookupService(t *store.Team, name string) (http.Handler, error) {
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return nil, fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
+
+	// lab might have been suspended while the team was idle
+	idle := t.IsIdle()
+	t.MarkActive(store.ForwardActivity)
+	if idle {
+		if err := ev.ResumeTeamLab(context.Background(), t); err != nil {
+			log.Error().Str("team", t.ID()).Msgf("Error on resuming lab of forwarded service: %v", err)
+		}
+	}
+
+	for _, s := range l.Environment().Services() {
+		if s.Name == name && s.Protocol == store.HTTPService {
+			return ev.proxy(s.Address), nil
+		}
+	}
+
+	return nil, UnknownServiceErr
+}
+
+// proxy returns the reverse proxy of the address of a service,
+// proxies are kept as addresses are kept across resets of labs
+func (ev *event) proxy(address string) http.Handler {
+	ev.fwdM.Lock()
+	defer ev.fwdM.Unlock()
+
+	if p, ok := ev.proxies[address]; ok {
+		return p
+	}
+
+	p := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: address})
+	ev.proxies[address] = p
+
+	return p
+}
+
+// forwardedServices returns the services forwarded from the lab of the
+// team, TCP services of exercises added since the last call are forwarded
+func (ev *event) forwardedServices(t *store.Team) []amigo.ForwardedService {
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return nil
+	}
+	services := l.Environment().Services()
+	ports := ev.forwardTCP(t, services)
+
+	var res []amigo.ForwardedService
+	for _, s := range services {
+		fs := amigo.ForwardedService{Name: s.Name, Protocol: s.Protocol}
+		if s.Protocol == store.TCPService {
+			port, ok := ports[s.Name]
+			if !ok {
+				continue
+			}
+			fs.Address = net.JoinHostPort(ev.store.Host, strconv.Itoa(port))
+		}
+		res = append(res, fs)
+	}
+
+	return res
+}
+
+// forwardTCP forwards the TCP services of the team to the ports of its
+// block, a service keeps its port while the team has a lab, it returns
+// the ports of the services by their name
+func (ev *event) forwardTCP(t *store.Team, services []exercise.Service) map[string]int {
+	if ev.ports == nil {
+		return nil
+	}
+
+	ev.fwdM.Lock()
+	defer ev.fwdM.Unlock()
+
+	fwds, ok := ev.tcpFwds[t.ID()]
+	if !ok {
+		fwds = map[string]*tcpForward{}
+		ev.tcpFwds[t.ID()] = fwds
+	}
+
+	var first int
+	ports := map[string]int{}
+	for _, s := range services {
+		if s.Protocol != store.TCPService {
+			continue
+		}
+
+		if f, ok := fwds[s.Name]; ok && f.address == s.Address {
+			ports[s.Name] = f.port
+			continue
+		}
+
+		if first == 0 {
+			var err error
+			first, err = ev.ports.Allocate(ev.portsOwner(t))
+			if err != nil {
+				log.Warn().Str("team", t.ID()).Msgf("Unable to forward TCP services: %v", err)
+				return ports
+			}
+		}
+
+		port := 0
+		if f, ok := fwds[s.Name]; ok {
+			// address of the service has changed
+			f.tcp.Close()
+			port = f.port
+		} else {
+			port = freePort(first, ev.ports.PerTeam(), fwds)
+		}
+		if port == 0 {
+			log.Warn().Str("team", t.ID()).Str("service", s.Name).Msg("No ports are left in the block of the team")
+			continue
+		}
+
+		tcp, err := forward.ListenTCP(ev.ports.Address(), port, s.Address)
+		if err != nil {
+			log.Warn().Str("team", t.ID()).Str("service", s.Name).Msgf("Unable to forward TCP service: %v", err)
+			delete(fwds, s.Name)
+			continue
+		}
+		fwds[s.Name] = &tcpForward{port: port, address: s.Address, tcp: tcp}
+		ports[s.Name] = port
+	}
+
+	return ports
+}
+
+func freePort(first, n int, fwds map[string]*tcpForward) int {
+	used := map[int]bool{}
+	for _, f := range fwds {
+		used[f.port] = true
+	}
+	for port := first; port < first+n; port++ {
+		if !used[port] {
+			return port
+		}
+	}
+
+	return 0
+}
+
+func (ev *event) portsOwner(t *store.Team) string {
+	return fmt.Sprintf("%s/%s", ev.store.Tag, t.ID())
+}
+
+// closeForwards stops forwarding the TCP services of all teams
+// and releases their blocks of ports
+func (ev *event) closeForwards() {
+	ev.fwdM.Lock()
+	defer ev.fwdM.Unlock()
+
+	for id, fwds := range ev.tcpFwds {
+		for _, f := range fwds {
+			f.tcp.Close()
+		}
+		ev.ports.Release(fmt.Sprintf("%s/%s", ev.store.Tag, id))
+	}
+	ev.tcpFwds = map[string]map[string]*tcpForward{}
+}
@@ -7,6 +7,7 @@ package guacamole
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aau-network-security/haaukins/virtual/vbox"
@@ -41,6 +43,7 @@ var (
 	IncorrectColorErr = errors.New("colorDepth can take the following values: 8, 16, 24, 32")
 	UnexpectedRespErr = errors.New("unexpected response from Guacamole")
 	SessionErr        = errors.New("session must exist")
+	NoGuacdErr        = errors.New("guacd container is not running")
 
 	DefaultAdminUser = "guacadmin"
 	DefaultAdminPass = "guacadmin"
@@ -71,6 +74,8 @@ type guacamole struct {
 	client     *http.Client
 	webPort    uint
 	containers map[string]docker.Container
+	netM       sync.Mutex
+	networks   map[docker.Network]struct{}
 }
 
 type createUserAttributes struct {
@@ -97,11 +102,15 @@ type Guacamole interface {
 	io.Closer
 	Start(context.Context) error
 	CreateUser(username, password string) error
-	CreateRDPConn(opts CreateRDPConnOpts) error
+	CreateRDPConn(opts CreateRDPConnOpts) (string, error)
+	CreateVNCConn(opts CreateVNCConnOpts) (string, error)
+	CreateSSHConn(opts CreateSSHConnOpts) (string, error)
+	DeleteConn(id string) error
+	ConnectNetwork(docker.Network) error
 	GetAdminPass() string
 	GetPort() uint
 	RawLogin(username, password string) ([]byte, error)
-	ProxyHandler(us *GuacUserStore, klp KeyLoggerPool, am *amigo.Amigo, event Event) svcs.ProxyConnector
+	ProxyHandler(us *GuacUserStore, klp KeyLoggerPool, rp RecordingPool, am *amigo.Amigo, event Event) svcs.ProxyConnector
 }
 
 func New(ctx context.Context, conf Config, onlyVPN int32, eventTag string) (Guacamole, error) {
@@ -261,7 +270,7 @@ func (guac *guacamole) stop() error {
 	return nil
 }
 
-func (guac *guacamole) ProxyHandler(us *GuacUserStore, klp KeyLoggerPool, am *amigo.Amigo, ev Event) svcs.ProxyConnector {
+func (guac *guacamole) ProxyHandler(us *GuacUserStore, klp KeyLoggerPool, rp RecordingPool, am *amigo.Amigo, ev Event) svcs.ProxyConnector {
 	loginFunc := func(u string, p string) (string, error) {
 		content, err := guac.RawLogin(u, p)
 		if err != nil {
@@ -289,7 +298,7 @@ func (guac *guacamole) ProxyHandler(us *GuacUserStore, klp KeyLoggerPool, am *am
 		return interceptors.Intercept(http.HandlerFunc(
 			func(w http.ResponseWriter, r *http.Request) {
 				if isWebSocket(r) {
-					websocketProxy(host, ef, klp, am).ServeHTTP(w, r)
+					websocketProxy(host, ef, klp, rp, am).ServeHTTP(w, r)
 					return
 				}
 				proxy.ServeHTTP(w, r)
@@ -537,7 +546,7 @@ func (guac *guacamole) logout() error {
 	return nil
 }
 
-type createRDPConnAttr struct {
+type createConnAttr struct {
 	FailOverOnly     *bool   `json:"failover-only"`
 	GuacdEncripytion *string `json:"guacd-encryption"`
 	GuacdPort        *uint   `json:"guacd-port"`
@@ -604,17 +613,9 @@ type CreateRDPConnOpts struct {
 	DrivePath        *string
 }
 
-func (guac *guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
-	if opts.Host == "" {
-		return NoHostErr
-	}
-
-	if opts.Port == 0 {
-		return NoPortErr
-	}
-
-	if opts.Name == "" {
-		return NoNameErr
+func (guac *guacamole) CreateRDPConn(opts CreateRDPConnOpts) (string, error) {
+	if err := validateConn(opts.Host, opts.Port, opts.Name); err != nil {
+		return "", err
 	}
 
 	if opts.ResolutionWidth == 0 || opts.ResolutionHeight == 0 {
@@ -622,18 +623,16 @@ func (guac *guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 		opts.ResolutionHeight = 1080
 	}
 
-	if opts.MaxConn == 0 {
-		opts.MaxConn = 10
-	}
-
 	if opts.ColorDepth%8 != 0 || opts.ColorDepth > 32 {
-		return IncorrectColorErr
+		return "", IncorrectColorErr
 	}
 
 	if opts.ColorDepth == 0 {
 		opts.ColorDepth = 16
 	}
-	log.Debug().Str("drive-path", *opts.DrivePath).Msg("Drivepath for user is")
+	if opts.DrivePath != nil {
+		log.Debug().Str("drive-path", *opts.DrivePath).Msg("Drivepath for user is")
+	}
 	conf := createRDPConnConf{
 		Hostname:        &opts.Host,
 		Width:           &opts.ResolutionWidth,
@@ -648,21 +647,137 @@ func (guac *guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 		DrivePath:       opts.DrivePath,
 	}
 
+	return guac.createConn(opts.Name, store.RDPProtocol, opts.GuacUser, opts.MaxConn, conf)
+}
+
+type createVNCConnConf struct {
+	Hostname   *string `json:"hostname"`
+	Port       *uint   `json:"port"`
+	Password   *string `json:"password,omitempty"`
+	ColorDepth *uint   `json:"color-depth"`
+	Cursor     *string `json:"cursor,omitempty"`
+}
+
+type CreateVNCConnOpts struct {
+	Host       string
+	Port       uint
+	Name       string
+	GuacUser   string
+	Password   *string
+	ColorDepth uint
+	MaxConn    uint
+}
+
+func (guac *guacamole) CreateVNCConn(opts CreateVNCConnOpts) (string, error) {
+	if err := validateConn(opts.Host, opts.Port, opts.Name); err != nil {
+		return "", err
+	}
+
+	if opts.ColorDepth%8 != 0 || opts.ColorDepth > 32 {
+		return "", IncorrectColorErr
+	}
+
+	if opts.ColorDepth == 0 {
+		opts.ColorDepth = 24
+	}
+
+	// the remote cursor keeps the pointer in sync with the desktop
+	cursor := "remote"
+	conf := createVNCConnConf{
+		Hostname:   &opts.Host,
+		Port:       &opts.Port,
+		Password:   opts.Password,
+		ColorDepth: &opts.ColorDepth,
+		Cursor:     &cursor,
+	}
+
+	return guac.createConn(opts.Name, store.VNCProtocol, opts.GuacUser, opts.MaxConn, conf)
+}
+
+type createSSHConnConf struct {
+	Hostname    *string `json:"hostname"`
+	Port        *uint   `json:"port"`
+	Username    *string `json:"username,omitempty"`
+	Password    *string `json:"password,omitempty"`
+	PrivateKey  *string `json:"private-key,omitempty"`
+	ColorScheme *string `json:"color-scheme,omitempty"`
+	FontSize    *uint   `json:"font-size,omitempty"`
+}
+
+type CreateSSHConnOpts struct {
+	Host       string
+	Port       uint
+	Name       string
+	GuacUser   string
+	Username   *string
+	Password   *string
+	PrivateKey *string
+	FontSize   uint
+	MaxConn    uint
+}
+
+func (guac *guacamole) CreateSSHConn(opts CreateSSHConnOpts) (string, error) {
+	if err := validateConn(opts.Host, opts.Port, opts.Name); err != nil {
+		return "", err
+	}
+
+	if opts.FontSize == 0 {
+		opts.FontSize = 12
+	}
+
+	colorScheme := "green-black"
+	conf := createSSHConnConf{
+		Hostname:    &opts.Host,
+		Port:        &opts.Port,
+		Username:    opts.Username,
+		Password:    opts.Password,
+		PrivateKey:  opts.PrivateKey,
+		ColorScheme: &colorScheme,
+		FontSize:    &opts.FontSize,
+	}
+
+	return guac.createConn(opts.Name, store.SSHProtocol, opts.GuacUser, opts.MaxConn, conf)
+}
+
+func validateConn(host string, port uint, name string) error {
+	if host == "" {
+		return NoHostErr
+	}
+
+	if port == 0 {
+		return NoPortErr
+	}
+
+	if name == "" {
+		return NoNameErr
+	}
+
+	return nil
+}
+
+// createConn creates a connection with the given protocol specific
+// parameters and grants the guacamole user access to it, the
+// identifier of the connection is returned
+func (guac *guacamole) createConn(name, protocol, guacUser string, maxConn uint, params interface{}) (string, error) {
+	if maxConn == 0 {
+		maxConn = 10
+	}
+
 	data := struct {
-		Name             string            `json:"name"`
-		ParentIdentifier string            `json:"parentIdentifier"`
-		Protocol         string            `json:"protocol"`
-		Attributes       createRDPConnAttr `json:"attributes"`
-		Parameters       createRDPConnConf `json:"parameters"`
+		Name             string         `json:"name"`
+		ParentIdentifier string         `json:"parentIdentifier"`
+		Protocol         string         `json:"protocol"`
+		Attributes       createConnAttr `json:"attributes"`
+		Parameters       interface{}    `json:"parameters"`
 	}{
-		Name:             opts.Name,
+		Name:             name,
 		ParentIdentifier: "ROOT",
-		Protocol:         "rdp",
-		Attributes: createRDPConnAttr{
-			MaxConn:        opts.MaxConn,
-			MaxConnPerUser: opts.MaxConn,
+		Protocol:         protocol,
+		Attributes: createConnAttr{
+			MaxConn:        maxConn,
+			MaxConnPerUser: maxConn,
 		},
-		Parameters: conf,
+		Parameters: params,
 	}
 
 	jsonData, _ := json.Marshal(data)
@@ -681,17 +796,71 @@ func (guac *guacamole) CreateRDPConn(opts CreateRDPConnOpts) error {
 	var out struct {
 		Id string `json:"identifier"`
 	}
-	if err := guac.authAction("create rdp connection", action, &out); err != nil {
-		return err
+	if err := guac.authAction(fmt.Sprintf("create %s connection", protocol), action, &out); err != nil {
+		return "", err
+	}
+
+	if err := guac.addConnectionToUser(out.Id, guacUser); err != nil {
+		return "", err
 	}
 
-	if err := guac.addConnectionToUser(out.Id, opts.GuacUser); err != nil {
+	return out.Id, nil
+}
+
+// DeleteConn removes a connection, e.g. when the
+// lab of a team has been replaced
+func (guac *guacamole) DeleteConn(id string) error {
+	action := func(t string) (*http.Response, error) {
+		endpoint := fmt.Sprintf("%s/guacamole/api/session/data/mysql/connections/%s?token=%s",
+			guac.baseUrl(),
+			id,
+			t)
+
+		req, err := http.NewRequest("DELETE", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return guac.client.Do(req)
+	}
+
+	return guac.authAction("delete connection", action, nil)
+}
+
+// ConnectNetwork connects guacd to a lab network, such
+// that it can reach the SSH and VNC servers of exercises
+func (guac *guacamole) ConnectNetwork(net docker.Network) error {
+	guac.netM.Lock()
+	defer guac.netM.Unlock()
+
+	if _, ok := guac.networks[net]; ok {
+		return nil
+	}
+
+	guacd, ok := guac.containers["guacd"]
+	if !ok {
+		return NoGuacdErr
+	}
+
+	if _, err := net.Connect(guacd); err != nil {
 		return err
 	}
 
+	if guac.networks == nil {
+		guac.networks = map[docker.Network]struct{}{}
+	}
+	guac.networks[net] = struct{}{}
+
 	return nil
 }
 
+// ClientURL returns the path of the guacamole web
+// client which opens the connection with the given id
+func ClientURL(id string) string {
+	clientId := base64.StdEncoding.EncodeToString([]byte(id + "\x00c\x00mysql"))
+	return "/guacamole/#/client/" + clientId
+}
+
 func (guac *guacamole) addConnectionToUser(id string, guacuser string) error {
 	data := []struct {
 		Operation string `json:"op"`
@@ -727,7 +896,7 @@ func (guac *guacamole) addConnectionToUser(id string, guacuser string) error {
 	return nil
 }
 
-func websocketProxy(target string, ef store.Event, keyLoggerPool KeyLoggerPool, am *amigo.Amigo) http.Handler {
+func websocketProxy(target string, ef store.Event, keyLoggerPool KeyLoggerPool, recordings RecordingPool, am *amigo.Amigo) http.Handler {
 	origin := fmt.Sprintf("http://%s", target)
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -777,11 +946,28 @@ func websocketProxy(target string, ef store.Event, keyLoggerPool KeyLoggerPool,
 		}
 		defer c.Close()
 
+		// the instructions of guacamole to the team are recorded
+		var record []func(RawFrame)
+		if recordings != nil {
+			rec, err := recordings.Record(t)
+			if err != nil {
+				log.Warn().Str("id", t.ID()).Msgf("Failed to record connection: %s", err)
+			} else {
+				defer rec.Close()
+				record = append(record, rec.Record)
+			}
+		}
+
 		errClient := make(chan error, 1)
 		errBackend := make(chan error, 1)
 
-		cp := func(logger KeyLogger) func(src *websocket.Conn, dst *websocket.Conn, errc chan error) {
-			var actions []func(RawFrame)
+		markActive := func(rawFrame RawFrame) {
+			if IsUserInput(rawFrame) {
+				t.MarkActive(store.GuacamoleActivity)
+			}
+		}
+
+		cp := func(logger KeyLogger, actions ...func(RawFrame)) func(src *websocket.Conn, dst *websocket.Conn, errc chan error) {
 			if logger != nil {
 				actions = append(actions, logger.Log)
 			}
@@ -807,8 +993,8 @@ func websocketProxy(target string, ef store.Event, keyLoggerPool KeyLoggerPool,
 			}
 		}
 
-		go cp(logger)(c, backend, errClient)
-		go cp(nil)(backend, c, errClient)
+		go cp(logger, markActive)(c, backend, errClient)
+		go cp(nil, record...)(backend, c, errClient)
 
 		log.Debug().
 			Str("id", t.ID()).
@@ -0,0 +1,191 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	wg "github.com/aau-network-security/haaukins/network/vpn"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog/log"
+)
+
+// idleTeam is a team whose lab the idle policy is applied on
+type idleTeam struct {
+	team     *store.Team
+	lab      lab.Lab
+	decision store.IdleDecision
+}
+
+func (ev *event) GetIdlePolicy() store.IdlePolicy {
+	ev.idleM.Lock()
+	defer ev.idleM.Unlock()
+	return ev.store.IdlePolicy.WithDefaults()
+}
+
+// SetIdlePolicy changes the idle policy of a running event
+// and saves it, so the policy is kept when the event is reloaded
+func (ev *event) SetIdlePolicy(p store.IdlePolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	p = p.WithDefaults()
+
+	ev.idleM.Lock()
+	defer ev.idleM.Unlock()
+	if err := ev.store.SetIdlePolicy(p); err != nil {
+		return fmt.Errorf("unable to save idle policy: %v", err)
+	}
+	ev.store.IdlePolicy = p
+	return nil
+}
+
+// CheckIdleTeams applies the idle policy of the event on labs of
+// teams without any activity within the idle threshold, labs which
+// are idle are resumed when activity is registered through the VPN
+func (ev *event) CheckIdleTeams(ctx context.Context) {
+	if ev.store.OnlyVPN != docker.NoVPN {
+		ev.refreshVPNActivity(ctx)
+	}
+
+	// teams are collected under the lock, labs are suspended,
+	// stopped or resumed after it is released
+	now := time.Now()
+	var idleTeams []idleTeam
+	var activeTeams []*store.Team
+	ev.idleM.Lock()
+	policy := ev.store.IdlePolicy.WithDefaults()
+	for _, t := range ev.GetTeams() {
+		l, ok := ev.GetLabByTeam(t.ID())
+		if !ok {
+			continue
+		}
+		lastActivity, source := t.LastActivity()
+
+		if t.IsIdle() {
+			if d, _ := t.IdleDecision(); lastActivity.After(d.At) {
+				activeTeams = append(activeTeams, t)
+			}
+			continue
+		}
+
+		if policy.IsExempt(t.ID()) {
+			continue
+		}
+
+		idle := now.Sub(lastActivity)
+		if idle <= policy.Threshold {
+			continue
+		}
+
+		idleTeams = append(idleTeams, idleTeam{
+			team: t,
+			lab:  l,
+			decision: store.IdleDecision{
+				Action:       policy.Action,
+				At:           now,
+				LastActivity: lastActivity,
+				Source:       source,
+				Threshold:    policy.Threshold,
+			},
+		})
+	}
+	ev.idleM.Unlock()
+
+	for _, t := range activeTeams {
+		if err := ev.ResumeTeamLab(ctx, t); err != nil {
+			log.Error().Str("team", t.ID()).Msgf("Error on resuming idle lab: %v", err)
+		}
+	}
+
+	for _, it := range idleTeams {
+		t, l, d := it.team, it.lab, it.decision
+		log.Info().
+			Str("event", string(ev.store.Tag)).
+			Str("team", t.ID()).
+			Str("lab", l.Tag()).
+			Str("action", string(d.Action)).
+			Str("activity-source", d.Source).
+			Time("last-activity", d.LastActivity).
+			Dur("idle", now.Sub(d.LastActivity)).
+			Dur("threshold", d.Threshold).
+			Msg("Team is idle, applying idle policy")
+
+		var err error
+		switch d.Action {
+		case store.IdleStop:
+			err = l.Stop()
+		default:
+			err = l.Suspend(ctx)
+		}
+		if err != nil {
+			log.Error().Str("team", t.ID()).Msgf("Error on applying idle policy: %v", err)
+			continue
+		}
+		t.SetIdleDecision(d)
+	}
+}
+
+// ResumeTeamLab resumes the lab of the team, labs which
+// are stopped by the idle policy are started again
+func (ev *event) ResumeTeamLab(ctx context.Context, t *store.Team) error {
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
+
+	ev.idleM.Lock()
+	defer ev.idleM.Unlock()
+
+	d, ok := t.IdleDecision()
+	idle := ok && d.ResumedAt.IsZero()
+
+	var err error
+	if idle && d.Action == store.IdleStop {
+		err = l.Start(ctx)
+	} else {
+		err = l.Resume(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if idle {
+		t.ResumeFromIdle()
+		log.Info().
+			Str("event", string(ev.store.Tag)).
+			Str("team", t.ID()).
+			Str("lab", l.Tag()).
+			Str("action", string(d.Action)).
+			Msg("Resumed lab of idle team")
+	}
+
+	return nil
+}
+
+// refreshVPNActivity registers activity for teams
+// which have a connected VPN peer
+func (ev *event) refreshVPNActivity(ctx context.Context) {
+	for _, t := range ev.GetTeams() {
+		for _, p := range t.GetVPNProfiles() {
+			resp, err := ev.wg.GetPeerStatus(ctx, &wg.PeerStatusReq{
+				NicName:   string(ev.store.Tag),
+				PublicKey: p.PublicKey,
+			})
+			if err != nil {
+				log.Warn().Str("team", t.ID()).Msgf("Unable to get VPN peer status: %v", err)
+				continue
+			}
+			if resp.Status {
+				t.MarkActive(store.VPNActivity)
+				break
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ package guacamole
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/aau-network-security/haaukins/store"
@@ -237,6 +238,17 @@ func (gtl *guacTokenLoginEndpoint) Intercept(next http.Handler) http.Handler {
 
 			authC := http.Cookie{Name: "GUAC_AUTH", Value: token, Path: "/guacamole/"}
 			http.SetCookie(w, &authC)
+
+			// a single connection is opened when requested by
+			// the connection tabs of the lab page, guacamole
+			// itself checks that the team may access it
+			if id := r.URL.Query().Get("connection"); id != "" {
+				if _, err := strconv.Atoi(id); err == nil {
+					http.Redirect(w, r, ClientURL(id), http.StatusFound)
+					return
+				}
+			}
+
 			http.Redirect(w, r, "/guacamole", http.StatusFound)
 
 		})
@@ -5,8 +5,10 @@
 package guacamole
 
 import (
-	"github.com/pkg/errors"
+	"bytes"
 	"unicode/utf8"
+
+	"github.com/pkg/errors"
 )
 
 var (
@@ -51,6 +53,17 @@ func NewFrame(rawFrame RawFrame) (*Frame, error) {
 	return msg, nil
 }
 
+var (
+	keyInstruction   = []byte("3.key,")
+	mouseInstruction = []byte("5.mouse,")
+)
+
+// IsUserInput returns true when the raw frame contains key or
+// mouse instructions, which are sent only on user interaction
+func IsUserInput(rawFrame RawFrame) bool {
+	return bytes.Contains(rawFrame, keyInstruction) || bytes.Contains(rawFrame, mouseInstruction)
+}
+
 type KeyFrame struct {
 	Key     Element
 	Pressed Element
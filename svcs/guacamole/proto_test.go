@@ -142,3 +142,34 @@ func TestMouseFrame(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUserInput(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:  "Sync",
+			input: "4.sync,8.31163115;",
+		},
+		{
+			name:     "Key",
+			input:    "3.key,5.65307,1.1;",
+			expected: true,
+		},
+		{
+			name:     "Mouse after sync",
+			input:    "4.sync,8.31163115;5.mouse,3.100,3.200,1.0;",
+			expected: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if res := guacamole.IsUserInput([]byte(tc.input)); res != tc.expected {
+				t.Fatalf("Expected %t, but got %t", tc.expected, res)
+			}
+		})
+	}
+}
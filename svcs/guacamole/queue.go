@@ -0,0 +1,188 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+const (
+	// used to estimate waiting time until the first lab
+	// has been handed out by the hub
+	defaultLabInterval = 3 * time.Minute
+	// amount of assignments used to calculate the average
+	labIntervalSamples = 10
+	// waiting before a lab is assigned again after a failure
+	assignRetryInterval = 10 * time.Second
+)
+
+// teamQueue is a FIFO queue of teams which are waiting
+// for a lab to be produced by the lab hub
+type teamQueue struct {
+	m        sync.Mutex
+	teams    []*store.Team
+	notify   chan struct{}
+	lastLab  time.Time
+	interval []time.Duration
+}
+
+func newTeamQueue() *teamQueue {
+	return &teamQueue{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Push adds the team to the end of the queue, a team
+// which is already waiting keeps its position
+func (q *teamQueue) Push(t *store.Team) int {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if pos := q.position(t.ID()); pos > 0 {
+		return pos
+	}
+	if len(q.teams) == 0 {
+		// time without waiting teams is not
+		// part of the estimated waiting time
+		q.lastLab = time.Now()
+	}
+	q.teams = append(q.teams, t)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+
+	return len(q.teams)
+}
+
+// Pop removes the first team of the queue
+func (q *teamQueue) Pop() (*store.Team, bool) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if len(q.teams) == 0 {
+		return nil, false
+	}
+	t := q.teams[0]
+	q.teams = q.teams[1:]
+
+	return t, true
+}
+
+// Requeue puts a team back in front of the queue,
+// e.g. when the lab could not be assigned to it
+func (q *teamQueue) Requeue(t *store.Team) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if q.position(t.ID()) > 0 {
+		return
+	}
+	q.teams = append([]*store.Team{t}, q.teams...)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Assigned registers the time of a lab assignment, which
+// is used to estimate the waiting time of the queue
+func (q *teamQueue) Assigned() {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	now := time.Now()
+	if !q.lastLab.IsZero() {
+		q.interval = append(q.interval, now.Sub(q.lastLab))
+		if len(q.interval) > labIntervalSamples {
+			q.interval = q.interval[1:]
+		}
+	}
+	q.lastLab = now
+}
+
+// Remove deletes the team from the queue, used when
+// a team is deleted while it is still waiting
+func (q *teamQueue) Remove(teamId string) bool {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	pos := q.position(teamId)
+	if pos == 0 {
+		return false
+	}
+	q.teams = append(q.teams[:pos-1], q.teams[pos:]...)
+	return true
+}
+
+// Position returns the position (starting from 1) of the team
+// in the queue, zero is returned when the team is not waiting
+func (q *teamQueue) Position(teamId string) int {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	return q.position(teamId)
+}
+
+func (q *teamQueue) position(teamId string) int {
+	for i, t := range q.teams {
+		if t.ID() == teamId {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Len returns amount of teams waiting in the queue
+func (q *teamQueue) Len() int {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	return len(q.teams)
+}
+
+// Teams returns waiting teams in the order of the queue
+func (q *teamQueue) Teams() []*store.Team {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	teams := make([]*store.Team, len(q.teams))
+	copy(teams, q.teams)
+	return teams
+}
+
+// ETA estimates the time until a team at the given
+// position is assigned to a lab, based on the average time
+// between the latest lab assignments while teams were waiting
+func (q *teamQueue) ETA(position int) time.Duration {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if position <= 0 {
+		return 0
+	}
+
+	avg := defaultLabInterval
+	if len(q.interval) > 0 {
+		var total time.Duration
+		for _, d := range q.interval {
+			total += d
+		}
+		avg = total / time.Duration(len(q.interval))
+	}
+
+	return time.Duration(position) * avg
+}
+
+// Wait returns a channel which receives a value
+// whenever a team has been added to the queue
+func (q *teamQueue) Wait() <-chan struct{} {
+	return q.notify
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+)
+
+func TestTeamQueue(t *testing.T) {
+	newTeam := func(id string) *store.Team {
+		return store.NewTeam(id+"@email.com", id, "password",
+			id, "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, nil)
+	}
+
+	q := newTeamQueue()
+	a, b, c := newTeam("a"), newTeam("b"), newTeam("c")
+
+	for i, tm := range []*store.Team{a, b, c} {
+		if pos := q.Push(tm); pos != i+1 {
+			t.Fatalf("expected position %d, got %d", i+1, pos)
+		}
+	}
+
+	select {
+	case <-q.Wait():
+	default:
+		t.Fatalf("expected notification after push")
+	}
+
+	if pos := q.Push(b); pos != 2 {
+		t.Fatalf("expected team to keep position 2 when pushed again, got %d", pos)
+	}
+	if q.Len() != 3 {
+		t.Fatalf("expected 3 waiting teams, got %d", q.Len())
+	}
+
+	if !q.Remove("b") {
+		t.Fatalf("expected team to be removed")
+	}
+	if pos := q.Position("c"); pos != 2 {
+		t.Fatalf("expected position 2 after removal, got %d", pos)
+	}
+	if pos := q.Position("b"); pos != 0 {
+		t.Fatalf("expected removed team to have no position, got %d", pos)
+	}
+
+	if eta := q.ETA(2); eta != 2*defaultLabInterval {
+		t.Fatalf("expected default estimation %s, got %s", 2*defaultLabInterval, eta)
+	}
+
+	// a team whose lab could not be assigned keeps its position
+	tm, _ := q.Pop()
+	q.Requeue(tm)
+	if pos := q.Position(tm.ID()); pos != 1 {
+		t.Fatalf("expected requeued team to be first, got position %d", pos)
+	}
+
+	for _, expected := range []string{"a", "c"} {
+		tm, ok := q.Pop()
+		if !ok {
+			t.Fatalf("expected team %s to be popped", expected)
+		}
+		if tm.ID() != expected {
+			t.Fatalf("expected team %s, got %s", expected, tm.ID())
+		}
+		q.Assigned()
+	}
+
+	if _, ok := q.Pop(); ok {
+		t.Fatalf("expected empty queue")
+	}
+	if q.ETA(1) >= defaultLabInterval {
+		t.Fatalf("expected estimation to be based on recent assignments")
+	}
+
+	// time without waiting teams is not part of the estimation
+	q = newTeamQueue()
+	q.lastLab = time.Now().Add(-time.Hour)
+	q.Push(a)
+	q.Pop()
+	q.Assigned()
+	if eta := q.ETA(1); eta >= time.Minute {
+		t.Fatalf("expected estimation without idle time, got %s", eta)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	recordingExt        = ".guac.gz"
+	recordingTimeFormat = "20060102-150405.000000000"
+	// recordings older than the retention are removed this often
+	recordingPruneInterval = time.Hour
+)
+
+var (
+	UnknownRecordingErr = errors.New("unknown recording")
+
+	recordingIDRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+/[0-9]{8}-[0-9]{6}\.[0-9]{9}$`)
+	// tunnelOpcode is the instruction of the websocket tunnel
+	// announcing its id, it is not part of the session
+	tunnelOpcode = []byte("0.,")
+)
+
+// Recording records the instructions sent by guacamole
+// to a team within a single connection
+type Recording interface {
+	Record(RawFrame)
+	io.Closer
+}
+
+// RecordingInfo describes a recording of a connection of a team,
+// its id is "<team id>/<start time>"
+type RecordingInfo struct {
+	ID         string
+	Team       string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Size       int64
+}
+
+// RecordingPool stores the recordings of the connections of the
+// teams of an event, recordings are compressed in the format of
+// guacamole session recordings
+type RecordingPool interface {
+	Record(t *store.Team) (Recording, error)
+	List() ([]RecordingInfo, error)
+	// Open returns the compressed recording
+	Open(id string) (io.ReadCloser, error)
+	io.Closer
+}
+
+type recording struct {
+	m      sync.Mutex
+	f      *os.File
+	gz     *gzip.Writer
+	buf    *bufio.Writer
+	err    error
+	closed bool
+	pool   *recordingPool
+}
+
+func (r *recording) Record(frame RawFrame) {
+	if bytes.HasPrefix(frame, tunnelOpcode) {
+		return
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed || r.err != nil {
+		return
+	}
+
+	if _, err := r.buf.Write(frame); err != nil {
+		r.err = err
+		log.Warn().Str("file", r.f.Name()).Msgf("Failed to record connection: %v", err)
+	}
+}
+
+func (r *recording) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.pool.forget(r)
+
+	var errs []error
+	for _, c := range []func() error{r.buf.Flush, r.gz.Close, r.f.Close} {
+		if err := c(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+type recordingPool struct {
+	m         sync.Mutex
+	dir       string
+	retention time.Duration
+	active    map[*recording]struct{}
+	stop      chan struct{}
+}
+
+// NewRecordingPool stores recordings in the directory, recordings
+// older than the retention are removed when it is set
+func NewRecordingPool(dir string, retention time.Duration) (RecordingPool, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	rp := &recordingPool{
+		dir:       dir,
+		retention: retention,
+		active:    map[*recording]struct{}{},
+		stop:      make(chan struct{}),
+	}
+
+	if retention > 0 {
+		rp.prune()
+		go rp.runPrune()
+	}
+
+	return rp, nil
+}
+
+func (rp *recordingPool) Record(t *store.Team) (Recording, error) {
+	dir := filepath.Join(rp.dir, t.ID())
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	name := time.Now().UTC().Format(recordingTimeFormat) + recordingExt
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(f)
+	r := &recording{
+		f:    f,
+		gz:   gz,
+		buf:  bufio.NewWriter(gz),
+		pool: rp,
+	}
+
+	rp.m.Lock()
+	rp.active[r] = struct{}{}
+	rp.m.Unlock()
+
+	return r, nil
+}
+
+func (rp *recordingPool) forget(r *recording) {
+	rp.m.Lock()
+	delete(rp.active, r)
+	rp.m.Unlock()
+}
+
+func (rp *recordingPool) List() ([]RecordingInfo, error) {
+	teams, err := ioutil.ReadDir(rp.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []RecordingInfo
+	for _, team := range teams {
+		if !team.IsDir() {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(rp.dir, team.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			info, ok := recordingInfo(team.Name(), f)
+			if ok {
+				res = append(res, info)
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].StartedAt.Before(res[j].StartedAt)
+	})
+
+	return res, nil
+}
+
+// recordingInfo returns the information of a recording, the
+// recording is finished when the file was modified the last time
+func recordingInfo(team string, f os.FileInfo) (RecordingInfo, bool) {
+	name := f.Name()
+	if f.IsDir() || !strings.HasSuffix(name, recordingExt) {
+		return RecordingInfo{}, false
+	}
+
+	name = strings.TrimSuffix(name, recordingExt)
+	startedAt, err := time.Parse(recordingTimeFormat, name)
+	if err != nil {
+		return RecordingInfo{}, false
+	}
+
+	return RecordingInfo{
+		ID:         fmt.Sprintf("%s/%s", team, name),
+		Team:       team,
+		StartedAt:  startedAt,
+		FinishedAt: f.ModTime().UTC(),
+		Size:       f.Size(),
+	}, true
+}
+
+func (rp *recordingPool) Open(id string) (io.ReadCloser, error) {
+	// ids are part of the path of the recordings
+	if !recordingIDRegex.MatchString(id) {
+		return nil, UnknownRecordingErr
+	}
+
+	f, err := os.Open(filepath.Join(rp.dir, filepath.FromSlash(id)+recordingExt))
+	if os.IsNotExist(err) {
+		return nil, UnknownRecordingErr
+	}
+
+	return f, err
+}
+
+func (rp *recordingPool) runPrune() {
+	ticker := time.NewTicker(recordingPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			rp.prune()
+		case <-rp.stop:
+			return
+		}
+	}
+}
+
+// prune removes the recordings which are older than the retention
+func (rp *recordingPool) prune() {
+	recordings, err := rp.List()
+	if err != nil {
+		log.Warn().Str("dir", rp.dir).Msgf("Unable to list recordings: %v", err)
+		return
+	}
+
+	for _, r := range recordings {
+		if time.Since(r.FinishedAt) < rp.retention {
+			continue
+		}
+
+		path := filepath.Join(rp.dir, filepath.FromSlash(r.ID)+recordingExt)
+		if err := os.Remove(path); err != nil {
+			log.Warn().Str("recording", r.ID).Msgf("Unable to remove recording: %v", err)
+			continue
+		}
+		log.Debug().Str("recording", r.ID).Msg("Removed recording after its retention")
+	}
+}
+
+func (rp *recordingPool) Close() error {
+	close(rp.stop)
+
+	rp.m.Lock()
+	var active []*recording
+	for r := range rp.active {
+		active = append(active, r)
+	}
+	rp.m.Unlock()
+
+	for _, r := range active {
+		r.Close()
+	}
+
+	return nil
+}
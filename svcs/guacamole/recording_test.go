@@ -0,0 +1,118 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/guacamole"
+)
+
+func TestRecordingPool(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	rp, err := guacamole.NewRecordingPool(tmpDir, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer rp.Close()
+
+	team := store.NewTeam("some@email.com", "some name", "password",
+		"team", "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, nil)
+
+	rec, err := rp.Record(team)
+	if err != nil {
+		t.Fatalf("Unexpected error while recording: %s", err)
+	}
+	rec.Record([]byte("0.,36.6f1d8bd4-6d9c-4b4c-a8e6-4d2e5a9e1f0a;")) // tunnel id, not recorded
+	rec.Record([]byte("4.size,1.0,4.1024,3.768;"))
+	rec.Record([]byte("4.sync,8.31163115;"))
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Unexpected error while closing recording: %s", err)
+	}
+
+	recordings, err := rp.List()
+	if err != nil {
+		t.Fatalf("Unexpected error while listing recordings: %s", err)
+	}
+	if len(recordings) != 1 {
+		t.Fatalf("Expected one recording, got %d", len(recordings))
+	}
+	if recordings[0].Team != team.ID() {
+		t.Fatalf("Expected recording of team %s, got %s", team.ID(), recordings[0].Team)
+	}
+
+	f, err := rp.Open(recordings[0].ID)
+	if err != nil {
+		t.Fatalf("Unexpected error while opening recording: %s", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("Expected compressed recording: %s", err)
+	}
+	content, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("Unexpected error while reading recording: %s", err)
+	}
+	expected := "4.size,1.0,4.1024,3.768;4.sync,8.31163115;"
+	if string(content) != expected {
+		t.Fatalf("Expected recording %q, got %q", expected, content)
+	}
+
+	for _, id := range []string{"", "team", "../team/20200215-090000.000000000", "team/unknown", "team/20200215-090000.000000000"} {
+		if _, err := rp.Open(id); err != guacamole.UnknownRecordingErr {
+			t.Fatalf("Expected unknown recording for id %q, got %v", id, err)
+		}
+	}
+}
+
+func TestRecordingRetention(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dir := filepath.Join(tmpDir, "team")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		t.Fatalf("Failed to create directory: %s", err)
+	}
+	old := filepath.Join(dir, "20200215-090000.000000000.guac.gz")
+	recent := filepath.Join(dir, "20200216-090000.000000000.guac.gz")
+	for _, fn := range []string{old, recent} {
+		if err := ioutil.WriteFile(fn, nil, 0600); err != nil {
+			t.Fatalf("Failed to create recording: %s", err)
+		}
+	}
+	expired := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(old, expired, expired); err != nil {
+		t.Fatalf("Failed to change time of recording: %s", err)
+	}
+
+	rp, err := guacamole.NewRecordingPool(tmpDir, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer rp.Close()
+
+	recordings, err := rp.List()
+	if err != nil {
+		t.Fatalf("Unexpected error while listing recordings: %s", err)
+	}
+	if len(recordings) != 1 || recordings[0].ID != "team/20200216-090000.000000000" {
+		t.Fatalf("Expected only the recent recording to be kept, got %v", recordings)
+	}
+}
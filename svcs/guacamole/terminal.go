@@ -0,0 +1,118 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aau-network-security/haaukins/logging"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/svcs/amigo"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type TerminalLogger interface {
+	Log(input []byte)
+}
+
+type terminalLogger struct {
+	logger *zerolog.Logger
+}
+
+func (tl terminalLogger) Log(input []byte) {
+	tl.logger.Log().
+		Time("t", time.Now()).
+		Str("i", string(input)).
+		Msg("input")
+}
+
+// TerminalLoggerPool logs the input of the teams in the
+// terminals of their labs, like the key logger of guacamole
+type TerminalLoggerPool interface {
+	GetLogger(t *store.Team) (TerminalLogger, error)
+	io.Closer
+}
+
+type terminalLoggerPool struct {
+	logpool logging.Pool
+}
+
+func (tlp *terminalLoggerPool) GetLogger(t *store.Team) (TerminalLogger, error) {
+	logger, err := tlp.logpool.GetLogger(t.ID())
+	if err != nil {
+		return nil, err
+	}
+	return terminalLogger{logger: logger}, nil
+}
+
+func (tlp *terminalLoggerPool) Close() error {
+	return tlp.logpool.Close()
+}
+
+func NewTerminalLoggerPool(dir string) (TerminalLoggerPool, error) {
+	logpool, err := logging.NewPool(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &terminalLoggerPool{
+		logpool: logpool,
+	}, nil
+}
+
+// terminalSession is a shell of a team which
+// logs its input and keeps the team active
+type terminalSession struct {
+	docker.Shell
+	team   *store.Team
+	logger TerminalLogger
+}
+
+func (ts *terminalSession) Write(p []byte) (int, error) {
+	ts.team.MarkActive(store.TerminalActivity)
+	if ts.logger != nil {
+		ts.logger.Log(p)
+	}
+
+	return ts.Shell.Write(p)
+}
+
+// openTerminal starts a shell in the attacker container of the lab of the team
+func (ev *event) openTerminal(t *store.Team) (amigo.TerminalSession, error) {
+	l, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return nil, fmt.Errorf("Lab could not found for team %s", t.ID())
+	}
+
+	// lab might have been suspended while the team was idle
+	idle := t.IsIdle()
+	t.MarkActive(store.TerminalActivity)
+	if idle {
+		if err := ev.ResumeTeamLab(context.Background(), t); err != nil {
+			log.Error().Str("team", t.ID()).Msgf("Error on resuming lab of terminal: %v", err)
+		}
+	}
+
+	shell, err := l.Terminal(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	logger, err := ev.terminalLoggerPool.GetLogger(t)
+	if err != nil {
+		log.Warn().Str("team", t.ID()).Msgf("Failed to create terminal logger: %v", err)
+	}
+
+	return &terminalSession{
+		Shell:  shell,
+		team:   t,
+		logger: logger,
+	}, nil
+}
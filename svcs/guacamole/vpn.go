@@ -0,0 +1,315 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	wg "github.com/aau-network-security/haaukins/network/vpn"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	UnknownVPNProfileErr = errors.New("VPN profile is not created")
+	VPNProfileExistsErr  = errors.New("VPN profile is already created")
+	NoVPNAddressErr      = errors.New("no VPN addresses are left on the event")
+)
+
+// vpnPool hands out the peer addresses of the wireguard subnet
+// of an event (x.y.240.1/22), the first address is the interface
+type vpnPool struct {
+	free []string
+}
+
+func newVPNPool(address string) *vpnPool {
+	prefix := strings.TrimSuffix(address, ".240.1/22")
+	p := &vpnPool{}
+	for host := 2; host <= 254; host++ {
+		for third := 240; third < 244; third++ {
+			p.free = append(p.free, fmt.Sprintf("%s.%d.%d/32", prefix, third, host))
+		}
+	}
+
+	return p
+}
+
+func (p *vpnPool) take() (string, error) {
+	if len(p.free) == 0 {
+		return "", NoVPNAddressErr
+	}
+	ip := p.free[0]
+	p.free = p.free[1:]
+
+	return ip, nil
+}
+
+func (p *vpnPool) release(ip string) {
+	p.free = append(p.free, ip)
+}
+
+// reserve takes the address of a restored
+// peer out of the free addresses
+func (p *vpnPool) reserve(ip string) {
+	for i, free := range p.free {
+		if free == ip {
+			p.free = append(p.free[:i], p.free[i+1:]...)
+			return
+		}
+	}
+}
+
+// vpnIPv6 returns the IPv6 counterpart of an address of the VPN
+// subnet of dual-stack events, the peer 10.20.241.7/32 becomes
+// fd00:10:20:f000::241:7/128 and the interface 10.20.240.1/22
+// becomes fd00:10:20:f000::240:1/64
+func vpnIPv6(address string) string {
+	ip, ipNet, err := net.ParseCIDR(address)
+	if err != nil || ip.To4() == nil {
+		return ""
+	}
+	o := ip.To4()
+	bits := 128
+	if ones, _ := ipNet.Mask.Size(); ones < 32 {
+		bits = 64
+	}
+
+	return fmt.Sprintf("fd00:%d:%d:f000::%d:%d/%d", o[0], o[1], o[2], o[3], bits)
+}
+
+// vpnAddresses returns the addresses of the interface or a peer,
+// which include the IPv6 address for dual-stack events
+func (ev *event) vpnAddresses(address string) string {
+	if !ev.store.IPv6 {
+		return address
+	}
+
+	return fmt.Sprintf("%s, %s", address, vpnIPv6(address))
+}
+
+// CreateVPNProfile generates a wireguard peer for the team in the
+// given slot and allows the peer to reach the lab of the team
+func (ev *event) CreateVPNProfile(t *store.Team, id int) error {
+	ev.vpnM.Lock()
+	defer ev.vpnM.Unlock()
+
+	return ev.createVPNProfile(t, id)
+}
+
+// RevokeVPNProfile removes the peer of the profile from the
+// interface of the event, such that a leaked profile is unusable
+func (ev *event) RevokeVPNProfile(t *store.Team, id int) error {
+	ev.vpnM.Lock()
+	defer ev.vpnM.Unlock()
+
+	return ev.revokeVPNProfile(t, id)
+}
+
+// RegenerateVPNProfile replaces the peer of the profile with
+// a peer using new keys and a new address
+func (ev *event) RegenerateVPNProfile(t *store.Team, id int) error {
+	ev.vpnM.Lock()
+	defer ev.vpnM.Unlock()
+
+	if err := ev.revokeVPNProfile(t, id); err != nil {
+		return err
+	}
+
+	return ev.createVPNProfile(t, id)
+}
+
+func (ev *event) createVPNProfile(t *store.Team, id int) error {
+	if id < 0 || id >= ev.store.TeamVPNProfiles() {
+		return UnknownVPNProfileErr
+	}
+	if _, ok := t.GetVPNProfile(id); ok {
+		return VPNProfileExistsErr
+	}
+	lab, ok := ev.GetLabByTeam(t.ID())
+	if !ok {
+		return fmt.Errorf("lab is not assigned to team %s", t.ID())
+	}
+
+	ctx := context.Background()
+	evTag := string(ev.store.Tag)
+	peerIP, err := ev.vpnAddrs.take()
+	if err != nil {
+		return err
+	}
+	keyName := fmt.Sprintf("%s_%s_%s", evTag, t.ID(), strings.NewReplacer(".", "_", "/32", "").Replace(peerIP))
+
+	profile, err := ev.addPeer(ctx, keyName, peerIP, lab.Environment().LabSubnet(), lab.Environment().LabSubnet6(), getDNSRecords(lab.Environment().DNSRecords()))
+	if err != nil {
+		ev.vpnAddrs.release(peerIP)
+		return err
+	}
+	profile.ID = id
+
+	// configurations are served from memory, the embedded VPN
+	// keeps the private keys of peers off the disk
+	if !ev.store.WireGuardConfig.Embedded {
+		if err := writeToFile(ev.vpnConfFile(t, id), profile.Config); err != nil {
+			log.Error().Msgf("Configuration file create error %v", err)
+		}
+	}
+	if err := t.SetVPNProfile(profile); err != nil {
+		// the peer is removed again, as it would not be revocable
+		if _, err := ev.wg.DelPeer(ctx, &wg.DelPReq{PeerPublicKey: profile.PublicKey, IpAddress: peerIP}); err != nil {
+			log.Error().Msgf("Error on deleting peer from interface %v", err)
+		}
+		ev.vpnAddrs.release(peerIP)
+		return fmt.Errorf("unable to save VPN profile: %v", err)
+	}
+	log.Info().Str("team", t.ID()).
+		Str("peer", peerIP).
+		Msgf("VPN profile %d is created", id)
+
+	return ev.applyFirewall(t)
+}
+
+func (ev *event) revokeVPNProfile(t *store.Team, id int) error {
+	p, ok := t.GetVPNProfile(id)
+	if !ok {
+		return UnknownVPNProfileErr
+	}
+
+	resp, err := ev.wg.DelPeer(context.Background(), &wg.DelPReq{
+		PeerPublicKey: p.PublicKey,
+		IpAddress:     p.PeerIP,
+	})
+	if err != nil {
+		log.Error().Msgf("Error on deleting peer from interface %v", err)
+		return err
+	}
+	log.Info().Str("team", t.ID()).
+		Str("peer", p.PeerIP).
+		Msgf("VPN profile %d is revoked: %s", id, resp.Message)
+
+	if err := t.RemoveVPNProfile(id); err != nil {
+		return fmt.Errorf("unable to remove VPN profile: %v", err)
+	}
+	ev.vpnAddrs.release(p.PeerIP)
+	if err := os.Remove(ev.vpnConfFile(t, id)); err != nil && !os.IsNotExist(err) {
+		log.Warn().Msgf("Unable to remove VPN configuration file %v", err)
+	}
+
+	return ev.applyFirewall(t)
+}
+
+// addPeer generates the keys of a peer, adds it to the
+// interface of the event and returns its client configuration
+func (ev *event) addPeer(ctx context.Context, keyName, peerIP, labSubnet, labSubnet6 string, hosts []string) (store.VPNProfile, error) {
+	evTag := string(ev.store.Tag)
+	serverPubKey, err := ev.wg.GetPublicKey(ctx, &wg.PubKeyReq{PubKeyName: evTag, PrivKeyName: evTag})
+	if err != nil {
+		return store.VPNProfile{}, err
+	}
+
+	log.Info().Msgf("Generating keys for peer %s", keyName)
+	if _, err := ev.wg.GenPrivateKey(ctx, &wg.PrivKeyReq{PrivateKeyName: keyName}); err != nil {
+		return store.VPNProfile{}, err
+	}
+	if _, err := ev.wg.GenPublicKey(ctx, &wg.PubKeyReq{PubKeyName: keyName, PrivKeyName: keyName}); err != nil {
+		return store.VPNProfile{}, err
+	}
+	pubKey, err := ev.wg.GetPublicKey(ctx, &wg.PubKeyReq{PubKeyName: keyName})
+	if err != nil {
+		log.Error().Msgf("Error on GetPublicKey %v", err)
+		return store.VPNProfile{}, err
+	}
+	privKey, err := ev.wg.GetPrivateKey(ctx, &wg.PrivKeyReq{PrivateKeyName: keyName})
+	if err != nil {
+		return store.VPNProfile{}, err
+	}
+
+	addPeerResp, err := ev.wg.AddPeer(ctx, &wg.AddPReq{
+		Nic:        evTag,
+		AllowedIPs: ev.vpnAddresses(peerIP),
+		PublicKey:  pubKey.Message,
+	})
+	if err != nil {
+		log.Error().Msgf("Error on adding peer to interface %v", err)
+		return store.VPNProfile{}, err
+	}
+	log.Info().Str("Event: ", evTag).
+		Str("Peer: ", peerIP).Msgf("Message : %s", addPeerResp.Message)
+
+	labSubnet = fmt.Sprintf("%s/24", labSubnet)
+	gwIP := strings.Replace(ev.store.VPNAddress, "1/22", "1/32", 1)
+	allowedIPs := fmt.Sprintf("%s, %s", labSubnet, ev.vpnAddresses(gwIP))
+	if labSubnet6 != "" {
+		allowedIPs = fmt.Sprintf("%s, %s", allowedIPs, labSubnet6)
+		labSubnet = fmt.Sprintf("%s, %s", labSubnet, labSubnet6)
+	}
+	endpoint := fmt.Sprintf("%s.%s:%d", evTag, ev.store.Host, ev.store.EndPointPort)
+	var hostsInfo string
+	for _, h := range hosts {
+		hostsInfo += fmt.Sprintf("# %s \n", h)
+	}
+
+	return store.VPNProfile{
+		PublicKey: pubKey.Message,
+		KeyName:   keyName,
+		PeerIP:    peerIP,
+		Config:    clientConfig(ev.vpnAddresses(peerIP), privKey.Message, serverPubKey.Message, allowedIPs, endpoint, labSubnet, hostsInfo),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (ev *event) vpnConfFile(t *store.Team, id int) string {
+	return fmt.Sprintf("%s%s_%s_%d.conf", ev.store.WireGuardConfig.Dir, ev.store.Tag, t.ID(), id)
+}
+
+func clientConfig(address, privKey, serverPubKey, allowedIPs, endpoint, labSubnet, hosts string) string {
+	return fmt.Sprintf(
+		`[Interface]
+Address = %s
+PrivateKey = %s
+DNS = 1.1.1.1
+MTU = 1420
+[Peer]
+PublicKey = %s
+AllowedIps = %s
+Endpoint =  %s
+PersistentKeepalive = 25
+
+# --------------------------------------------------------------------------
+#  YOUR LAB SUBNET IS:  %s
+# --------------------------------------------------------------------------
+
+######### << USER SCRIPTS >> #####
+#
+#	Use following scripts to install wireguard and connect to lab.
+#
+#   Install Wireguard: %s
+#
+#	Connect Event:  %s
+#
+#   The scripts are automating steps which you do manually. Use them with your responsibility.
+#   If you notice outdated information, help us to update it :)
+#
+####################
+
+##### HOSTS INFORMATION #############
+#   Append given IP Address(es) with Domain(s) to your /etc/hosts file
+#   It enables you to browse domain of challenge through VPN
+#   when you connected to internet.
+#   (* If you used the script given above, your /etc/hosts file is updated already, you can skip this.)
+###################################
+
+%s
+
+####### SETTING VPN CONFIGURATION #########
+
+%s
+
+`, address, privKey, serverPubKey, allowedIPs, endpoint, labSubnet, installWireguard, connectWireguard, hosts, getContent(vpnInfo))
+}
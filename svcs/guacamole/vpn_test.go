@@ -0,0 +1,159 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package guacamole
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/store"
+	pb "github.com/aau-network-security/haaukins/store/proto"
+	mockserver "github.com/aau-network-security/haaukins/testing"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"google.golang.org/grpc"
+)
+
+func TestVPNPool(t *testing.T) {
+	p := newVPNPool("10.20.240.1/22")
+	if n := len(p.free); n != 4*253 {
+		t.Fatalf("expected %d addresses, got %d", 4*253, n)
+	}
+
+	seen := map[string]bool{}
+	for range p.free {
+		ip, err := p.take()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if seen[ip] || ip == "10.20.240.1/32" || !strings.HasPrefix(ip, "10.20.24") {
+			t.Fatalf("unexpected address %s", ip)
+		}
+		seen[ip] = true
+	}
+
+	p.release("10.20.241.7/32")
+	p.release("10.20.242.9/32")
+	p.reserve("10.20.241.7/32")
+	if ip, _ := p.take(); ip != "10.20.242.9/32" {
+		t.Fatalf("expected released address which is not reserved, got %s", ip)
+	}
+	if _, err := p.take(); err != NoVPNAddressErr {
+		t.Fatalf("expected no address error, got %v", err)
+	}
+}
+
+func TestVPNIPv6(t *testing.T) {
+	tt := map[string]string{
+		"10.20.241.7/32": "fd00:10:20:f000::241:7/128",
+		"10.20.240.1/22": "fd00:10:20:f000::240:1/64",
+		"invalid":        "",
+	}
+	for address, expected := range tt {
+		if ip := vpnIPv6(address); ip != expected {
+			t.Fatalf("expected %q for %s, got %q", expected, address, ip)
+		}
+	}
+}
+
+type testEnv struct {
+	exercise.Environment
+}
+
+func (env *testEnv) LabSubnet() string { return "172.16.5.0" }
+
+func (env *testEnv) LabSubnet6() string { return "" }
+
+func (env *testEnv) InstanceEgress() []firewall.InstanceRule {
+	return []firewall.InstanceRule{{IP: "172.16.5.34", Internet: true}}
+}
+
+type testLab struct {
+	lab.Lab
+}
+
+func (l *testLab) Tag() string                       { return "elated_turing" }
+func (l *testLab) Environment() exercise.Environment { return &testEnv{} }
+
+type testFirewall struct {
+	applied map[string]firewall.LabRules
+}
+
+func (fw *testFirewall) Apply(r firewall.LabRules) error {
+	fw.applied[r.Name] = r
+	return nil
+}
+
+func (fw *testFirewall) Remove(name string) error {
+	delete(fw.applied, name)
+	return nil
+}
+
+func (fw *testFirewall) Reconcile([]string) error { return nil }
+
+func TestApplyFirewall(t *testing.T) {
+	fw := &testFirewall{applied: map[string]firewall.LabRules{}}
+	ev := &event{
+		store: store.Event{EventConfig: store.EventConfig{
+			OnlyVPN: docker.OnlyVPN,
+			Egress:  store.EgressPolicy{Allow: []string{"github.com"}},
+		}},
+		labs:   map[string]lab.Lab{"a": &testLab{}},
+		fw:     fw,
+		fwLabs: map[string]string{},
+	}
+	dialer, close := mockserver.Create()
+	defer close()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	team := store.NewTeam("a@email.com", "a", "password",
+		"a", "", "", time.Now().UTC(), map[string][]string{}, map[string][]string{}, pb.NewStoreClient(conn))
+
+	for _, p := range []store.VPNProfile{{ID: 1, PeerIP: "10.20.241.3/32"}, {ID: 0, PeerIP: "10.20.240.2/32"}} {
+		if err := team.SetVPNProfile(p); err != nil {
+			t.Fatalf("unexpected error when saving profile: %v", err)
+		}
+	}
+	if err := ev.applyFirewall(team); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := firewall.LabRules{
+		Name:      "elated_turing",
+		Subnet:    "172.16.5.0/24",
+		Peers:     []string{"10.20.240.2/32", "10.20.241.3/32"},
+		Policy:    firewall.Policy{BlockInternet: true, Allow: []string{"github.com"}},
+		Instances: []firewall.InstanceRule{{IP: "172.16.5.34", Internet: true}},
+	}
+	if r := fw.applied["elated_turing"]; !reflect.DeepEqual(r, expected) {
+		t.Fatalf("expected rules %+v, got: %+v", expected, r)
+	}
+
+	if err := team.RemoveVPNProfile(1); err != nil {
+		t.Fatalf("unexpected error when removing profile: %v", err)
+	}
+	if err := ev.applyFirewall(team); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := fw.applied["elated_turing"].Peers; !reflect.DeepEqual(p, []string{"10.20.240.2/32"}) {
+		t.Fatalf("unexpected peers after revoke: %v", p)
+	}
+
+	ev.removeFirewallRules()
+	if len(fw.applied) != 0 || len(ev.fwLabs) != 0 {
+		t.Fatalf("expected rules to be removed, got: %v", fw.applied)
+	}
+}
@@ -36,6 +36,22 @@ func (s serverTest) UpdateEventFinishDate(context.Context, *pbc.UpdateEventReque
 	return &pbc.UpdateResponse{Message: "ok"}, nil
 }
 
+func (s serverTest) UpdateIdlePolicy(context.Context, *pbc.UpdateIdlePolicyRequest) (*pbc.UpdateResponse, error) {
+	return &pbc.UpdateResponse{Message: "ok"}, nil
+}
+
+func (s serverTest) UpdateDNSRecords(context.Context, *pbc.UpdateDNSRecordsRequest) (*pbc.UpdateResponse, error) {
+	return &pbc.UpdateResponse{Message: "ok"}, nil
+}
+
+func (s serverTest) UpdateTeamCheckpoint(context.Context, *pbc.UpdateTeamCheckpointRequest) (*pbc.UpdateResponse, error) {
+	return &pbc.UpdateResponse{Message: "ok"}, nil
+}
+
+func (s serverTest) UpdateTeamVPNProfiles(context.Context, *pbc.UpdateTeamVPNProfilesRequest) (*pbc.UpdateResponse, error) {
+	return &pbc.UpdateResponse{Message: "ok"}, nil
+}
+
 func (s serverTest) UpdateTeamSolvedChallenge(context.Context, *pbc.UpdateTeamSolvedChallengeRequest) (*pbc.UpdateResponse, error) {
 	return &pbc.UpdateResponse{Message: "ok"}, nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"io"
 
+	"github.com/aau-network-security/haaukins/network/subnet"
 	"github.com/aau-network-security/haaukins/virtual"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/google/uuid"
@@ -46,11 +47,18 @@ var (
 	UnexpectedIPErr           = errors.New("unexpected IP range")
 	ContNotCreatedErr         = errors.New("container is not created")
 
+	// CheckpointRepo is the local repository holding images
+	// committed from containers by Commit
+	CheckpointRepo = "hkn-checkpoint"
+
 	Registries = map[string]docker.AuthConfiguration{
 		"": {},
 	}
 
-	ipPool = newIPPoolFromHost()
+	// Subnets allocates the subnets of the networks of labs,
+	// it is replaced by an allocator persisting the allocations
+	// when the daemon is connected to the store
+	Subnets = subnet.NewAllocator(nil)
 )
 
 const (
@@ -66,6 +74,10 @@ func init() {
 		log.Fatal().Err(err).Msg("")
 	}
 
+	if err := LoadSubnets(nil); err != nil {
+		log.Warn().Msgf("Unable to load subnets in use: %v", err)
+	}
+
 	DefaultLinkBridge, err = newDefaultBridge("hkn-bridge")
 	if err != nil {
 		log.Fatal().Err(err).Msg("Error creating default bridge")
@@ -108,6 +120,7 @@ func (err NoRemoteImageAvailableErr) Error() string {
 
 type Host interface {
 	GetDockerHostIP() (string, error)
+	RemoveImage(name string) error
 }
 
 func NewHost() Host {
@@ -120,6 +133,10 @@ func (h *host) GetDockerHostIP() (string, error) {
 	return getDockerHostIP()
 }
 
+func (h *host) RemoveImage(name string) error {
+	return RemoveImage(name)
+}
+
 type Identifier interface {
 	ID() string
 }
@@ -128,6 +145,7 @@ type Container interface {
 	Identifier
 	virtual.Instance
 	BridgeAlias(string) (string, error)
+	Commit(context.Context) (string, error)
 }
 
 type ContainerConfig struct {
@@ -142,11 +160,8 @@ type ContainerConfig struct {
 	DNS          []string
 	UsedPorts    []string
 	UseBridge    bool
-}
-
-type Resources struct {
-	MemoryMB uint
-	CPU      float64
+	Sysctls      map[string]string
+	CapDrop      []string
 }
 
 type Image struct {
@@ -264,22 +279,15 @@ func (c *container) getCreateConfig() (*docker.CreateContainerOptions, error) {
 	}
 
 	if c.conf.Resources != nil {
-		if c.conf.Resources.MemoryMB > 0 {
-			if c.conf.Resources.MemoryMB < 50 {
-				return nil, TooLowMemErr
-			}
-
-			hostConf.Memory = int64(c.conf.Resources.MemoryMB) * 1024 * 1024
-		}
-
-		if c.conf.Resources.CPU > 0 {
-			hostConf.CPUPeriod = 100000
-			hostConf.CPUQuota = int64(100000 * c.conf.Resources.CPU)
+		if err := c.conf.Resources.apply(&hostConf); err != nil {
+			return nil, err
 		}
 	}
 
 	hostConf.PortBindings = bindings
 	hostConf.Mounts = mounts
+	hostConf.Sysctls = c.conf.Sysctls
+	hostConf.CapDrop = c.conf.CapDrop
 
 	if len(c.conf.DNS) > 0 {
 		resolvPath, err := getResolvFile(c.conf.DNS)
@@ -299,15 +307,8 @@ func (c *container) getCreateConfig() (*docker.CreateContainerOptions, error) {
 		ports[docker.Port(p)] = struct{}{}
 	}
 
-	img := parseImage(c.conf.Image)
-	if err := verifyLocalImageVersion(img); err != nil {
-		// we can proceed on several errors
-		switch err.(type) {
-		case NoLocalImageAvailableErr, NoCredentialsErr:
-			return nil, err
-		default:
-			log.Warn().Msgf("failed to update local Docker image: %s", err)
-		}
+	if err := verifyContainerImage(c.conf.Image); err != nil {
+		return nil, err
 	}
 
 	return &docker.CreateContainerOptions{
@@ -423,6 +424,11 @@ func (c *container) state() virtual.State {
 	}
 
 	if cont.State.Running {
+		// containers with a failing health check are reported
+		// as erroneous, as the service inside is not reachable
+		if cont.State.Health.Status == "unhealthy" {
+			return virtual.Error
+		}
 		return virtual.Running
 	}
 
@@ -477,64 +483,150 @@ func (c *container) BridgeAlias(alias string) (string, error) {
 	return DefaultLinkBridge.connect(c.id, alias)
 }
 
+// Commit saves the filesystem of the container as an image in
+// the checkpoint repository and returns the name of the image.
+// Data stored in volumes is not part of the image.
+func (c *container) Commit(ctx context.Context) (string, error) {
+	if c.id == "" {
+		return "", ContNotCreatedErr
+	}
+
+	tag := strings.Replace(uuid.New().String(), "-", "", -1)
+	if _, err := DefaultClient.CommitContainer(docker.CommitContainerOptions{
+		Container:  c.id,
+		Repository: CheckpointRepo,
+		Tag:        tag,
+		Context:    ctx,
+	}); err != nil {
+		return "", err
+	}
+
+	log.Debug().
+		Str("ID", c.id[0:8]).
+		Str("Image", c.conf.Image).
+		Str("Checkpoint", tag).
+		Msg("Committed container")
+
+	return CheckpointRepo + ":" + tag, nil
+}
+
+// RemoveImage removes a local image, e.g. a checkpoint
+// image which is no longer needed
+func RemoveImage(name string) error {
+	return DefaultClient.RemoveImage(name)
+}
+
 type network struct {
 	net       *docker.Network
 	subnet    string
+	subnet6   string
 	isVPN     int32
+	internal  bool
 	ipPool    map[uint]struct{}
 	connected []Identifier
 }
 
 type Network interface {
 	FormatIP(num int) string
+	FormatIPv6(num int) string
 	Interface() string
 	SetIsVPN(int32)
 	Connect(c Container, ip ...int) (int, error)
 	io.Closer
 }
 
-func NewNetwork(isVPN int32) (Network, error) {
-	sub, err := ipPool.Get()
-	if err != nil {
-		return nil, fmt.Errorf("ip pool get new network err %v", err)
+// NewNetwork creates the network of a lab, dual-stack networks
+// additionally get an IPv6 subnet derived from the IPv4 subnet
+func NewNetwork(isVPN int32, ipv6 bool) (Network, error) {
+	dOption := "macvlan"
+	if isVPN == OnlyVPN || isVPN == VPNBrowser {
+		dOption = "bridge"
 	}
-	var dOption string
 
+	return newNetwork(dOption, isVPN, ipv6, false, "")
+}
+
+// NewNetworkWithSubnet creates the network of a lab on the given
+// subnet, which has been allocated by the daemon for a lab on a worker
+func NewNetworkWithSubnet(isVPN int32, ipv6 bool, cidr string) (Network, error) {
+	dOption := "macvlan"
 	if isVPN == OnlyVPN || isVPN == VPNBrowser {
 		dOption = "bridge"
+	}
+
+	return newNetwork(dOption, isVPN, ipv6, false, cidr)
+}
+
+// NewSegmentNetwork creates a network segment of an exercise, which
+// is an internal bridge network without a route to the host, such
+// that it is only reachable through the routers of the exercise
+func NewSegmentNetwork() (Network, error) {
+	return newNetwork("bridge", NoVPN, false, true, "")
+}
+
+func newNetwork(dOption string, isVPN int32, ipv6, internal bool, cidr string) (Network, error) {
+	kind, label := subnet.Lab, "lab_network"
+	if internal {
+		kind, label = subnet.Segment, "lab_segment"
+	}
+	name := uuid.New().String()
+	if cidr != "" {
+		if err := Subnets.Reserve(kind, name, cidr); err != nil {
+			return nil, fmt.Errorf("subnet reservation for new network err %v", err)
+		}
+		cidr = subnet.Of(cidr)
 	} else {
-		dOption = "macvlan"
+		var err error
+		cidr, err = Subnets.Allocate(kind, name)
+		if err != nil {
+			return nil, fmt.Errorf("subnet allocation for new network err %v", err)
+		}
 	}
+	sub := strings.TrimSuffix(cidr, ".0/24")
 
-	subnet := fmt.Sprintf("%s.0/24", sub)
 	conf := docker.CreateNetworkOptions{
-		Name:   uuid.New().String(),
-		Driver: dOption,
+		Name:     name,
+		Driver:   dOption,
+		Internal: internal,
 		IPAM: &docker.IPAMOptions{
 			Config: []docker.IPAMConfig{{
-				Subnet: subnet,
+				Subnet: cidr,
 			}},
 		},
 		Labels: map[string]string{
-			"kn": "lab_network",
+			"kn": label,
 		},
 	}
+	if ipv6 {
+		conf.EnableIPv6 = true
+		conf.IPAM.Config = append(conf.IPAM.Config, docker.IPAMConfig{
+			Subnet: ipv6Subnet(sub),
+		})
+	}
 
 	netw, err := DefaultClient.CreateNetwork(conf)
 	if err != nil {
+		Subnets.Release(cidr)
 		log.Debug().Msgf("Overlaps err: Some of the containers having same IP addresses...")
 		return nil, fmt.Errorf("docker CreateNetwork err %v", err)
 	}
 
 	netInfo, _ := DefaultClient.NetworkInfo(netw.ID)
-	subnet = netInfo.IPAM.Config[0].Subnet
+	var subnet6 string
+	for _, c := range netInfo.IPAM.Config {
+		if strings.Contains(c.Subnet, ":") {
+			subnet6 = c.Subnet
+			continue
+		}
+		cidr = c.Subnet
+	}
 
 	ipPool := make(map[uint]struct{})
 	for i := 30; i < 255; i++ {
 		ipPool[uint(i)] = struct{}{}
 	}
 
-	return &network{net: netw, subnet: subnet, isVPN: isVPN, ipPool: ipPool}, nil
+	return &network{net: netw, subnet: cidr, subnet6: subnet6, isVPN: isVPN, internal: internal, ipPool: ipPool}, nil
 }
 
 func (n *network) SetIsVPN(isVPN int32) {
@@ -549,16 +641,37 @@ func (n *network) Close() error {
 		}
 	}
 
-	return DefaultClient.RemoveNetwork(n.net.ID)
+	if err := DefaultClient.RemoveNetwork(n.net.ID); err != nil {
+		return err
+	}
+	Subnets.Release(n.subnet)
+
+	return nil
 }
 
 func (n *network) FormatIP(num int) string {
 	return fmt.Sprintf("%s.%d", n.subnet[0:len(n.subnet)-5], num)
 }
 
+// FormatIPv6 returns the IPv6 address with the same host part as
+// the IPv4 address, which is empty when the network is IPv4 only
+func (n *network) FormatIPv6(num int) string {
+	if n.subnet6 == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%d", strings.TrimSuffix(n.subnet6, "/64"), num)
+}
+
+// ipv6Subnet returns the unique local IPv6 subnet of the IPv4
+// subnet prefix, 10.20.5 becomes fd00:10:20:5::/64, such that
+// addresses read like their IPv4 counterpart (fd00:10:20:5::216)
+func ipv6Subnet(prefix string) string {
+	return fmt.Sprintf("fd00:%s::/64", strings.Replace(prefix, ".", ":", -1))
+}
+
 func (n *network) Interface() string {
 	var pDriver string
-	if n.isVPN == OnlyVPN || n.isVPN == VPNBrowser {
+	if n.internal || n.isVPN == OnlyVPN || n.isVPN == VPNBrowser {
 		pDriver = "br"
 		log.Info().Msg("Getting bridge interface from network.Interface function")
 	} else {
@@ -592,20 +705,25 @@ func (n *network) Connect(c Container, ip ...int) (int, error) {
 	var lastDigit int
 
 	if len(ip) > 0 {
+		// fixed addresses of exercises are never handed out randomly
 		lastDigit = ip[0]
+		delete(n.ipPool, uint(lastDigit))
 	} else {
 		lastDigit = n.getRandomIP()
 	}
 
 	ipAddr := n.FormatIP(lastDigit)
+	ip6Addr := n.FormatIPv6(lastDigit)
 
 	err := DefaultClient.ConnectNetwork(n.net.ID, docker.NetworkConnectionOptions{
 		Container: c.ID(),
 		EndpointConfig: &docker.EndpointConfig{
 			IPAMConfig: &docker.EndpointIPAMConfig{
 				IPv4Address: ipAddr,
+				IPv6Address: ip6Addr,
 			},
-			IPAddress: ipAddr,
+			IPAddress:         ipAddr,
+			GlobalIPv6Address: ip6Addr,
 		},
 	})
 	if err != nil {
@@ -621,114 +739,6 @@ func (n *network) Connect(c Container, ip ...int) (int, error) {
 	return lastDigit, nil
 }
 
-type IPPool struct {
-	m       sync.Mutex
-	ips     map[string]struct{}
-	weights map[string]int
-}
-
-func newIPPoolFromHost() *IPPool {
-	ips := map[string]struct{}{}
-	weights := map[string]int{
-		"34": 7 * 255,   // 172.{2nd}.{0-255}.{0-255} => 2nd => 25-31 => 6 + 1 => 7
-		"77": 255 * 255, // 10.{2nd}.{0-255}.{0-255} => 2nd => 0-254 => 254 + 1 => 255
-		"22": 1 * 255,
-	}
-
-	ifaces, err := net.Interfaces()
-	if err == nil {
-		for _, i := range ifaces {
-			addrs, err := i.Addrs()
-			if err != nil {
-				continue
-			}
-
-			for _, a := range addrs {
-				addr, ok := a.(*net.IPNet)
-				if !ok {
-					continue
-				}
-
-				if addr.IP.To4() == nil {
-					// not v4
-					continue
-				}
-
-				ipParts := strings.Split(addr.IP.String(), ".")
-				lvl1 := ipParts[0]
-				if _, ok = weights[lvl1]; !ok {
-					// not relevant ip
-					continue
-				}
-
-				ipStr := strings.Join(ipParts[0:3], ".")
-				ips[ipStr] = struct{}{}
-
-				weights[lvl1] = weights[lvl1] - 1
-			}
-		}
-	}
-
-	return &IPPool{
-		ips:     ips,
-		weights: weights,
-	}
-}
-
-func (ipp *IPPool) Get() (string, error) {
-	ipp.m.Lock()
-	defer ipp.m.Unlock()
-
-	if len(ipp.ips) > 60000 {
-		return "", NoAvailableIPsErr
-	}
-
-	genIP := func() string {
-		ip := randomPickWeighted(ipp.weights)
-		switch ip {
-		case "34":
-			ip += fmt.Sprintf(".%d", rand.Intn(6)+25)
-		case "22":
-			ip += ".168"
-		case "77":
-			ip += fmt.Sprintf(".%d", rand.Intn(255))
-		}
-
-		ip += fmt.Sprintf(".%d", rand.Intn(255))
-
-		return ip
-	}
-
-	var ip string
-	exists := true
-	for exists {
-		ip = genIP()
-		_, exists = ipp.ips[ip]
-	}
-
-	ipp.ips[ip] = struct{}{}
-
-	return ip, nil
-}
-
-func randomPickWeighted(m map[string]int) string {
-	var totalWeight int
-	for _, w := range m {
-		totalWeight += w
-	}
-
-	r := rand.Intn(totalWeight)
-
-	for k, w := range m {
-		r -= w
-		if r <= 0 {
-			return k
-		}
-	}
-
-	return ""
-}
-
 type defaultBridge struct {
 	m          sync.Mutex
 	id         string
@@ -750,10 +760,19 @@ func newDefaultBridge(name string) (*defaultBridge, error) {
 	}
 
 	if netID == "" {
+		cidr, err := Subnets.Allocate(subnet.Service, name)
+		if err != nil {
+			return nil, err
+		}
 		createNetworkOpts := docker.CreateNetworkOptions{
 			Name:     name,
 			Driver:   "bridge",
 			Internal: true,
+			IPAM: &docker.IPAMOptions{
+				Config: []docker.IPAMConfig{{
+					Subnet: cidr,
+				}},
+			},
 			Labels: map[string]string{
 				"hkn": "default_bridge",
 			},
@@ -761,6 +780,7 @@ func newDefaultBridge(name string) (*defaultBridge, error) {
 
 		net, err := DefaultClient.CreateNetwork(createNetworkOpts)
 		if err != nil {
+			Subnets.Release(cidr)
 			return nil, err
 		}
 
@@ -1006,7 +1026,35 @@ func retrieveImage(auth docker.AuthConfiguration, img Image) error {
 	return nil
 }
 
+// verifyContainerImage makes sure the image of a container is available
+// and up to date, only errors the container cannot be created on are returned
+func verifyContainerImage(name string) error {
+	img := parseImage(name)
+	// checkpoint images only exist locally, hence there
+	// is no remote version to compare them against
+	if img.Repo == CheckpointRepo {
+		return nil
+	}
+
+	if err := verifyLocalImageVersion(img); err != nil {
+		// we can proceed on several errors, while an image not
+		// matching its bundle may have been tampered with
+		switch err.(type) {
+		case NoLocalImageAvailableErr, NoCredentialsErr, DigestMismatchErr:
+			return err
+		default:
+			log.Warn().Msgf("failed to update local Docker image: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func verifyLocalImageVersion(img Image) error {
+	if id, ok := bundleImageID(img); ok {
+		return verifyBundleImage(img, id)
+	}
+
 	creds, ok := Registries[img.Registry]
 	if !ok {
 		return NoCredentialsErr{img.Registry}
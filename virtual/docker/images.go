@@ -0,0 +1,294 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	ImagePulling  = "pulling"
+	ImagePulled   = "pulled"
+	ImageUpToDate = "up to date"
+	ImageFailed   = "failed"
+)
+
+type DigestMismatchErr struct {
+	Image  Image
+	Local  string
+	Remote string
+}
+
+func (err DigestMismatchErr) Error() string {
+	return fmt.Sprintf("digest of local image %s (%s) does not match the registry (%s)", err.Image, err.Local, err.Remote)
+}
+
+var (
+	bundleM sync.RWMutex
+	// images loaded from offline bundles by name, their
+	// ids are authoritative instead of the registries
+	bundleImages = map[string]string{}
+)
+
+// SetBundleImages replaces the images loaded from offline bundles,
+// given as image ids by name. The registries are not contacted for
+// those images, instead the local image has to match the id.
+func SetBundleImages(images map[string]string) {
+	bundled := map[string]string{}
+	for name, id := range images {
+		bundled[parseImage(name).String()] = id
+	}
+
+	bundleM.Lock()
+	bundleImages = bundled
+	bundleM.Unlock()
+}
+
+func bundleImageID(img Image) (string, bool) {
+	bundleM.RLock()
+	defer bundleM.RUnlock()
+	id, ok := bundleImages[img.String()]
+	return id, ok
+}
+
+// verifyBundleImage checks the local image against the id from its bundle
+func verifyBundleImage(img Image, id string) error {
+	localImg, err := DefaultClient.InspectImage(img.String())
+	if err != nil {
+		if err == docker.ErrNoSuchImage {
+			return NoLocalImageAvailableErr{err}
+		}
+		return err
+	}
+
+	if localImg.ID != id {
+		return DigestMismatchErr{Image: img, Local: localImg.ID, Remote: id}
+	}
+
+	return nil
+}
+
+// ImageID returns the id of the local image
+func ImageID(name string) (string, error) {
+	img, err := DefaultClient.InspectImage(parseImage(name).String())
+	if err != nil {
+		return "", err
+	}
+
+	return img.ID, nil
+}
+
+// ImageDigest returns the registry digest of the local image
+func ImageDigest(name string) (string, error) {
+	return localImageDigest(parseImage(name))
+}
+
+// SaveImage writes the local image as a tarball including its tag
+func SaveImage(ctx context.Context, name string, w io.Writer) error {
+	return DefaultClient.ExportImage(docker.ExportImageOptions{
+		Name:         parseImage(name).String(),
+		OutputStream: w,
+		Context:      ctx,
+	})
+}
+
+// LoadImage loads the images of a tarball written by SaveImage
+func LoadImage(ctx context.Context, r io.Reader) error {
+	return DefaultClient.LoadImage(docker.LoadImageOptions{
+		InputStream:  r,
+		OutputStream: ioutil.Discard,
+		Context:      ctx,
+	})
+}
+
+// ImageProgress reports the state of an image being prepared
+type ImageProgress struct {
+	Image  string
+	Status string
+	Err    error
+}
+
+// PrepareImages makes sure the latest version of the images are
+// available locally, at most parallel images are pulled at a time.
+// The first error is returned after all images have been handled.
+func PrepareImages(ctx context.Context, images []string, parallel int, progress func(ImageProgress)) error {
+	if parallel < 1 {
+		parallel = 1
+	}
+	if progress == nil {
+		progress = func(ImageProgress) {}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		m        sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, parallel)
+		seen     = map[string]struct{}{}
+	)
+	for _, img := range images {
+		if _, ok := seen[img]; ok || img == "" {
+			continue
+		}
+		seen[img] = struct{}{}
+
+		wg.Add(1)
+		go func(img string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				progress(ImageProgress{Image: img, Status: ImageFailed, Err: ctx.Err()})
+				return
+			}
+
+			progress(ImageProgress{Image: img, Status: ImagePulling})
+			pulled, err := PrepareImage(img)
+			switch {
+			case err != nil:
+				progress(ImageProgress{Image: img, Status: ImageFailed, Err: err})
+				m.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				m.Unlock()
+			case pulled:
+				progress(ImageProgress{Image: img, Status: ImagePulled})
+			default:
+				progress(ImageProgress{Image: img, Status: ImageUpToDate})
+			}
+		}(img)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// PrepareImage pulls the image when it is missing locally or differs
+// from the registry, and verifies the digest of the pulled image.
+// An image which is available locally is kept when the registry
+// cannot be reached.
+func PrepareImage(name string) (bool, error) {
+	img := parseImage(name)
+	if img.Repo == CheckpointRepo {
+		return false, nil
+	}
+
+	if id, ok := bundleImageID(img); ok {
+		return false, verifyBundleImage(img, id)
+	}
+
+	creds, ok := Registries[img.Registry]
+	if !ok {
+		return false, NoCredentialsErr{img.Registry}
+	}
+
+	localDigest, err := localImageDigest(img)
+	if err != nil && err != docker.ErrNoSuchImage {
+		return false, err
+	}
+	exists := err == nil
+
+	remoteDigest, err := getRemoteDigestForImage(creds, img)
+	if err != nil {
+		if exists {
+			log.Warn().Str("image", img.String()).Msgf("Unable to get digest from registry, keeping local image: %v", err)
+			return false, nil
+		}
+		// the registry might still serve the image
+		remoteDigest = ""
+	}
+
+	if exists && localDigest == remoteDigest {
+		return false, nil
+	}
+
+	if err := retrieveImage(creds, img); err != nil {
+		return false, NoRemoteImageAvailableErr{err}
+	}
+
+	if remoteDigest == "" {
+		return true, nil
+	}
+
+	localDigest, err = localImageDigest(img)
+	if err != nil {
+		return true, err
+	}
+	if localDigest != remoteDigest {
+		return true, DigestMismatchErr{Image: img, Local: localDigest, Remote: remoteDigest}
+	}
+
+	return true, nil
+}
+
+func localImageDigest(img Image) (string, error) {
+	localImg, err := DefaultClient.InspectImage(img.String())
+	if err != nil {
+		return "", err
+	}
+
+	for _, d := range localImg.RepoDigests {
+		parts := strings.SplitN(d, "@", 2)
+		if len(parts) == 2 && parts[0] == img.NameWithReg() {
+			return parts[1], nil
+		}
+	}
+
+	return "", NoLocalDigestErr{img}
+}
+
+// PruneImages removes the local images of the configured private
+// registries which are not in keep, public images, checkpoints
+// and images of offline bundles are left untouched. Images used by
+// containers cannot be removed and are skipped. The removed images
+// are returned.
+func PruneImages(keep []string) ([]string, error) {
+	kept := map[string]struct{}{}
+	for _, k := range keep {
+		kept[parseImage(k).String()] = struct{}{}
+	}
+
+	images, err := DefaultClient.ListImages(docker.ListImagesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, i := range images {
+		for _, t := range i.RepoTags {
+			img := parseImage(t)
+			if img.Registry == "" || img.Repo == CheckpointRepo {
+				continue
+			}
+			if _, ok := Registries[img.Registry]; !ok {
+				continue
+			}
+			if _, ok := kept[img.String()]; ok {
+				continue
+			}
+			if _, ok := bundleImageID(img); ok {
+				continue
+			}
+
+			if err := DefaultClient.RemoveImage(t); err != nil {
+				log.Debug().Str("image", t).Msgf("Unable to remove image: %v", err)
+				continue
+			}
+			removed = append(removed, t)
+		}
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+func TestBundleImageMismatch(t *testing.T) {
+	// the docker daemon knows the image by another id than its bundle
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/json") || !strings.Contains(r.URL.Path, "/images/") {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"Id": "sha256:tampered"}`)
+	}))
+	defer srv.Close()
+
+	client, err := docker.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	prev := DefaultClient
+	DefaultClient = client
+	defer func() { DefaultClient = prev }()
+
+	SetBundleImages(map[string]string{"registry.gitlab.com/aau/sql": "sha256:bundled"})
+	defer SetBundleImages(nil)
+
+	if err := verifyContainerImage("registry.gitlab.com/aau/sql"); err == nil {
+		t.Fatalf("expected error when the image does not match its bundle")
+	} else if _, ok := err.(DigestMismatchErr); !ok {
+		t.Fatalf("expected digest mismatch error, got: %v", err)
+	}
+
+	SetBundleImages(map[string]string{"registry.gitlab.com/aau/sql": "sha256:tampered"})
+	if err := verifyContainerImage("registry.gitlab.com/aau/sql"); err != nil {
+		t.Fatalf("unexpected error when the image matches its bundle: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netns"
+)
+
+// containerPid returns the process id of a running container
+func containerPid(c Identifier) (int, error) {
+	if c.ID() == "" {
+		return 0, ContNotCreatedErr
+	}
+
+	cont, err := DefaultClient.InspectContainer(c.ID())
+	if err != nil {
+		return 0, err
+	}
+	if cont.State.Pid == 0 {
+		return 0, NotRunningErr
+	}
+
+	return cont.State.Pid, nil
+}
+
+// InNetns runs the function within the network namespace of a running
+// container, sockets opened by the function stay in the namespace
+func InNetns(c Identifier, f func() error) error {
+	pid, err := containerPid(c)
+	if err != nil {
+		return err
+	}
+
+	ns, err := netns.GetFromPid(pid)
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	// the namespace is a property of the thread
+	runtime.LockOSThread()
+	orig, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+	defer orig.Close()
+
+	if err := netns.Set(ns); err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+
+	ferr := f()
+	if err := netns.Set(orig); err != nil {
+		// the thread is left locked, such that it exits
+		// with the goroutine instead of being reused
+		return fmt.Errorf("unable to restore network namespace: %v", err)
+	}
+	runtime.UnlockOSThread()
+
+	return ferr
+}
+
+// ListensUDP returns true when a process of a running container
+// has a socket bound to the UDP port
+func ListensUDP(c Identifier, port uint16) (bool, error) {
+	pid, err := containerPid(c)
+	if err != nil {
+		return false, err
+	}
+
+	// the file shows the sockets of the network namespace of the process
+	content, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/net/udp", pid))
+	if err != nil {
+		return false, err
+	}
+
+	return listensUDP(content, port), nil
+}
+
+// listensUDP parses the sockets of /proc/net/udp, which are formatted as
+// "sl local_address rem_address ..." with addresses in hex, e.g. 00000000:0043
+func listensUDP(content []byte, port uint16) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		i := strings.LastIndex(fields[1], ":")
+		if i < 0 {
+			continue
+		}
+		p, err := strconv.ParseUint(fields[1][i+1:], 16, 16)
+		if err != nil {
+			continue
+		}
+		if uint16(p) == port {
+			return true
+		}
+	}
+
+	return false
+}
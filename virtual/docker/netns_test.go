@@ -0,0 +1,32 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import "testing"
+
+func TestListensUDP(t *testing.T) {
+	content := []byte(`   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  123: 00000000:0043 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 51742 2 0000000000000000 0
+  456: 0300024D:0035 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 51743 2 0000000000000000 0
+`)
+
+	tt := []struct {
+		name     string
+		port     uint16
+		expected bool
+	}{
+		{name: "dhcp", port: 67, expected: true},
+		{name: "dns", port: 53, expected: true},
+		{name: "not bound", port: 68},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if listens := listensUDP(content, tc.port); listens != tc.expected {
+				t.Fatalf("expected port %d to be bound: %t, got %t", tc.port, tc.expected, listens)
+			}
+		})
+	}
+}
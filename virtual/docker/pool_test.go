@@ -1,28 +0,0 @@
-// Copyright (c) 2018-2019 Aalborg University
-// Use of this source code is governed by a GPLv3
-// license that can be found in the LICENSE file.
-
-package docker
-
-import (
-	"testing"
-)
-
-func TestIPPool(t *testing.T) {
-	pool := newIPPoolFromHost()
-
-	ips := map[string]struct{}{}
-	n := 30000
-	for i := 0; i < n; i++ {
-		ip, err := pool.Get()
-		if err != nil {
-			break
-		}
-
-		ips[ip] = struct{}{}
-	}
-
-	if len(ips) != n {
-		t.Fatalf("expected %d unique ip ranges, but received: %d", n, len(ips))
-	}
-}
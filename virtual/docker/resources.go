@@ -0,0 +1,188 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aau-network-security/haaukins/virtual"
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+const (
+	// SeccompUnconfined disables seccomp filtering of a container
+	SeccompUnconfined = "unconfined"
+)
+
+var (
+	InvalidBlkioWeightErr = errors.New("blkio weight needs to be between 10 and 1000")
+	InvalidUlimitErr      = errors.New("ulimit needs a name and a soft limit not above the hard limit")
+)
+
+type Resources struct {
+	MemoryMB uint
+	CPU      float64
+	// PidsLimit is the maximum amount of processes in the container
+	PidsLimit int64
+	// StorageMB limits the size of the writable layer, it requires
+	// a storage driver supporting quotas (e.g. overlay2 on xfs with pquota)
+	StorageMB uint
+	// BlkioWeight is the relative block IO weight (10-1000)
+	BlkioWeight uint
+	Ulimits     []Ulimit
+	// Tmpfs maps paths to the mount options of tmpfs mounts
+	Tmpfs          map[string]string
+	ReadOnlyRootfs bool
+	CapDrop        []string
+	// SeccompProfile is either a path to a JSON seccomp profile
+	// or "unconfined", the default profile of docker is used if empty
+	SeccompProfile string
+}
+
+type Ulimit struct {
+	Name string
+	Soft int64
+	Hard int64
+}
+
+func (r *Resources) apply(hostConf *docker.HostConfig) error {
+	if r.MemoryMB > 0 {
+		if r.MemoryMB < 50 {
+			return TooLowMemErr
+		}
+
+		hostConf.Memory = int64(r.MemoryMB) * 1024 * 1024
+	}
+
+	if r.CPU > 0 {
+		hostConf.CPUPeriod = 100000
+		hostConf.CPUQuota = int64(100000 * r.CPU)
+	}
+
+	if r.PidsLimit > 0 {
+		pids := r.PidsLimit
+		hostConf.PidsLimit = &pids
+	}
+
+	if r.StorageMB > 0 {
+		hostConf.StorageOpt = map[string]string{
+			"size": fmt.Sprintf("%dM", r.StorageMB),
+		}
+	}
+
+	if r.BlkioWeight > 0 {
+		if r.BlkioWeight < 10 || r.BlkioWeight > 1000 {
+			return InvalidBlkioWeightErr
+		}
+
+		hostConf.BlkioWeight = int64(r.BlkioWeight)
+	}
+
+	for _, u := range r.Ulimits {
+		hard := u.Hard
+		if hard == 0 {
+			hard = u.Soft
+		}
+		if u.Name == "" || u.Soft > hard {
+			return InvalidUlimitErr
+		}
+
+		hostConf.Ulimits = append(hostConf.Ulimits, docker.ULimit{
+			Name: u.Name,
+			Soft: u.Soft,
+			Hard: hard,
+		})
+	}
+
+	if len(r.Tmpfs) > 0 {
+		hostConf.Tmpfs = r.Tmpfs
+	}
+
+	hostConf.ReadonlyRootfs = r.ReadOnlyRootfs
+	hostConf.CapDrop = r.CapDrop
+
+	switch r.SeccompProfile {
+	case "":
+	case SeccompUnconfined:
+		hostConf.SecurityOpt = append(hostConf.SecurityOpt, "seccomp="+SeccompUnconfined)
+	default:
+		// the daemon expects the content of the profile,
+		// the path is only understood by the docker cli
+		profile, err := ioutil.ReadFile(r.SeccompProfile)
+		if err != nil {
+			return err
+		}
+
+		hostConf.SecurityOpt = append(hostConf.SecurityOpt, "seccomp="+string(profile))
+	}
+
+	return nil
+}
+
+// Usage returns a single sample of the resources consumed by the container
+func (c *container) Usage(ctx context.Context) (virtual.Usage, error) {
+	if c.id == "" {
+		return virtual.Usage{}, ContNotCreatedErr
+	}
+
+	statsC := make(chan *docker.Stats, 1)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- DefaultClient.Stats(docker.StatsOptions{
+			ID:      c.id,
+			Stats:   statsC,
+			Stream:  false,
+			Context: ctx,
+		})
+	}()
+
+	stats, ok := <-statsC
+	if err := <-errC; err != nil {
+		return virtual.Usage{}, err
+	}
+	if !ok || stats == nil {
+		return virtual.Usage{}, nil
+	}
+
+	return usageFromStats(stats), nil
+}
+
+func usageFromStats(s *docker.Stats) virtual.Usage {
+	u := virtual.Usage{
+		MemoryBytes: s.MemoryStats.Usage,
+		Pids:        s.PidsStats.Current,
+	}
+
+	// same computation as the docker cli
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(s.CPUStats.SystemCPUUsage) - float64(s.PreCPUStats.SystemCPUUsage)
+	cpus := float64(s.CPUStats.OnlineCPUs)
+	if cpus == 0 {
+		cpus = float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && sysDelta > 0 {
+		u.CPUPercent = cpuDelta / sysDelta * cpus * 100
+	}
+
+	for _, n := range s.Networks {
+		u.NetRxBytes += n.RxBytes
+		u.NetTxBytes += n.TxBytes
+	}
+
+	for _, e := range s.BlkioStats.IOServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			u.BlockRead += e.Value
+		case "write":
+			u.BlockWrite += e.Value
+		}
+	}
+
+	return u
+}
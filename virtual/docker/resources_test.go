@@ -0,0 +1,87 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+func TestResourcesApply(t *testing.T) {
+	profile, err := ioutil.TempFile("", "seccomp")
+	if err != nil {
+		t.Fatalf("unable to create seccomp profile: %v", err)
+	}
+	defer os.Remove(profile.Name())
+	profile.WriteString(`{"defaultAction":"SCMP_ACT_ERRNO"}`)
+	profile.Close()
+
+	tt := []struct {
+		name     string
+		res      Resources
+		expected docker.HostConfig
+		err      error
+	}{
+		{
+			name: "limits",
+			res: Resources{
+				PidsLimit:      100,
+				StorageMB:      512,
+				BlkioWeight:    200,
+				Ulimits:        []Ulimit{{Name: "nofile", Soft: 1024}},
+				Tmpfs:          map[string]string{"/tmp": "rw,size=64m"},
+				ReadOnlyRootfs: true,
+				CapDrop:        []string{"NET_RAW"},
+				SeccompProfile: profile.Name(),
+			},
+			expected: docker.HostConfig{
+				PidsLimit:      func() *int64 { p := int64(100); return &p }(),
+				StorageOpt:     map[string]string{"size": "512M"},
+				BlkioWeight:    200,
+				Ulimits:        []docker.ULimit{{Name: "nofile", Soft: 1024, Hard: 1024}},
+				Tmpfs:          map[string]string{"/tmp": "rw,size=64m"},
+				ReadonlyRootfs: true,
+				CapDrop:        []string{"NET_RAW"},
+				SecurityOpt:    []string{`seccomp={"defaultAction":"SCMP_ACT_ERRNO"}`},
+			},
+		},
+		{
+			name:     "unconfined",
+			res:      Resources{SeccompProfile: SeccompUnconfined},
+			expected: docker.HostConfig{SecurityOpt: []string{"seccomp=unconfined"}},
+		},
+		{
+			name: "invalid blkio weight",
+			res:  Resources{BlkioWeight: 5},
+			err:  InvalidBlkioWeightErr,
+		},
+		{
+			name: "invalid ulimit",
+			res:  Resources{Ulimits: []Ulimit{{Name: "nproc", Soft: 20, Hard: 10}}},
+			err:  InvalidUlimitErr,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var hostConf docker.HostConfig
+			err := tc.res.apply(&hostConf)
+			if err != tc.err {
+				t.Fatalf("expected error (%v), but received: %v", tc.err, err)
+			}
+			if tc.err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(hostConf, tc.expected) {
+				t.Fatalf("unexpected host config: %+v", hostConf)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+var (
+	InvalidRouteErr = errors.New("route has an invalid destination or gateway")
+	NotRunningErr   = errors.New("container is not running")
+)
+
+// Route is a route of a container, traffic to the destination
+// subnet is sent to the gateway, which is a router of an exercise.
+// The route is the default route when the destination is empty, and
+// the destination is unreachable when the gateway is empty.
+type Route struct {
+	Destination string
+	Gateway     string
+}
+
+// SetRoutes adds the routes to the network namespace of a running
+// container, existing routes to the same destinations are replaced.
+// Routes do not survive a restart of the container.
+func SetRoutes(c Identifier, routes []Route) error {
+	pid, err := containerPid(c)
+	if err != nil {
+		return err
+	}
+
+	ns, err := netns.GetFromPid(pid)
+	if err != nil {
+		return err
+	}
+	defer ns.Close()
+
+	h, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		return err
+	}
+	defer h.Delete()
+
+	for _, r := range routes {
+		route, err := r.netlink()
+		if err != nil {
+			return err
+		}
+		if err := h.RouteReplace(route); err != nil {
+			return fmt.Errorf("unable to add route to %s via %s: %v", r.Destination, r.Gateway, err)
+		}
+	}
+
+	return nil
+}
+
+func (r Route) netlink() (*netlink.Route, error) {
+	if r.Gateway == "" {
+		_, dst, err := net.ParseCIDR(r.Destination)
+		if err != nil {
+			return nil, InvalidRouteErr
+		}
+		return &netlink.Route{Dst: dst, Type: syscall.RTN_UNREACHABLE}, nil
+	}
+
+	gw := net.ParseIP(r.Gateway)
+	if gw == nil || gw.To4() == nil {
+		return nil, InvalidRouteErr
+	}
+
+	route := &netlink.Route{Gw: gw}
+	if r.Destination == "" {
+		return route, nil
+	}
+
+	_, dst, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return nil, InvalidRouteErr
+	}
+	route.Dst = dst
+
+	return route, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+// Shell is an interactive terminal of a command running within a
+// container, reads return the output of the command until it exits
+type Shell interface {
+	io.ReadWriteCloser
+	Resize(rows, cols uint16) error
+}
+
+type shell struct {
+	once sync.Once
+	id   string
+	in   *io.PipeWriter
+	out  *io.PipeReader
+	cw   docker.CloseWaiter
+}
+
+// Exec starts the command with a terminal within a running container
+func Exec(ctx context.Context, c Identifier, cmd []string) (Shell, error) {
+	if c.ID() == "" {
+		return nil, ContNotCreatedErr
+	}
+
+	exec, err := DefaultClient.CreateExec(docker.CreateExecOptions{
+		Container:    c.ID(),
+		Cmd:          cmd,
+		Env:          []string{"TERM=xterm-256color"},
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          true,
+		Context:      ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	cw, err := DefaultClient.StartExecNonBlocking(exec.ID, docker.StartExecOptions{
+		InputStream:  inR,
+		OutputStream: outW,
+		ErrorStream:  outW,
+		Tty:          true,
+		RawTerminal:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		// output ends when the command exits
+		outW.CloseWithError(cw.Wait())
+		inR.Close()
+	}()
+
+	return &shell{
+		id:  exec.ID,
+		in:  inW,
+		out: outR,
+		cw:  cw,
+	}, nil
+}
+
+func (s *shell) Read(p []byte) (int, error) {
+	return s.out.Read(p)
+}
+
+func (s *shell) Write(p []byte) (int, error) {
+	return s.in.Write(p)
+}
+
+func (s *shell) Resize(rows, cols uint16) error {
+	return DefaultClient.ResizeExecTTY(s.id, int(rows), int(cols))
+}
+
+func (s *shell) Close() error {
+	var err error
+	s.once.Do(func() {
+		s.in.Close()
+		err = s.cw.Close()
+	})
+
+	return err
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package docker
+
+import (
+	"strings"
+
+	"github.com/aau-network-security/haaukins/network/subnet"
+)
+
+// LoadSubnets replaces the allocator of subnets by an allocator which
+// persists its allocations in the store, it keeps the subnets of the
+// networks created by haaukins and avoids the subnets of host routes
+// and other Docker networks
+func LoadSubnets(s subnet.Store) error {
+	networks, err := DefaultClient.ListNetworks()
+	if err != nil {
+		return err
+	}
+
+	var live []subnet.Allocation
+	var taken []string
+	for _, n := range networks {
+		kind, ok := networkKind(n.Labels)
+		for _, c := range n.IPAM.Config {
+			if c.Subnet == "" || strings.Contains(c.Subnet, ":") {
+				continue
+			}
+			if !ok {
+				taken = append(taken, c.Subnet)
+				continue
+			}
+			live = append(live, subnet.Allocation{
+				Subnet: subnet.Of(c.Subnet),
+				Kind:   kind,
+				Owner:  n.Name,
+			})
+		}
+	}
+
+	host, err := subnet.HostSubnets()
+	if err != nil {
+		return err
+	}
+
+	// the allocator is used even when the store cannot be read,
+	// as it still reserves host routes and live networks
+	a := subnet.NewAllocator(s)
+	err = a.Load(live, append(taken, host...))
+	Subnets = a
+
+	return err
+}
+
+// networkKind returns the kind of subnet of a network
+// created by haaukins, based on the labels of the network
+func networkKind(labels map[string]string) (subnet.Kind, bool) {
+	switch {
+	case labels["kn"] == "lab_network":
+		return subnet.Lab, true
+	case labels["kn"] == "lab_segment":
+		return subnet.Segment, true
+	case labels["hkn"] == "default_bridge":
+		return subnet.Service, true
+	}
+
+	return "", false
+}
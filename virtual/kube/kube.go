@@ -0,0 +1,352 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package kube
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	namespacePrefix = "hkn-"
+	isolationPolicy = "hkn-isolation"
+	dnsName         = "hkn-dns"
+	corefileKey     = "Corefile"
+
+	// workloadLabel selects the pods of a single workload
+	workloadLabel = "hkn-workload"
+	kubeSystem    = "kube-system"
+)
+
+var (
+	DefaultDNSImage      = "coredns/coredns:1.8.0"
+	DefaultClusterDomain = "cluster.local"
+
+	corefileTmpl = template.Must(template.New("corefile").Parse(`.:53 {
+    errors
+    reload 10s
+{{- if .Hosts}}
+    hosts {
+{{- range .Hosts}}
+        {{.IP}} {{.Name}}
+{{- end}}
+        fallthrough
+    }
+{{- end}}
+{{- range .Rewrites}}
+    rewrite stop {
+        name exact {{.Name}}. {{.Target}}.
+        answer name {{.Target}}. {{.Name}}.
+    }
+{{- end}}
+    forward . /etc/resolv.conf
+}
+`))
+)
+
+// Config of the cluster which labs are scheduled on
+type Config struct {
+	// Kubeconfig is the path of the kubeconfig file,
+	// the in-cluster config is used when empty
+	Kubeconfig string `yaml:"kubeconfig,omitempty"`
+	// PodSubnet is the address range of pods, it is
+	// handed to teams as the subnet of their lab
+	PodSubnet string `yaml:"pod-subnet,omitempty"`
+	// AllowFrom are address ranges outside of the cluster (e.g. the
+	// frontends and VPN of the daemon host) which may reach the labs
+	AllowFrom     []string `yaml:"allow-from,omitempty"`
+	DNSImage      string   `yaml:"dns-image,omitempty"`
+	ClusterDomain string   `yaml:"cluster-domain,omitempty"`
+}
+
+// Cluster creates lab namespaces on a Kubernetes cluster
+type Cluster struct {
+	client kubernetes.Interface
+	conf   Config
+}
+
+func NewCluster(conf Config) (*Cluster, error) {
+	restConf, err := clientcmd.BuildConfigFromFlags("", conf.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(restConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClusterFromClient(client, conf), nil
+}
+
+// NewClusterFromClient uses the given clientset,
+// e.g. a fake clientset within tests
+func NewClusterFromClient(client kubernetes.Interface, conf Config) *Cluster {
+	if conf.DNSImage == "" {
+		conf.DNSImage = DefaultDNSImage
+	}
+
+	if conf.ClusterDomain == "" {
+		conf.ClusterDomain = DefaultClusterDomain
+	}
+
+	return &Cluster{
+		client: client,
+		conf:   conf,
+	}
+}
+
+func (c *Cluster) PodSubnet() string {
+	return c.conf.PodSubnet
+}
+
+// Namespace is the namespace of a single lab, pods of the namespace can
+// only reach each other, the lab DNS and the ranges allowed by the config
+type Namespace struct {
+	cluster *Cluster
+	name    string
+	dnsIP   string
+	dns     *Workload
+}
+
+// CreateNamespace creates a namespace isolated by a network
+// policy together with the service of its DNS server
+func (c *Cluster) CreateNamespace(ctx context.Context) (*Namespace, error) {
+	ns := &Namespace{
+		cluster: c,
+		name:    namespacePrefix + strings.Replace(uuid.New().String(), "-", "", -1)[0:12],
+	}
+
+	_, err := c.client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ns.name,
+			Labels: map[string]string{
+				"hkn": "lab",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ns.createIsolationPolicy(ctx); err != nil {
+		ns.Close()
+		return nil, err
+	}
+
+	if err := ns.createDNSService(ctx); err != nil {
+		ns.Close()
+		return nil, err
+	}
+
+	log.Debug().
+		Str("namespace", ns.name).
+		Msg("Created namespace")
+
+	return ns, nil
+}
+
+func (ns *Namespace) Name() string {
+	return ns.name
+}
+
+// DNS returns the address of the DNS server of the lab
+func (ns *Namespace) DNS() string {
+	return ns.dnsIP
+}
+
+// DNSReady is true when the DNS server of the lab is running
+func (ns *Namespace) DNSReady() bool {
+	if ns.dns == nil {
+		return false
+	}
+
+	return ns.dns.Info().State == virtual.Running
+}
+
+func (ns *Namespace) createIsolationPolicy(ctx context.Context) error {
+	peers := []networkingv1.NetworkPolicyPeer{
+		{PodSelector: &metav1.LabelSelector{}},
+	}
+	for _, cidr := range ns.cluster.conf.AllowFrom {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+
+	udp, tcp := corev1.ProtocolUDP, corev1.ProtocolTCP
+	dnsPort := intstr.FromInt(53)
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: isolationPolicy},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress,
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{From: peers},
+			},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{To: peers},
+				// the lab DNS forwards unknown names to the cluster DNS
+				{
+					To: []networkingv1.NetworkPolicyPeer{{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"kubernetes.io/metadata.name": kubeSystem},
+						},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &udp, Port: &dnsPort},
+						{Protocol: &tcp, Port: &dnsPort},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := ns.cluster.client.NetworkingV1().NetworkPolicies(ns.name).Create(ctx, policy, metav1.CreateOptions{})
+	return err
+}
+
+func (ns *Namespace) createDNSService(ctx context.Context) error {
+	svc, err := ns.cluster.client.CoreV1().Services(ns.name).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: dnsName},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{workloadLabel: dnsName},
+			Ports: []corev1.ServicePort{
+				{Name: "dns", Protocol: corev1.ProtocolUDP, Port: 53},
+				{Name: "dns-tcp", Protocol: corev1.ProtocolTCP, Port: 53},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	ns.dnsIP = svc.Spec.ClusterIP
+
+	return nil
+}
+
+// Record is an A record of the lab DNS, it resolves
+// to either the pod of a workload or a fixed address
+type Record struct {
+	Name     string
+	Workload string
+	IP       string
+}
+
+func (ns *Namespace) corefile(records []Record) (string, error) {
+	type rewrite struct {
+		Name   string
+		Target string
+	}
+
+	var hosts []Record
+	var rewrites []rewrite
+	for _, r := range records {
+		if r.IP != "" {
+			hosts = append(hosts, r)
+			continue
+		}
+
+		rewrites = append(rewrites, rewrite{
+			Name:   strings.TrimSuffix(r.Name, "."),
+			Target: ns.hostname(r.Workload),
+		})
+	}
+
+	var buf bytes.Buffer
+	err := corefileTmpl.Execute(&buf, struct {
+		Hosts    []Record
+		Rewrites []rewrite
+	}{
+		Hosts:    hosts,
+		Rewrites: rewrites,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// RunDNS writes the records to the configuration of the lab DNS
+// and starts the DNS server, a running server reloads the records
+func (ns *Namespace) RunDNS(ctx context.Context, records []Record) error {
+	corefile, err := ns.corefile(records)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: dnsName},
+		Data:       map[string]string{corefileKey: corefile},
+	}
+
+	cms := ns.cluster.client.CoreV1().ConfigMaps(ns.name)
+	if _, err := cms.Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if _, err := cms.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if ns.dns != nil {
+		return nil
+	}
+
+	ns.dns = ns.NewWorkload(dnsName, WorkloadConfig{
+		Image: ns.cluster.conf.DNSImage,
+		Cmd:   []string{"-conf", "/etc/coredns/" + corefileKey},
+		Labels: map[string]string{
+			"hkn": "lab_dns",
+		},
+		ConfigMap: dnsName,
+		MountPath: "/etc/coredns",
+		// the lab DNS itself resolves through the cluster DNS
+		clusterDNS: true,
+	})
+
+	return ns.dns.Run(ctx)
+}
+
+// hostname is the name of a workload within the cluster DNS
+func (ns *Namespace) hostname(workload string) string {
+	return fmt.Sprintf("%s.%s.svc.%s", workload, ns.name, ns.cluster.conf.ClusterDomain)
+}
+
+// Close deletes the namespace including everything within it
+func (ns *Namespace) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if err := ns.cluster.client.CoreV1().Namespaces().Delete(ctx, ns.name, metav1.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("namespace", ns.name).
+		Msg("Deleted namespace")
+
+	return nil
+}
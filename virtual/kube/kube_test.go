@@ -0,0 +1,166 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package kube
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/virtual"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNamespace(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	cluster := NewClusterFromClient(client, Config{AllowFrom: []string{"10.0.0.0/8"}})
+	ctx := context.Background()
+
+	ns, err := cluster.CreateNamespace(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error when creating namespace: %v", err)
+	}
+
+	if !strings.HasPrefix(ns.Name(), namespacePrefix) {
+		t.Fatalf("expected namespace to be prefixed with %s, got: %s", namespacePrefix, ns.Name())
+	}
+
+	policy, err := client.NetworkingV1().NetworkPolicies(ns.Name()).Get(ctx, isolationPolicy, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected isolation policy to be created: %v", err)
+	}
+	peers := policy.Spec.Ingress[0].From
+	if len(peers) != 2 || peers[1].IPBlock == nil || peers[1].IPBlock.CIDR != "10.0.0.0/8" {
+		t.Fatalf("expected ingress from the namespace and allowed ranges, got: %v", peers)
+	}
+
+	if _, err := client.CoreV1().Services(ns.Name()).Get(ctx, dnsName, metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected dns service to be created: %v", err)
+	}
+
+	if err := ns.Close(); err != nil {
+		t.Fatalf("unexpected error when closing namespace: %v", err)
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, ns.Name(), metav1.GetOptions{}); err == nil {
+		t.Fatalf("expected namespace to be deleted")
+	}
+}
+
+func TestWorkload(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	cluster := NewClusterFromClient(client, Config{})
+	ctx := context.Background()
+
+	ns, err := cluster.CreateNamespace(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error when creating namespace: %v", err)
+	}
+
+	w := ns.NewWorkload("sql-0", WorkloadConfig{
+		Image:    "aau/sql",
+		EnvVars:  map[string]string{"APP_FLAG": "HKN{flag}"},
+		MemoryMB: 64,
+		CPU:      0.5,
+	})
+	if err := w.Create(ctx); err != nil {
+		t.Fatalf("unexpected error when creating workload: %v", err)
+	}
+	if state := w.Info().State; state != virtual.Stopped {
+		t.Fatalf("expected created workload to be stopped, got: %v", state)
+	}
+
+	d, err := client.AppsV1().Deployments(ns.Name()).Get(ctx, "sql-0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected deployment to be created: %v", err)
+	}
+	spec := d.Spec.Template.Spec
+	if spec.DNSPolicy != corev1.DNSNone {
+		t.Fatalf("expected pod to resolve through the lab dns, got policy: %s", spec.DNSPolicy)
+	}
+	limits := spec.Containers[0].Resources.Limits
+	if limits.Memory().String() != "64Mi" || limits.Cpu().String() != "500m" {
+		t.Fatalf("unexpected resource limits: %v", limits)
+	}
+	if env := spec.Containers[0].Env; len(env) != 1 || env[0].Value != "HKN{flag}" {
+		t.Fatalf("unexpected environment: %v", env)
+	}
+
+	svc, err := client.CoreV1().Services(ns.Name()).Get(ctx, "sql-0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected service to be created: %v", err)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Fatalf("expected headless service, got cluster ip: %s", svc.Spec.ClusterIP)
+	}
+
+	if err := w.Start(ctx); err != nil {
+		t.Fatalf("unexpected error when starting workload: %v", err)
+	}
+	if state := w.Info().State; state != virtual.Running {
+		t.Fatalf("expected started workload to be running, got: %v", state)
+	}
+
+	if err := w.Stop(); err != nil {
+		t.Fatalf("unexpected error when stopping workload: %v", err)
+	}
+	if state := w.Info().State; state != virtual.Stopped {
+		t.Fatalf("expected stopped workload to be stopped, got: %v", state)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error when closing workload: %v", err)
+	}
+	if state := w.Info().State; state != virtual.Error {
+		t.Fatalf("expected closed workload to be gone, got: %v", state)
+	}
+}
+
+func TestRunDNS(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	cluster := NewClusterFromClient(client, Config{})
+	ctx := context.Background()
+
+	ns, err := cluster.CreateNamespace(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error when creating namespace: %v", err)
+	}
+
+	records := []Record{
+		{Name: "formalbank.com", Workload: "csrf-0"},
+		{Name: "gateway.com", IP: "1.2.3.4"},
+	}
+	// running twice updates the records of the running server
+	for i := 0; i < 2; i++ {
+		if err := ns.RunDNS(ctx, records); err != nil {
+			t.Fatalf("unexpected error when running dns: %v", err)
+		}
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(ns.Name()).Get(ctx, dnsName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected dns config to be created: %v", err)
+	}
+	corefile := cm.Data[corefileKey]
+	target := "csrf-0." + ns.Name() + ".svc.cluster.local."
+	if !strings.Contains(corefile, "name exact formalbank.com. "+target) {
+		t.Fatalf("expected record to be rewritten to workload, got:\n%s", corefile)
+	}
+	if !strings.Contains(corefile, "1.2.3.4 gateway.com") {
+		t.Fatalf("expected fixed record within hosts, got:\n%s", corefile)
+	}
+
+	d, err := client.AppsV1().Deployments(ns.Name()).Get(ctx, dnsName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected dns deployment to be created: %v", err)
+	}
+	if *d.Spec.Replicas != 1 {
+		t.Fatalf("expected dns to be started")
+	}
+	if d.Spec.Template.Spec.DNSPolicy == corev1.DNSNone {
+		t.Fatalf("expected dns to resolve through the cluster dns")
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	closeTimeout = 30 * time.Second
+	infoTimeout  = 5 * time.Second
+)
+
+// WorkloadConfig is the counterpart of the docker container
+// configuration for an instance running as a pod
+type WorkloadConfig struct {
+	Image    string
+	EnvVars  map[string]string
+	Labels   map[string]string
+	Cmd      []string
+	MemoryMB uint
+	CPU      float64
+
+	// ConfigMap is mounted at MountPath when set
+	ConfigMap string
+	MountPath string
+
+	clusterDNS bool
+}
+
+// Workload is a deployment of a single pod with a headless service
+// giving the pod a stable name, stopping it scales the deployment
+// to zero such that the pod is recreated from its image on start
+type Workload struct {
+	ns   *Namespace
+	name string
+	conf WorkloadConfig
+}
+
+func (ns *Namespace) NewWorkload(name string, conf WorkloadConfig) *Workload {
+	return &Workload{
+		ns:   ns,
+		name: name,
+		conf: conf,
+	}
+}
+
+func (w *Workload) Name() string {
+	return w.name
+}
+
+// Hostname is the name of the workload within the cluster DNS
+func (w *Workload) Hostname() string {
+	return w.ns.hostname(w.name)
+}
+
+func (w *Workload) selector() map[string]string {
+	return map[string]string{workloadLabel: w.name}
+}
+
+func (w *Workload) podSpec() corev1.PodSpec {
+	var env []corev1.EnvVar
+	for k, v := range w.conf.EnvVars {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+
+	limits := corev1.ResourceList{}
+	if w.conf.MemoryMB > 0 {
+		limits[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", w.conf.MemoryMB))
+	}
+	if w.conf.CPU > 0 {
+		limits[corev1.ResourceCPU] = *resource.NewMilliQuantity(int64(w.conf.CPU*1000), resource.DecimalSI)
+	}
+
+	container := corev1.Container{
+		Name:  "instance",
+		Image: w.conf.Image,
+		Args:  w.conf.Cmd,
+		Env:   env,
+		Resources: corev1.ResourceRequirements{
+			Limits: limits,
+		},
+	}
+
+	automount := false
+	enableLinks := false
+	spec := corev1.PodSpec{
+		Containers:                   []corev1.Container{container},
+		AutomountServiceAccountToken: &automount,
+		EnableServiceLinks:           &enableLinks,
+	}
+
+	if w.conf.ConfigMap != "" {
+		spec.Volumes = []corev1.Volume{{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: w.conf.ConfigMap},
+				},
+			},
+		}}
+		spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+			Name:      "config",
+			MountPath: w.conf.MountPath,
+			ReadOnly:  true,
+		}}
+	}
+
+	if !w.conf.clusterDNS {
+		// resolve through the lab DNS, like containers
+		// on the docker network of a lab do
+		domain := w.ns.cluster.conf.ClusterDomain
+		spec.DNSPolicy = corev1.DNSNone
+		spec.DNSConfig = &corev1.PodDNSConfig{
+			Nameservers: []string{w.ns.dnsIP},
+			Searches: []string{
+				fmt.Sprintf("%s.svc.%s", w.ns.name, domain),
+				"svc." + domain,
+				domain,
+			},
+		}
+	}
+
+	return spec
+}
+
+// Create creates the deployment scaled to zero and its service
+func (w *Workload) Create(ctx context.Context) error {
+	labels := map[string]string{}
+	for k, v := range w.conf.Labels {
+		labels[k] = v
+	}
+	for k, v := range w.selector() {
+		labels[k] = v
+	}
+
+	replicas := int32(0)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   w.name,
+			Labels: labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: w.selector()},
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       w.podSpec(),
+			},
+		},
+	}
+
+	if _, err := w.ns.cluster.client.AppsV1().Deployments(w.ns.name).Create(ctx, deployment, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+
+	// the lab DNS has a service with a cluster ip already
+	if w.name == dnsName {
+		return nil
+	}
+
+	_, err := w.ns.cluster.client.CoreV1().Services(w.ns.name).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   w.name,
+			Labels: labels,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  w.selector(),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("namespace", w.ns.name).
+		Str("name", w.name).
+		Str("image", w.conf.Image).
+		Msg("Created workload")
+
+	return nil
+}
+
+func (w *Workload) scale(ctx context.Context, replicas int32) error {
+	deployments := w.ns.cluster.client.AppsV1().Deployments(w.ns.name)
+	d, err := deployments.Get(ctx, w.name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	d.Spec.Replicas = &replicas
+	_, err = deployments.Update(ctx, d, metav1.UpdateOptions{})
+	return err
+}
+
+func (w *Workload) Start(ctx context.Context) error {
+	if err := w.scale(ctx, 1); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("namespace", w.ns.name).
+		Str("name", w.name).
+		Msg("Started workload")
+
+	return nil
+}
+
+func (w *Workload) Run(ctx context.Context) error {
+	if err := w.Create(ctx); err != nil {
+		return err
+	}
+
+	return w.Start(ctx)
+}
+
+// Suspend scales the deployment to zero, pods cannot be paused
+// so the state of the instance is lost like when it is stopped
+func (w *Workload) Suspend(ctx context.Context) error {
+	return w.scale(ctx, 0)
+}
+
+func (w *Workload) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if err := w.scale(ctx, 0); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("namespace", w.ns.name).
+		Str("name", w.name).
+		Msg("Stopped workload")
+
+	return nil
+}
+
+// Restart replaces the pod of the workload with
+// a fresh one created by the deployment
+func (w *Workload) Restart(ctx context.Context) error {
+	return w.ns.cluster.client.CoreV1().Pods(w.ns.name).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: workloadLabel + "=" + w.name,
+	})
+}
+
+func (w *Workload) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if err := w.ns.cluster.client.AppsV1().Deployments(w.ns.name).Delete(ctx, w.name, metav1.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	if w.name != dnsName {
+		if err := w.ns.cluster.client.CoreV1().Services(w.ns.name).Delete(ctx, w.name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IP returns the address of the running pod of the
+// workload, it is empty while there is no such pod
+func (w *Workload) IP(ctx context.Context) string {
+	pods, err := w.ns.cluster.client.CoreV1().Pods(w.ns.name).List(ctx, metav1.ListOptions{
+		LabelSelector: workloadLabel + "=" + w.name,
+	})
+	if err != nil {
+		return ""
+	}
+
+	for _, p := range pods.Items {
+		if p.Status.Phase == corev1.PodRunning {
+			return p.Status.PodIP
+		}
+	}
+
+	return ""
+}
+
+func (w *Workload) state() virtual.State {
+	ctx, cancel := context.WithTimeout(context.Background(), infoTimeout)
+	defer cancel()
+
+	d, err := w.ns.cluster.client.AppsV1().Deployments(w.ns.name).Get(ctx, w.name, metav1.GetOptions{})
+	if err != nil {
+		return virtual.Error
+	}
+
+	if d.Spec.Replicas == nil || *d.Spec.Replicas == 0 {
+		return virtual.Stopped
+	}
+
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse {
+			return virtual.Error
+		}
+	}
+
+	return virtual.Running
+}
+
+func (w *Workload) Info() virtual.InstanceInfo {
+	return virtual.InstanceInfo{
+		Image: w.conf.Image,
+		Type:  "kubernetes",
+		Id:    w.name,
+		State: w.state(),
+	}
+}
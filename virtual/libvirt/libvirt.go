@@ -0,0 +1,417 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	virshBin   = "virsh"
+	qemuImg    = "qemu-img"
+	qemuURI    = "qemu:///system"
+	imageExt   = ".qcow2"
+	rdpPort    = 3389
+	defaultMB  = 2048
+	defaultCPU = 2
+
+	closeTimeout = 30 * time.Second
+)
+
+var domainTmpl = template.Must(template.New("domain").Parse(`<domain type='kvm' xmlns:qemu='http://libvirt.org/schemas/domain/qemu/1.0'>
+  <name>{{.Name}}</name>
+  <memory unit='MiB'>{{.MemoryMB}}</memory>
+  <vcpu>{{.CPU}}</vcpu>
+  <os>
+    <type arch='x86_64'>hvm</type>
+    <boot dev='hd'/>
+  </os>
+  <features>
+    <acpi/>
+    <apic/>
+  </features>
+  <cpu mode='host-passthrough'/>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='{{.Disk}}'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+{{- if .Bridge}}
+    <interface type='bridge'>
+      <source bridge='{{.Bridge}}'/>
+      <model type='virtio'/>
+    </interface>
+{{- end}}
+{{- if .VNCPort}}
+    <graphics type='vnc' listen='{{.VNCHost}}' port='{{.VNCPort}}' autoport='no'/>
+{{- else}}
+    <graphics type='vnc' listen='127.0.0.1' autoport='yes'/>
+{{- end}}
+  </devices>
+{{- if .RDPPort}}
+  <qemu:commandline>
+    <qemu:arg value='-netdev'/>
+    <qemu:arg value='user,id=rdp,restrict=on,hostfwd=tcp:{{.RDPHost}}:{{.RDPPort}}-:{{.GuestRDPPort}}'/>
+    <qemu:arg value='-device'/>
+    <qemu:arg value='virtio-net-pci,netdev=rdp'/>
+  </qemu:commandline>
+{{- end}}
+</domain>
+`))
+
+type VirshErr struct {
+	Action string
+	Output []byte
+}
+
+func (err *VirshErr) Error() string {
+	return fmt.Sprintf("VirshError [%s]: %s", err.Action, string(err.Output))
+}
+
+// Library creates QEMU/KVM domains from the qcow2 images
+// in its directory, every domain gets its own overlay disk
+// backed by the image, so images are never modified
+type Library interface {
+	GetCopy(context.Context, store.InstanceConfig, ...DomainOpt) (virtual.Instance, error)
+	IsAvailable(string) bool
+}
+
+type library struct {
+	pwd string
+}
+
+func NewLibrary(pwd string) Library {
+	return &library{pwd: pwd}
+}
+
+func (lib *library) getPathFromFile(file string) string {
+	if !strings.HasPrefix(file, lib.pwd) {
+		file = filepath.Join(lib.pwd, file)
+	}
+
+	if !strings.HasSuffix(file, imageExt) {
+		file += imageExt
+	}
+
+	return file
+}
+
+func (lib *library) GetCopy(ctx context.Context, conf store.InstanceConfig, opts ...DomainOpt) (virtual.Instance, error) {
+	path := lib.getPathFromFile(conf.Image)
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	n := strings.TrimSuffix(filepath.Base(path), imageExt)
+	id := strings.Replace(uuid.New().String(), "-", "", -1)[0:12]
+	d := &domain{
+		name:     fmt.Sprintf("%s-%s", n, id),
+		image:    n,
+		base:     path,
+		disk:     filepath.Join(lib.pwd, fmt.Sprintf("%s-%s%s", n, id, imageExt)),
+		memoryMB: defaultMB,
+		cpu:      defaultCPU,
+	}
+
+	if conf.MemoryMB != 0 {
+		opts = append(opts, SetRAM(conf.MemoryMB))
+	}
+
+	if conf.CPU != 0 {
+		opts = append(opts, SetCPU(uint(math.Ceil(conf.CPU))))
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if err := d.Create(ctx); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (lib *library) IsAvailable(file string) bool {
+	path := lib.getPathFromFile(file)
+	if _, err := os.Stat(path); err == nil {
+		return true
+	}
+
+	return false
+}
+
+type DomainOpt func(*domain)
+
+// SetBridge attaches the domain to the given bridge on the host
+func SetBridge(nic string) DomainOpt {
+	return func(d *domain) {
+		d.bridge = nic
+	}
+}
+
+// SetLocalRDP forwards the given address on the host to
+// the RDP server of the guest, the forwarding NIC is
+// restricted such that the guest cannot reach the host
+func SetLocalRDP(ip string, port uint) DomainOpt {
+	return func(d *domain) {
+		d.rdpHost = ip
+		d.rdpPort = port
+	}
+}
+
+// SetLocalVNC exposes the display of the domain
+// through VNC on the given address on the host
+func SetLocalVNC(ip string, port uint) DomainOpt {
+	return func(d *domain) {
+		d.vncHost = ip
+		d.vncPort = port
+	}
+}
+
+func SetCPU(cores uint) DomainOpt {
+	return func(d *domain) {
+		d.cpu = cores
+	}
+}
+
+func SetRAM(mb uint) DomainOpt {
+	return func(d *domain) {
+		d.memoryMB = mb
+	}
+}
+
+type domain struct {
+	name     string
+	image    string
+	base     string
+	disk     string
+	bridge   string
+	rdpHost  string
+	rdpPort  uint
+	vncHost  string
+	vncPort  uint
+	memoryMB uint
+	cpu      uint
+	// defined is set when the domain and its disk are created
+	defined bool
+}
+
+func (d *domain) xml() ([]byte, error) {
+	var buf bytes.Buffer
+	err := domainTmpl.Execute(&buf, struct {
+		Name         string
+		MemoryMB     uint
+		CPU          uint
+		Disk         string
+		Bridge       string
+		RDPHost      string
+		RDPPort      uint
+		GuestRDPPort uint
+		VNCHost      string
+		VNCPort      uint
+	}{
+		Name:         d.name,
+		MemoryMB:     d.memoryMB,
+		CPU:          d.cpu,
+		Disk:         d.disk,
+		Bridge:       d.bridge,
+		RDPHost:      d.rdpHost,
+		RDPPort:      d.rdpPort,
+		GuestRDPPort: rdpPort,
+		VNCHost:      d.vncHost,
+		VNCPort:      d.vncPort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Create defines the domain with its overlay disk, domains
+// which are defined already are left as they are
+func (d *domain) Create(ctx context.Context) error {
+	if d.defined {
+		return nil
+	}
+
+	if _, err := cmdContext(ctx, qemuImg, "create", "-f", "qcow2", "-F", "qcow2", "-b", d.base, d.disk); err != nil {
+		return err
+	}
+
+	raw, err := d.xml()
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile("", "hkn-domain-*.xml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(raw); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	if _, err := VirshCmdContext(ctx, "define", f.Name()); err != nil {
+		os.Remove(d.disk)
+		return err
+	}
+	d.defined = true
+
+	log.Debug().
+		Str("name", d.name).
+		Str("image", d.image).
+		Msg("Created domain")
+
+	return nil
+}
+
+func (d *domain) Run(ctx context.Context) error {
+	if err := d.Create(ctx); err != nil {
+		return err
+	}
+
+	return d.Start(ctx)
+}
+
+func (d *domain) Start(ctx context.Context) error {
+	cmd := "start"
+	if d.state() == virtual.Suspended {
+		cmd = "resume"
+	}
+
+	if _, err := VirshCmdContext(ctx, cmd, d.name); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("name", d.name).
+		Msg("Started domain")
+
+	return nil
+}
+
+func (d *domain) Suspend(ctx context.Context) error {
+	if _, err := VirshCmdContext(ctx, "suspend", d.name); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("name", d.name).
+		Msg("Suspended domain")
+
+	return nil
+}
+
+func (d *domain) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if _, err := VirshCmdContext(ctx, "destroy", d.name); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("name", d.name).
+		Msg("Stopped domain")
+
+	return nil
+}
+
+func (d *domain) Close() error {
+	if d.state() != virtual.Stopped {
+		if err := d.Stop(); err != nil {
+			log.Warn().
+				Str("name", d.name).
+				Msgf("Failed to stop domain: %s", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if _, err := VirshCmdContext(ctx, "undefine", d.name); err != nil {
+		return err
+	}
+
+	d.defined = false
+	if err := os.Remove(d.disk); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("name", d.name).
+		Msg("Closed domain")
+
+	return nil
+}
+
+func (d *domain) state() virtual.State {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	out, err := VirshCmdContext(ctx, "domstate", d.name)
+	if err != nil {
+		return virtual.Error
+	}
+
+	switch strings.TrimSpace(string(out)) {
+	case "running":
+		return virtual.Running
+	case "paused", "pmsuspended":
+		return virtual.Suspended
+	case "shut off":
+		return virtual.Stopped
+	}
+
+	return virtual.Error
+}
+
+func (d *domain) Info() virtual.InstanceInfo {
+	return virtual.InstanceInfo{
+		Image: d.image,
+		Type:  "libvirt",
+		Id:    d.name,
+		State: d.state(),
+	}
+}
+
+func VirshCmdContext(ctx context.Context, cmd string, cmds ...string) ([]byte, error) {
+	return cmdContext(ctx, virshBin, append([]string{"-c", qemuURI, cmd}, cmds...)...)
+}
+
+func cmdContext(ctx context.Context, bin string, command ...string) ([]byte, error) {
+	c := exec.CommandContext(ctx, bin, command...)
+	out, err := c.CombinedOutput()
+	if err != nil {
+		return nil, &VirshErr{
+			Action: strings.Join(append([]string{bin}, command...), " "),
+			Output: out,
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package libvirt
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDomainXML(t *testing.T) {
+	tt := []struct {
+		name     string
+		opts     []DomainOpt
+		expected []string
+		absent   []string
+	}{
+		{
+			name: "Defaults",
+			expected: []string{
+				"<name>kali-1a2b3c</name>",
+				"<memory unit='MiB'>2048</memory>",
+				"<vcpu>2</vcpu>",
+				"<source file='/images/kali-1a2b3c.qcow2'/>",
+				"<graphics type='vnc' listen='127.0.0.1' autoport='yes'/>",
+			},
+			absent: []string{"<interface", "<qemu:commandline>"},
+		},
+		{
+			name: "Bridge",
+			opts: []DomainOpt{SetBridge("br-8f2c1d")},
+			expected: []string{
+				"<interface type='bridge'>",
+				"<source bridge='br-8f2c1d'/>",
+				"<model type='virtio'/>",
+			},
+			absent: []string{"type='direct'"},
+		},
+		{
+			name: "RDP",
+			opts: []DomainOpt{SetLocalRDP("127.0.0.1", 5000), SetRAM(4096), SetCPU(4)},
+			expected: []string{
+				"<memory unit='MiB'>4096</memory>",
+				"<vcpu>4</vcpu>",
+				"hostfwd=tcp:127.0.0.1:5000-:3389",
+			},
+		},
+		{
+			name: "VNC",
+			opts: []DomainOpt{SetLocalVNC("127.0.0.1", 5001)},
+			expected: []string{
+				"<graphics type='vnc' listen='127.0.0.1' port='5001' autoport='no'/>",
+			},
+			absent: []string{"<qemu:commandline>"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &domain{
+				name:     "kali-1a2b3c",
+				image:    "kali",
+				disk:     "/images/kali-1a2b3c.qcow2",
+				memoryMB: defaultMB,
+				cpu:      defaultCPU,
+			}
+			for _, opt := range tc.opts {
+				opt(d)
+			}
+
+			raw, err := d.xml()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, e := range tc.expected {
+				if !strings.Contains(string(raw), e) {
+					t.Fatalf("expected %q in domain xml:\n%s", e, raw)
+				}
+			}
+			for _, a := range tc.absent {
+				if strings.Contains(string(raw), a) {
+					t.Fatalf("unexpected %q in domain xml:\n%s", a, raw)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateDefinedDomain(t *testing.T) {
+	// qemu-img and virsh are not called for domains which
+	// are defined already, so Run does not create them twice
+	d := &domain{name: "kali-1a2b3c", defined: true}
+	if err := d.Create(context.Background()); err != nil {
+		t.Fatalf("expected no error when creating defined domain, got: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package vbox
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttachedDisks(t *testing.T) {
+	raw := []byte(`name="kali"
+"SATA Controller-0-0"="/root/VirtualBox VMs/kali/Snapshots/{6b1f}.vdi"
+"SATA Controller-ImageUUID-0-0"="6b1f"
+"IDE Controller-0-0"="/root/guest.iso"
+"IDE Controller-ImageUUID-0-0"="a7c2"
+"IDE Controller-1-0"="emptydrive"
+`)
+
+	disks := attachedDisks(raw)
+	if expected := []string{"6b1f"}; !reflect.DeepEqual(disks, expected) {
+		t.Fatalf("expected disks %v, got %v", expected, disks)
+	}
+}
+
+func TestMediumInfoValue(t *testing.T) {
+	raw := []byte(`UUID:           6b1f
+Parent UUID:    3d9e
+State:          locked write
+Location:       /root/VirtualBox VMs/kali/Snapshots/{6b1f}.vdi
+`)
+
+	tt := []struct {
+		key      string
+		expected string
+		ok       bool
+	}{
+		{key: "Parent UUID", expected: "3d9e", ok: true},
+		{key: "Location", expected: "/root/VirtualBox VMs/kali/Snapshots/{6b1f}.vdi", ok: true},
+		{key: "Format"},
+	}
+	for _, tc := range tt {
+		v, ok := mediumInfoValue(raw, tc.key)
+		if v != tc.expected || ok != tc.ok {
+			t.Fatalf("expected %q (%t) for %s, got %q (%t)", tc.expected, tc.ok, tc.key, v, ok)
+		}
+	}
+}
+
+func TestLatestStateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if fi, err := latestStateFile(dir); err != nil || fi != nil {
+		t.Fatalf("expected no state file, got %v (%v)", fi, err)
+	}
+
+	files := []struct {
+		name string
+		size int
+		age  time.Duration
+	}{
+		{name: "2020-01-01T10-00-00-000000000Z.sav", size: 10, age: time.Hour},
+		{name: "2020-01-01T11-00-00-000000000Z.sav", size: 20},
+		{name: "{6b1f}.vdi", size: 30},
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := ioutil.WriteFile(path, make([]byte, f.size), 0644); err != nil {
+			t.Fatalf("unable to write file: %v", err)
+		}
+		mtime := time.Now().Add(-f.age)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatalf("unable to set time of file: %v", err)
+		}
+	}
+
+	fi, err := latestStateFile(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fi == nil || fi.Size() != 20 {
+		t.Fatalf("expected latest state file of size 20, got %v", fi)
+	}
+}
@@ -32,6 +32,8 @@ import (
 const (
 	stateRegex = `State:\s*(.*)`
 	nicRegex   = "\\bNIC\\b"
+	// VMs imported from OVAs are named <image>{<checksum>}
+	baseVMRegex = `"(([^"{]+)\{[0-9a-f]{8}\})" \{`
 
 	vboxBin          = "VBoxManage"
 	vboxModVM        = "modifyvm"
@@ -39,6 +41,12 @@ const (
 	vboxCtrlVM       = "controlvm"
 	vboxUnregisterVM = "unregistervm"
 	vboxShowVMInfo   = "showvminfo"
+	vboxSnapshot     = "snapshot"
+
+	snapshotTimeout = 5 * time.Minute
+
+	// InstanceType is the type of the info of VMs
+	InstanceType = "vbox"
 )
 
 var FileTransferRoot string
@@ -59,12 +67,17 @@ func (err *VBoxErr) Error() string {
 type VM interface {
 	virtual.Instance
 	Snapshot(string) error
+	RestoreSnapshot(context.Context, string) error
+	DeleteSnapshot(context.Context, string) error
+	SnapshotSize(context.Context) (int64, error)
 	LinkedClone(context.Context, string, ...VMOpt) (VM, error)
 }
 
 type Library interface {
 	GetCopy(context.Context, store.InstanceConfig, ...VMOpt) (VM, error)
 	IsAvailable(string) bool
+	Prepare(context.Context, string) error
+	Prune(context.Context, []string) ([]string, error)
 }
 
 type vBoxLibrary struct {
@@ -304,18 +317,201 @@ func (vm *vm) ensureStopped(ctx context.Context) (func(), error) {
 		}
 	}, nil
 }
+
+// Snapshot takes a snapshot of the VM, running VMs
+// are snapshotted live including their memory state
 func (vm *vm) Snapshot(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
 	defer cancel()
 
-	_, err := VBoxCmdContext(ctx, "snapshot", vm.id, "take", name)
+	args := []string{vm.id, "take", name}
+	if vm.running {
+		args = append(args, "--live")
+	}
+	_, err := VBoxCmdContext(ctx, vboxSnapshot, args...)
 	if err != nil {
 		return err
 	}
 
+	log.Debug().
+		Str("ID", vm.id).
+		Str("snapshot", name).
+		Msg("Took snapshot of VM")
+
+	return nil
+}
+
+// RestoreSnapshot powers off the VM, restores the given snapshot
+// and starts the VM again when it was running before
+func (vm *vm) RestoreSnapshot(ctx context.Context, name string) error {
+	wasRunning := vm.running
+	if vm.running {
+		if err := vm.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if vm.state() == virtual.Suspended {
+		if _, err := VBoxCmdContext(ctx, "discardstate", vm.id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := VBoxCmdContext(ctx, vboxSnapshot, vm.id, "restore", name); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("ID", vm.id).
+		Str("snapshot", name).
+		Msg("Restored snapshot of VM")
+
+	if wasRunning {
+		return vm.Start(ctx)
+	}
+
+	return nil
+}
+
+func (vm *vm) DeleteSnapshot(ctx context.Context, name string) error {
+	_, err := VBoxCmdContext(ctx, vboxSnapshot, vm.id, "delete", name)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("ID", vm.id).
+		Str("snapshot", name).
+		Msg("Deleted snapshot of VM")
+
 	return nil
 }
 
+// SnapshotSize returns the size of the differencing disks which were
+// frozen by the latest snapshot of the VM, they contain the changes
+// since the snapshot before, and of the memory state saved by it
+func (vm *vm) SnapshotSize(ctx context.Context) (int64, error) {
+	raw, err := VBoxCmdContext(ctx, vboxShowVMInfo, vm.id, "--machinereadable")
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, id := range attachedDisks(raw) {
+		info, err := VBoxCmdContext(ctx, "showmediuminfo", "disk", id)
+		if err != nil {
+			return 0, err
+		}
+		parent, ok := mediumInfoValue(info, "Parent UUID")
+		if !ok || parent == "base" {
+			continue
+		}
+
+		info, err = VBoxCmdContext(ctx, "showmediuminfo", "disk", parent)
+		if err != nil {
+			return 0, err
+		}
+		location, ok := mediumInfoValue(info, "Location")
+		if !ok {
+			return 0, fmt.Errorf("unable to find location of disk %s", parent)
+		}
+		fi, err := os.Stat(location)
+		if err != nil {
+			return 0, err
+		}
+		size += fi.Size()
+	}
+
+	// running VMs are snapshotted live, which saves
+	// their memory state in the snapshot folder
+	if vm.running {
+		folder, ok := machineReadableValue(raw, "SnapFldr")
+		if !ok {
+			return 0, fmt.Errorf("unable to find snapshot folder of vm %s", vm.id)
+		}
+		fi, err := latestStateFile(folder)
+		if err != nil {
+			return 0, err
+		}
+		if fi != nil {
+			size += fi.Size()
+		}
+	}
+
+	return size, nil
+}
+
+// latestStateFile returns the most recent saved state file in
+// the snapshot folder, nil is returned when there is none
+func latestStateFile(folder string) (os.FileInfo, error) {
+	files, err := filepath.Glob(filepath.Join(folder, "*.sav"))
+	if err != nil {
+		return nil, err
+	}
+
+	var latest os.FileInfo
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || fi.ModTime().After(latest.ModTime()) {
+			latest = fi
+		}
+	}
+
+	return latest, nil
+}
+
+// attachedDisks returns the ids of the hard disks which
+// are attached to the VM, optical drives are left out
+func attachedDisks(raw []byte) []string {
+	var ids []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.Trim(kv[0], `"`)
+		if !strings.Contains(key, "-ImageUUID-") {
+			continue
+		}
+		location, _ := machineReadableValue(raw, strings.Replace(key, "-ImageUUID-", "-", 1))
+		if strings.HasSuffix(strings.ToLower(location), ".iso") {
+			continue
+		}
+		ids = append(ids, strings.Trim(kv[1], `"`))
+	}
+
+	return ids
+}
+
+// mediumInfoValue returns the value of a key
+// in the output of showmediuminfo
+func mediumInfoValue(raw []byte, key string) (string, bool) {
+	for _, line := range strings.Split(string(raw), "\n") {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) != key {
+			continue
+		}
+		return strings.TrimSpace(kv[1]), true
+	}
+
+	return "", false
+}
+
+func machineReadableValue(raw []byte, key string) (string, bool) {
+	for _, line := range strings.Split(string(raw), "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 || strings.Trim(kv[0], `"`) != key {
+			continue
+		}
+		return strings.Trim(kv[1], `"`), true
+	}
+
+	return "", false
+}
+
 func (v *vm) LinkedClone(ctx context.Context, snapshot string, vmOpts ...VMOpt) (VM, error) {
 	newID := strings.Replace(uuid.New().String(), "-", "", -1)
 	_, err := VBoxCmdContext(ctx, "clonevm", v.id, "--snapshot", snapshot, "--options", "link", "--name", newID, "--register")
@@ -364,7 +560,7 @@ func (v *vm) state() virtual.State {
 func (v *vm) Info() virtual.InstanceInfo {
 	return virtual.InstanceInfo{
 		Image: v.image,
-		Type:  "vbox",
+		Type:  InstanceType,
 		Id:    v.id,
 		State: v.state(),
 	}
@@ -390,10 +586,9 @@ func (lib *vBoxLibrary) getPathFromFile(file string) string {
 	return file
 }
 
-func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig, vmOpts ...VMOpt) (VM, error) {
-	path := lib.getPathFromFile(conf.Image)
-
+func (lib *vBoxLibrary) pathLock(path string) *sync.Mutex {
 	lib.m.Lock()
+	defer lib.m.Unlock()
 
 	pathLock, ok := lib.locks[path]
 	if !ok {
@@ -406,17 +601,12 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 		Bool("first_time", ok == false).
 		Msg("getting path lock")
 
-	lib.m.Unlock()
-
-	pathLock.Lock()
-	defer pathLock.Unlock()
+	return pathLock
+}
 
-	vm, ok := lib.known[path]
-	if ok {
-		return vm.LinkedClone(ctx, "origin", vmOpts...) // if ok==true then VM will be linked without the ram value which is exist on configuration file
-		// vbox.SetRAM(conf.memoryMB) on addFrontend function in lab.go fixes the problem...
-	}
-	// if ok==false, then following codes will be run, in that case there will be no problem because at the end instance returns with specified VMOpts parameter.
+// importBase imports the OVA as the VM which linked clones are
+// created from, unless it already exists, the path lock must be held
+func (lib *vBoxLibrary) importBase(ctx context.Context, path string) (VM, error) {
 	sum, err := checksumOfFile(path)
 	if err != nil {
 		return nil, err
@@ -424,7 +614,7 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 
 	n := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 
-	vm, ok = VmExists(n, sum)
+	vm, ok := VmExists(n, sum)
 	if !ok {
 		vm = NewVMWithSum(path, n, sum)
 		if err := vm.Create(ctx); err != nil {
@@ -441,6 +631,27 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 	lib.known[path] = vm
 	lib.m.Unlock()
 
+	return vm, nil
+}
+
+func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig, vmOpts ...VMOpt) (VM, error) {
+	path := lib.getPathFromFile(conf.Image)
+
+	pathLock := lib.pathLock(path)
+	pathLock.Lock()
+	defer pathLock.Unlock()
+
+	vm, ok := lib.known[path]
+	if ok {
+		return vm.LinkedClone(ctx, "origin", vmOpts...) // if ok==true then VM will be linked without the ram value which is exist on configuration file
+		// vbox.SetRAM(conf.memoryMB) on addFrontend function in lab.go fixes the problem...
+	}
+	// if ok==false, then following codes will be run, in that case there will be no problem because at the end instance returns with specified VMOpts parameter.
+	vm, err := lib.importBase(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
 	if conf.CPU != 0 {
 		vmOpts = append(vmOpts, SetCPU(uint(math.Ceil(conf.CPU))))
 	}
@@ -457,6 +668,80 @@ func (lib *vBoxLibrary) GetCopy(ctx context.Context, conf store.InstanceConfig,
 	return instance, nil
 }
 
+// Prepare imports the OVA of the image ahead of the
+// first frontend, which is then a linked clone only
+func (lib *vBoxLibrary) Prepare(ctx context.Context, file string) error {
+	path := lib.getPathFromFile(file)
+
+	pathLock := lib.pathLock(path)
+	pathLock.Lock()
+	defer pathLock.Unlock()
+
+	lib.m.Lock()
+	_, ok := lib.known[path]
+	lib.m.Unlock()
+	if ok {
+		return nil
+	}
+
+	_, err := lib.importBase(ctx, path)
+	return err
+}
+
+// Prune removes the VMs imported from OVAs which are not in keep,
+// as well as imports of outdated versions of the OVAs. VMs which
+// still have linked clones cannot be removed and are skipped.
+// The names of the removed VMs are returned.
+func (lib *vBoxLibrary) Prune(ctx context.Context, keep []string) ([]string, error) {
+	kept := map[string]struct{}{}
+	for _, k := range keep {
+		kept[lib.getPathFromFile(k)] = struct{}{}
+	}
+
+	out, err := VBoxCmdContext(ctx, "list", "vms")
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	r := regexp.MustCompile(baseVMRegex)
+	for _, m := range r.FindAllStringSubmatch(string(out), -1) {
+		name, image := m[1], m[2]
+		path := lib.getPathFromFile(image)
+
+		pathLock := lib.pathLock(path)
+		pathLock.Lock()
+		lib.m.Lock()
+		current, ok := lib.known[path]
+		lib.m.Unlock()
+
+		_, isKept := kept[path]
+		outdated := false
+		if v, isVM := current.(*vm); ok && isVM {
+			outdated = v.id != name
+		}
+		if isKept && !outdated {
+			pathLock.Unlock()
+			continue
+		}
+
+		_, err := VBoxCmdContext(ctx, vboxUnregisterVM, name, "--delete")
+		if err == nil {
+			removed = append(removed, name)
+			if ok && !outdated {
+				lib.m.Lock()
+				delete(lib.known, path)
+				lib.m.Unlock()
+			}
+		} else {
+			log.Debug().Str("vm", name).Msgf("Unable to remove imported OVA: %v", err)
+		}
+		pathLock.Unlock()
+	}
+
+	return removed, nil
+}
+
 func (lib *vBoxLibrary) IsAvailable(file string) bool {
 	path := lib.getPathFromFile(file)
 	if _, err := os.Stat(path); err == nil {
@@ -10,6 +10,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
@@ -38,6 +39,71 @@ type Instance interface {
 	io.Closer
 }
 
+// Usage is a sample of the resources consumed by one or more instances
+type Usage struct {
+	CPUPercent  float64 `json:"cpuPercent"`
+	MemoryBytes uint64  `json:"memoryBytes"`
+	Pids        uint64  `json:"pids"`
+	NetRxBytes  uint64  `json:"netRxBytes"`
+	NetTxBytes  uint64  `json:"netTxBytes"`
+	BlockRead   uint64  `json:"blockRead"`
+	BlockWrite  uint64  `json:"blockWrite"`
+}
+
+// Add returns the sum of the two samples
+func (u Usage) Add(o Usage) Usage {
+	return Usage{
+		CPUPercent:  u.CPUPercent + o.CPUPercent,
+		MemoryBytes: u.MemoryBytes + o.MemoryBytes,
+		Pids:        u.Pids + o.Pids,
+		NetRxBytes:  u.NetRxBytes + o.NetRxBytes,
+		NetTxBytes:  u.NetTxBytes + o.NetTxBytes,
+		BlockRead:   u.BlockRead + o.BlockRead,
+		BlockWrite:  u.BlockWrite + o.BlockWrite,
+	}
+}
+
+// UsageReporter is implemented by instances able to report
+// their current resource consumption
+type UsageReporter interface {
+	Usage(context.Context) (Usage, error)
+}
+
+// SampleUsage samples the instances reporting their usage concurrently
+// and returns the sum, instances failing to report are left out and
+// the last error is returned
+func SampleUsage(ctx context.Context, instances ...Instance) (Usage, error) {
+	var (
+		wg    sync.WaitGroup
+		m     sync.Mutex
+		total Usage
+		last  error
+	)
+	for _, i := range instances {
+		r, ok := i.(UsageReporter)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			u, err := r.Usage(ctx)
+
+			m.Lock()
+			defer m.Unlock()
+			if err != nil {
+				last = err
+				return
+			}
+			total = total.Add(u)
+		}()
+	}
+	wg.Wait()
+
+	return total, last
+}
+
 type ResourceResizer interface {
 	SetRAM(uint) error
 	SetCPU(uint) error
@@ -0,0 +1,660 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	"github.com/aau-network-security/haaukins/virtual/libvirt"
+	"github.com/aau-network-security/haaukins/virtual/vbox"
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	rpcTimeout        = 30 * time.Second
+	drainPollInterval = 10 * time.Second
+	captureGCInterval = time.Hour
+	// imagePulls is the amount of images pulled at a time
+	imagePulls = 4
+)
+
+var (
+	ErrDraining   = errors.New("worker is draining and does not accept new labs")
+	ErrNoCapacity = errors.New("worker has no capacity for more labs")
+	ErrUnknownLab = errors.New("unknown lab on worker")
+)
+
+// Agent runs labs on a worker host on behalf of the daemon, it
+// registers at the coordinator of the daemon and reports its labs
+type Agent struct {
+	conf        Config
+	coordinator pb.CoordinatorClient
+	newCreator  func(lab.Config) lab.Creator
+	vlib        vbox.Library
+	m           sync.RWMutex
+	labs        map[string]lab.Lab
+	creating    int
+	draining    bool
+	stop        chan struct{}
+	pb.UnimplementedWorkerServer
+}
+
+func NewAgent(conf Config) (*Agent, error) {
+	conn, err := dial(conf.Coordinator, conf.Keys, conf.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	vlib := vbox.NewLibrary(conf.ConfFiles.OvaDir)
+	providers := lab.NewFrontendProviders(vlib, libvirt.NewLibrary(conf.ConfFiles.LibvirtDir))
+
+	a := newAgent(conf, pb.NewCoordinatorClient(conn), func(labConf lab.Config) lab.Creator {
+		return &lab.LabHost{
+			Vlib:      vlib,
+			Providers: providers,
+			RDPHost:   frontendHost,
+			Conf:      labConf,
+		}
+	})
+	a.vlib = vlib
+
+	return a, nil
+}
+
+func newAgent(conf Config, coordinator pb.CoordinatorClient, newCreator func(lab.Config) lab.Creator) *Agent {
+	return &Agent{
+		conf:        conf,
+		coordinator: coordinator,
+		newCreator:  newCreator,
+		labs:        map[string]lab.Lab{},
+		stop:        make(chan struct{}),
+	}
+}
+
+// Run serves the worker service and keeps the
+// worker registered at the coordinator
+func (a *Agent) Run() error {
+	lis, err := net.Listen("tcp", a.conf.Listen)
+	if err != nil {
+		return err
+	}
+
+	opts, err := serverOpts(a.conf.Keys, a.conf.TLS)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterWorkerServer(s, a)
+
+	go a.heartbeat()
+	go a.pruneCaptures()
+
+	log.Info().
+		Str("name", a.conf.Name).
+		Str("listen", a.conf.Listen).
+		Msg("Worker service has been started")
+
+	return s.Serve(lis)
+}
+
+func (a *Agent) register() (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := a.coordinator.Register(ctx, &pb.RegisterRequest{
+		Name:     a.conf.Name,
+		Address:  a.conf.Advertise,
+		RdpHost:  a.conf.RDPHost,
+		Capacity: a.conf.Capacity,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// labs from before the registration are unknown to
+	// the coordinator, it has created them elsewhere
+	a.closeLabs()
+
+	log.Info().Str("coordinator", a.conf.Coordinator).Msg("Registered worker")
+
+	return time.Duration(resp.HeartbeatIntervalSeconds) * time.Second, nil
+}
+
+func (a *Agent) heartbeat() {
+	interval := defaultHeartbeatInterval
+	registered := false
+	for {
+		if !registered {
+			i, err := a.register()
+			if err != nil {
+				log.Warn().Msgf("Unable to register at coordinator: %v", err)
+			} else {
+				registered = true
+				if i > 0 {
+					interval = i
+				}
+			}
+		} else {
+			ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+			resp, err := a.coordinator.Heartbeat(ctx, &pb.HeartbeatRequest{
+				Name: a.conf.Name,
+				Labs: a.tags(),
+			})
+			cancel()
+			if err != nil {
+				log.Warn().Msgf("Unable to send heartbeat to coordinator: %v", err)
+			} else if resp.Unknown {
+				registered = false
+				continue
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// pruneCaptures removes the captures of labs which
+// have not been written to within the retention
+func (a *Agent) pruneCaptures() {
+	for {
+		removed, err := capture.Prune(a.conf.ConfFiles.CaptureDir, a.conf.CaptureRetention)
+		if err != nil {
+			log.Warn().Msgf("Unable to prune captures: %v", err)
+		}
+		if len(removed) > 0 {
+			log.Info().Strs("captures", removed).Msg("Removed captures after their retention")
+		}
+
+		select {
+		case <-time.After(captureGCInterval):
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// Drain stops the worker from receiving new labs and waits until the
+// daemon has closed the labs of the worker or the drain timeout has
+// passed, the daemon recreates the labs left on other workers
+func (a *Agent) Drain() error {
+	a.m.Lock()
+	a.draining = true
+	a.m.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	_, err := a.coordinator.Drain(ctx, &pb.WorkerRequest{Name: a.conf.Name})
+	cancel()
+	if err != nil {
+		log.Warn().Msgf("Unable to notify coordinator about draining: %v", err)
+	}
+
+	log.Info().Dur("timeout", a.conf.DrainTimeout).Msg("Draining worker")
+
+	deadline := time.After(a.conf.DrainTimeout)
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+Wait:
+	for len(a.tags()) > 0 {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			break Wait
+		}
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), rpcTimeout)
+	_, err = a.coordinator.Deregister(ctx, &pb.WorkerRequest{Name: a.conf.Name})
+	cancel()
+
+	close(a.stop)
+	a.closeLabs()
+
+	return err
+}
+
+func (a *Agent) tags() []string {
+	a.m.RLock()
+	defer a.m.RUnlock()
+
+	var tags []string
+	for tag := range a.labs {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+func (a *Agent) closeLabs() {
+	a.m.Lock()
+	labs := a.labs
+	a.labs = map[string]lab.Lab{}
+	a.m.Unlock()
+
+	var wg sync.WaitGroup
+	for _, l := range labs {
+		wg.Add(1)
+		go func(l lab.Lab) {
+			defer wg.Done()
+			if err := l.Close(); err != nil {
+				log.Error().Msgf("Error while closing lab %s: %v", l.Tag(), err)
+			}
+		}(l)
+	}
+	wg.Wait()
+}
+
+func (a *Agent) getLab(tag string) (lab.Lab, error) {
+	a.m.RLock()
+	defer a.m.RUnlock()
+
+	l, ok := a.labs[tag]
+	if !ok {
+		return nil, status.Error(codes.NotFound, ErrUnknownLab.Error())
+	}
+
+	return l, nil
+}
+
+func (a *Agent) CreateLab(ctx context.Context, req *pb.CreateLabRequest) (*pb.LabInfo, error) {
+	var conf lab.Config
+	if err := json.Unmarshal(req.Config, &conf); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	conf.Subnet = req.Subnet
+	// the directory of the event is kept below the capture directory of the worker
+	if conf.Capture != nil {
+		c := *conf.Capture
+		c.Directory = filepath.Join(a.conf.ConfFiles.CaptureDir, filepath.Base(c.Directory))
+		conf.Capture = &c
+	}
+
+	a.m.Lock()
+	if a.draining {
+		a.m.Unlock()
+		return nil, status.Error(codes.Unavailable, ErrDraining.Error())
+	}
+	if int32(len(a.labs)+a.creating) >= a.conf.Capacity {
+		a.m.Unlock()
+		return nil, status.Error(codes.ResourceExhausted, ErrNoCapacity.Error())
+	}
+	a.creating += 1
+	a.m.Unlock()
+
+	l, err := a.newCreator(conf).NewLab(ctx, req.IsVPN)
+
+	a.m.Lock()
+	a.creating -= 1
+	if err == nil {
+		a.labs[l.Tag()] = l
+	}
+	a.m.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("lab", l.Tag()).Msg("Created lab for the daemon")
+
+	return labInfo(l)
+}
+
+func (a *Agent) GetLab(ctx context.Context, req *pb.LabRequest) (*pb.LabInfo, error) {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := labInfo(l)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Usage {
+		info.Usage, err = json.Marshal(l.Usage(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+func (a *Agent) LabAction(ctx context.Context, req *pb.LabActionRequest) (*pb.LabInfo, error) {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case pb.LabActionRequest_START:
+		err = l.Start(ctx)
+	case pb.LabActionRequest_STOP:
+		err = l.Stop()
+	case pb.LabActionRequest_RESTART:
+		err = l.Restart(ctx)
+	case pb.LabActionRequest_SUSPEND:
+		err = l.Suspend(ctx)
+	case pb.LabActionRequest_RESUME:
+		err = l.Resume(ctx)
+	case pb.LabActionRequest_RESET_FRONTENDS:
+		err = l.ResetFrontends(ctx, req.EventTag, req.TeamId)
+	case pb.LabActionRequest_REPAIR:
+		_, err = l.Repair(ctx)
+	case pb.LabActionRequest_START_CAPTURE:
+		err = l.Environment().StartCapture()
+	case pb.LabActionRequest_CLOSE:
+		a.m.Lock()
+		delete(a.labs, req.Tag)
+		a.m.Unlock()
+
+		if err := l.Close(); err != nil {
+			return nil, err
+		}
+		return &pb.LabInfo{Tag: req.Tag}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return labInfo(l)
+}
+
+func (a *Agent) ExerciseAction(ctx context.Context, req *pb.ExerciseActionRequest) (*pb.LabInfo, error) {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case pb.ExerciseActionRequest_RESET:
+		err = l.Environment().ResetByTag(ctx, req.ExerciseTag)
+	case pb.ExerciseActionRequest_START:
+		err = l.Environment().StartByTag(ctx, req.ExerciseTag)
+	case pb.ExerciseActionRequest_STOP:
+		err = l.Environment().StopByTag(req.ExerciseTag)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return labInfo(l)
+}
+
+func (a *Agent) AddChallenges(ctx context.Context, req *pb.AddChallengesRequest) (*pb.LabInfo, error) {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var exercises []store.Exercise
+	if err := json.Unmarshal(req.Exercises, &exercises); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := l.AddChallenge(ctx, exercises...); err != nil {
+		return nil, err
+	}
+
+	return labInfo(l)
+}
+
+func (a *Agent) SnapshotAction(ctx context.Context, req *pb.SnapshotActionRequest) (*pb.LabInfo, error) {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case pb.SnapshotActionRequest_TAKE:
+		_, err = l.TakeSnapshot(ctx, req.Name)
+	case pb.SnapshotActionRequest_RESTORE:
+		err = l.RestoreSnapshot(ctx, req.Name, req.EventTag, req.TeamId)
+	case pb.SnapshotActionRequest_DELETE:
+		err = l.DeleteSnapshot(ctx, req.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return labInfo(l)
+}
+
+// Tunnel relays a connection of the daemon to the remote desktop of
+// a frontend, which is bound to the loopback address of the worker
+func (a *Agent) Tunnel(stream pb.Worker_TunnelServer) error {
+	header, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	l, err := a.getLab(header.Tag)
+	if err != nil {
+		return err
+	}
+
+	known := false
+	for _, c := range l.FrontendConns() {
+		if uint32(c.Port) == header.Port {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return status.Errorf(codes.PermissionDenied, "unknown frontend port %d of lab %s", header.Port, header.Tag)
+	}
+
+	c, err := net.DialTimeout("tcp", hostPort(frontendHost, header.Port), forwardDialTimeout)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return relayTunnel(stream, c)
+}
+
+// Terminal relays a shell in the attacker container of a lab to the daemon
+func (a *Agent) Terminal(stream pb.Worker_TerminalServer) error {
+	header, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	l, err := a.getLab(header.Tag)
+	if err != nil {
+		return err
+	}
+
+	shell, err := l.Terminal(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer shell.Close()
+
+	if err := stream.Send(&pb.TerminalData{}); err != nil {
+		return err
+	}
+
+	done := make(chan error, 2)
+	go func() {
+		buf := make([]byte, tunnelChunkSize)
+		for {
+			n, err := shell.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&pb.TerminalData{Data: buf[:n]}); err != nil {
+					done <- err
+					return
+				}
+			}
+			if err != nil {
+				done <- nil
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				done <- nil
+				return
+			}
+			if msg.Rows > 0 && msg.Cols > 0 {
+				err = shell.Resize(uint16(msg.Rows), uint16(msg.Cols))
+			} else {
+				_, err = shell.Write(msg.Data)
+			}
+			if err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	// either side closing ends the shell
+	return <-done
+}
+
+type captureWriter struct {
+	stream pb.Worker_DownloadCaptureServer
+}
+
+func (cw *captureWriter) Write(p []byte) (int, error) {
+	if err := cw.stream.Send(&pb.CaptureChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// DownloadCapture streams the captured traffic of a lab as a gzipped tarball
+func (a *Agent) DownloadCapture(req *pb.LabRequest, stream pb.Worker_DownloadCaptureServer) error {
+	l, err := a.getLab(req.Tag)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&captureWriter{stream}, tunnelChunkSize)
+	if err := l.Capture(stream.Context(), w); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// PrepareImages pulls the images and imports the OVAs of an event
+// on the worker, the first error is returned after all are handled
+func (a *Agent) PrepareImages(req *pb.PrepareImagesRequest, stream pb.Worker_PrepareImagesServer) error {
+	ctx := stream.Context()
+
+	var m sync.Mutex
+	send := func(s *pb.PrepareImagesStatus) {
+		m.Lock()
+		defer m.Unlock()
+		stream.Send(s)
+	}
+
+	err := docker.PrepareImages(ctx, req.Images, imagePulls, func(p docker.ImageProgress) {
+		s := &pb.PrepareImagesStatus{Image: p.Image, Status: p.Status}
+		if p.Err != nil {
+			s.ErrorMessage = p.Err.Error()
+		}
+		send(s)
+	})
+
+	seen := map[string]struct{}{}
+	for _, ova := range req.Ovas {
+		if _, ok := seen[ova]; ok {
+			continue
+		}
+		seen[ova] = struct{}{}
+
+		send(&pb.PrepareImagesStatus{Image: ova, Ova: true, Status: docker.ImagePulling})
+		if prepErr := a.vlib.Prepare(ctx, ova); prepErr != nil {
+			send(&pb.PrepareImagesStatus{Image: ova, Ova: true, Status: docker.ImageFailed, ErrorMessage: prepErr.Error()})
+			if err == nil {
+				err = prepErr
+			}
+			continue
+		}
+		send(&pb.PrepareImagesStatus{Image: ova, Ova: true, Status: docker.ImageUpToDate})
+	}
+
+	return err
+}
+
+// labInfo describes the lab for the daemon, the
+// health of the lab is probed on every call
+func labInfo(l lab.Lab) (*pb.LabInfo, error) {
+	env := l.Environment()
+	info := &pb.LabInfo{
+		Tag:        l.Tag(),
+		LabSubnet:  env.LabSubnet(),
+		LabSubnet6: env.LabSubnet6(),
+		LabDNS:     env.LabDNS(),
+	}
+
+	for _, c := range l.FrontendConns() {
+		info.Frontends = append(info.Frontends, &pb.LabInfo_Frontend{
+			Port:     uint32(c.Port),
+			Protocol: c.Protocol,
+		})
+	}
+
+	for _, r := range env.DNSRecords() {
+		for ip, name := range r.Record {
+			info.Records = append(info.Records, &pb.LabInfo_Record{Ip: ip, Name: name, Network: r.Network})
+		}
+	}
+
+	for _, c := range env.Challenges() {
+		info.Challenges = append(info.Challenges, &pb.LabInfo_Challenge{
+			Name:  c.Name,
+			Tag:   string(c.Tag),
+			Value: c.Value,
+		})
+	}
+
+	for _, i := range l.InstanceInfo() {
+		info.Instances = append(info.Instances, &pb.LabInfo_Instance{
+			Image: i.Image,
+			Type:  i.Type,
+			Id:    i.Id,
+			State: int32(i.State),
+		})
+	}
+
+	for _, s := range l.Snapshots() {
+		info.Snapshots = append(info.Snapshots, &pb.LabInfo_Snapshot{
+			Name:      s.Name,
+			CreatedAt: s.CreatedAt.Unix(),
+			Size:      s.Size,
+		})
+	}
+
+	health, err := json.Marshal(l.Health())
+	if err != nil {
+		return nil, err
+	}
+	info.Health = health
+
+	return info, nil
+}
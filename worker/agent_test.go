@@ -0,0 +1,193 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeEnvironment struct {
+	exercise.Environment
+}
+
+func (fe *fakeEnvironment) LabSubnet() string                 { return "10.1.0.0/24" }
+func (fe *fakeEnvironment) LabSubnet6() string                { return "" }
+func (fe *fakeEnvironment) LabDNS() string                    { return "10.1.0.3" }
+func (fe *fakeEnvironment) DNSRecords() []*exercise.DNSRecord { return nil }
+func (fe *fakeEnvironment) Challenges() []store.Challenge {
+	return []store.Challenge{{Tag: "sql", Value: "HKN{flag}"}}
+}
+
+type fakeLab struct {
+	lab.Lab
+	tag    string
+	port   uint
+	closed bool
+}
+
+func (fl *fakeLab) Tag() string                          { return fl.tag }
+func (fl *fakeLab) Environment() exercise.Environment    { return &fakeEnvironment{} }
+func (fl *fakeLab) InstanceInfo() []virtual.InstanceInfo { return nil }
+func (fl *fakeLab) Snapshots() []lab.Snapshot            { return nil }
+func (fl *fakeLab) Health() lab.Health                   { return lab.Health{Tag: fl.tag} }
+func (fl *fakeLab) Close() error {
+	fl.closed = true
+	return nil
+}
+func (fl *fakeLab) FrontendConns() []lab.FrontendConn {
+	return []lab.FrontendConn{{Port: fl.port, Protocol: store.RDPProtocol}}
+}
+
+type fakeCreator struct {
+	lab.Creator
+	labs []*fakeLab
+}
+
+func (fc *fakeCreator) NewLab(context.Context, int32) (lab.Lab, error) {
+	l := &fakeLab{tag: fmt.Sprintf("lab-%d", len(fc.labs)), port: 5000}
+	fc.labs = append(fc.labs, l)
+	return l, nil
+}
+
+func TestAgent(t *testing.T) {
+	creator := &fakeCreator{}
+	a := newAgent(Config{Capacity: 1}, nil, func(lab.Config) lab.Creator {
+		return creator
+	})
+	ctx := context.Background()
+
+	conf, _ := json.Marshal(lab.Config{})
+	info, err := a.CreateLab(ctx, &pb.CreateLabRequest{Config: conf})
+	if err != nil {
+		t.Fatalf("unexpected error when creating lab: %v", err)
+	}
+	if info.Tag != "lab-0" || info.LabSubnet != "10.1.0.0/24" || len(info.Frontends) != 1 || len(info.Challenges) != 1 {
+		t.Fatalf("unexpected lab info: %v", info)
+	}
+
+	var h lab.Health
+	if err := json.Unmarshal(info.Health, &h); err != nil || h.Tag != "lab-0" {
+		t.Fatalf("expected health of the lab, got: %s", info.Health)
+	}
+
+	if _, err := a.CreateLab(ctx, &pb.CreateLabRequest{Config: conf}); translateErr(err) != ErrNoCapacity {
+		t.Fatalf("expected worker to be out of capacity, got: %v", err)
+	}
+
+	if _, err := a.LabAction(ctx, &pb.LabActionRequest{Tag: "lab-0", Action: pb.LabActionRequest_CLOSE}); err != nil {
+		t.Fatalf("unexpected error when closing lab: %v", err)
+	}
+	if !creator.labs[0].closed {
+		t.Fatalf("expected lab to be closed")
+	}
+	if _, err := a.GetLab(ctx, &pb.LabRequest{Tag: "lab-0"}); translateErr(err) != ErrUnknownLab {
+		t.Fatalf("expected closed lab to be unknown, got: %v", err)
+	}
+
+	a.draining = true
+	if _, err := a.CreateLab(ctx, &pb.CreateLabRequest{Config: conf}); translateErr(err) != ErrDraining {
+		t.Fatalf("expected draining worker to refuse labs, got: %v", err)
+	}
+}
+
+func TestTunnel(t *testing.T) {
+	// the frontend echoes what it receives
+	frontend, err := net.Listen("tcp", hostPort(frontendHost, 0))
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	defer frontend.Close()
+	go func() {
+		for {
+			c, err := frontend.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(c, c)
+				c.Close()
+			}()
+		}
+	}()
+
+	keys := Keys{AuthKey: "auth", SignKey: "sign"}
+	a := newAgent(Config{}, nil, nil)
+	a.labs["lab-0"] = &fakeLab{tag: "lab-0", port: uint(frontend.Addr().(*net.TCPAddr).Port)}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	opts, err := serverOpts(keys, TLSConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterWorkerServer(s, a)
+	go s.Serve(ln)
+	defer s.Stop()
+
+	tt := []struct {
+		name string
+		keys Keys
+		tag  string
+		port uint32
+		err  codes.Code
+	}{
+		{name: "Normal", keys: keys, tag: "lab-0", port: uint32(a.labs["lab-0"].FrontendConns()[0].Port)},
+		{name: "Unauthenticated", keys: Keys{AuthKey: "auth", SignKey: "other"}, tag: "lab-0", port: 5000, err: codes.Unauthenticated},
+		{name: "Unknown lab", keys: keys, tag: "lab-1", port: 5000, err: codes.NotFound},
+		{name: "Unknown port", keys: keys, tag: "lab-0", port: 22, err: codes.PermissionDenied},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, err := dial(ln.Addr().String(), tc.keys, TLSConfig{})
+			if err != nil {
+				t.Fatalf("unable to dial worker: %v", err)
+			}
+			defer conn.Close()
+
+			tun, err := tunnelTo(pb.NewWorkerClient(conn), tc.tag, tc.port)()
+			if err != nil {
+				t.Fatalf("unable to open tunnel: %v", err)
+			}
+			defer tun.Close()
+
+			if _, err := tun.Write([]byte("hello")); err != nil {
+				t.Fatalf("unable to write to tunnel: %v", err)
+			}
+
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(tun, buf)
+			if tc.err != codes.OK {
+				if status.Code(err) != tc.err {
+					t.Fatalf("expected %v error, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to read from tunnel: %v", err)
+			}
+			if string(buf) != "hello" {
+				t.Fatalf("expected echo from frontend, got: %s", buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AUTH_KEY = "hkn-worker"
+)
+
+var (
+	MissingTokenErr = errors.New("no security token provided")
+	InvalidTokenErr = errors.New("invalid security token")
+)
+
+// TLSConfig secures the gRPC connections between the daemon and
+// its workers, clients verify the server certificate by the CA file
+type TLSConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	CertFile string `yaml:"certfile"`
+	CertKey  string `yaml:"certkey"`
+	CAFile   string `yaml:"cafile"`
+}
+
+// Keys are shared by the daemon and its workers, both
+// sides of a connection authenticate with the same token
+type Keys struct {
+	AuthKey string `yaml:"auth-key"`
+	SignKey string `yaml:"sign-key"`
+}
+
+type Creds struct {
+	Token    string
+	Insecure bool
+}
+
+func (c Creds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		"token": c.Token,
+	}, nil
+}
+
+func (c Creds) RequireTransportSecurity() bool {
+	return !c.Insecure
+}
+
+func (k Keys) token() (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		AUTH_KEY: k.AuthKey,
+	})
+	return token.SignedString([]byte(k.SignKey))
+}
+
+func (k Keys) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["token"]) == 0 || md["token"][0] == "" {
+		return MissingTokenErr
+	}
+
+	token, err := jwt.Parse(md["token"][0], func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(k.SignKey), nil
+	})
+	if err != nil {
+		return InvalidTokenErr
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return InvalidTokenErr
+	}
+
+	if authKey, ok := claims[AUTH_KEY].(string); !ok || authKey != k.AuthKey {
+		return InvalidTokenErr
+	}
+
+	return nil
+}
+
+// serverOpts authenticates every call by the shared keys
+func serverOpts(keys Keys, conf TLSConfig) ([]grpc.ServerOption, error) {
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := keys.authenticate(ctx); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(ctx, req)
+	}
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := keys.authenticate(ss.Context()); err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(srv, ss)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(interceptor),
+		grpc.StreamInterceptor(streamInterceptor),
+	}
+	if conf.Enabled {
+		creds, err := credentials.NewServerTLSFromFile(conf.CertFile, conf.CertKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load server key pair: %s", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	return opts, nil
+}
+
+func dial(address string, keys Keys, conf TLSConfig) (*grpc.ClientConn, error) {
+	token, err := keys.token()
+	if err != nil {
+		return nil, err
+	}
+
+	authCreds := Creds{Token: token}
+	if conf.Enabled {
+		creds, err := credentials.NewClientTLSFromFile(conf.CAFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not read ca certificate: %s", err)
+		}
+
+		return grpc.Dial(address, grpc.WithTransportCredentials(creds), grpc.WithPerRPCCredentials(authCreds))
+	}
+
+	authCreds.Insecure = true
+	return grpc.Dial(address, grpc.WithInsecure(), grpc.WithPerRPCCredentials(authCreds))
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aau-network-security/haaukins/network/capture"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultWorkerListen      = ":5456"
+	defaultCoordinatorListen = ":5455"
+	defaultCapacity          = 10
+	defaultDrainTimeout      = time.Hour
+	defaultHeartbeatInterval = 10 * time.Second
+)
+
+type MissingConfigErr struct {
+	Option string
+}
+
+func (m *MissingConfigErr) Error() string {
+	return fmt.Sprintf("%s cannot be empty", m.Option)
+}
+
+// Config of a worker agent
+type Config struct {
+	Name string `yaml:"name,omitempty"`
+	// Listen is the address of the worker service
+	Listen string `yaml:"listen,omitempty"`
+	// Advertise is the address the daemon reaches the worker service
+	// on, it defaults to the RDP host and the port of Listen
+	Advertise string `yaml:"advertise,omitempty"`
+	// RDPHost is the address of the worker which the daemon reaches the
+	// subnets of labs on, remote desktops of frontends are only bound to
+	// the loopback address and reached through the worker service
+	RDPHost string `yaml:"rdp-host"`
+	// Capacity is the maximum amount of labs on the worker
+	Capacity int32 `yaml:"capacity,omitempty"`
+	// Coordinator is the address of the worker coordinator of the daemon
+	Coordinator string `yaml:"coordinator"`
+	// DrainTimeout is how long a draining worker waits for its
+	// labs to be closed before they are moved to other workers
+	DrainTimeout time.Duration `yaml:"drain-timeout,omitempty"`
+	// CaptureRetention is how long the captures of labs
+	// are kept after they have been written to the last time
+	CaptureRetention time.Duration `yaml:"capture-retention,omitempty"`
+	Keys             Keys          `yaml:",inline"`
+	TLS              TLSConfig     `yaml:"tls,omitempty"`
+	ConfFiles        struct {
+		OvaDir     string `yaml:"ova-directory,omitempty"`
+		LibvirtDir string `yaml:"libvirt-directory,omitempty"`
+		// CaptureDir keeps the captured traffic of labs
+		// on the worker, by event and lab like the daemon
+		CaptureDir string `yaml:"capture-directory,omitempty"`
+	} `yaml:"files,omitempty"`
+}
+
+func NewConfigFromFile(path string) (*Config, error) {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := yaml.Unmarshal(f, &c); err != nil {
+		return nil, err
+	}
+
+	if c.Coordinator == "" {
+		return nil, &MissingConfigErr{"Coordinator address"}
+	}
+
+	if c.RDPHost == "" {
+		return nil, &MissingConfigErr{"RDP host"}
+	}
+
+	if c.Keys.SignKey == "" {
+		return nil, &MissingConfigErr{"Worker signing key"}
+	}
+
+	if c.Name == "" {
+		c.Name, _ = os.Hostname()
+	}
+
+	if c.Listen == "" {
+		c.Listen = defaultWorkerListen
+	}
+
+	if c.Advertise == "" {
+		_, port, err := net.SplitHostPort(c.Listen)
+		if err != nil {
+			return nil, err
+		}
+		c.Advertise = net.JoinHostPort(c.RDPHost, port)
+	}
+
+	if c.Capacity == 0 {
+		c.Capacity = defaultCapacity
+	}
+
+	if c.DrainTimeout == 0 {
+		c.DrainTimeout = defaultDrainTimeout
+	}
+
+	if c.ConfFiles.OvaDir == "" {
+		dir, _ := os.Getwd()
+		c.ConfFiles.OvaDir = filepath.Join(dir, "vbox")
+	}
+
+	if c.ConfFiles.LibvirtDir == "" {
+		dir, _ := os.Getwd()
+		c.ConfFiles.LibvirtDir = filepath.Join(dir, "libvirt")
+	}
+
+	if c.CaptureRetention == 0 {
+		c.CaptureRetention = capture.DefaultRetention
+	}
+
+	if c.ConfFiles.CaptureDir == "" {
+		dir, _ := os.Getwd()
+		c.ConfFiles.CaptureDir = filepath.Join(dir, "captures")
+	}
+
+	return &c, nil
+}
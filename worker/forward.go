@@ -0,0 +1,248 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	forwardDialTimeout = 5 * time.Second
+	// frontendHost is the address remote desktops of frontends
+	// are bound to on workers, they are reached through tunnels
+	frontendHost = "127.0.0.1"
+	// tunnelChunkSize bounds the data of a single tunnel message
+	tunnelChunkSize = 32 * 1024
+)
+
+// dialFunc opens a connection to the remote desktop of a frontend
+type dialFunc func() (io.ReadWriteCloser, error)
+
+// forwarder relays connections from a port on the daemon host to the
+// remote desktop of a frontend on a worker, guacamole connects to the
+// local port which stays the same when the lab moves to another worker
+type forwarder struct {
+	ln    net.Listener
+	m     sync.Mutex
+	dial  dialFunc
+	conns map[io.Closer]struct{}
+}
+
+func newForwarder(host string, dial dialFunc) (*forwarder, error) {
+	ln, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &forwarder{
+		ln:    ln,
+		dial:  dial,
+		conns: map[io.Closer]struct{}{},
+	}
+	go f.serve()
+
+	return f, nil
+}
+
+func (f *forwarder) Port() uint {
+	return uint(f.ln.Addr().(*net.TCPAddr).Port)
+}
+
+func (f *forwarder) dialer() dialFunc {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	return f.dial
+}
+
+// Retarget relays new connections through the given dialer,
+// connections to the previous target are closed
+func (f *forwarder) Retarget(dial dialFunc) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.dial = dial
+	for c := range f.conns {
+		c.Close()
+	}
+}
+
+func (f *forwarder) track(c io.Closer) {
+	f.m.Lock()
+	f.conns[c] = struct{}{}
+	f.m.Unlock()
+}
+
+func (f *forwarder) untrack(c io.Closer) {
+	f.m.Lock()
+	delete(f.conns, c)
+	f.m.Unlock()
+}
+
+func (f *forwarder) serve() {
+	for {
+		c, err := f.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		go f.relay(c)
+	}
+}
+
+func (f *forwarder) relay(c net.Conn) {
+	defer c.Close()
+
+	tc, err := f.dialer()()
+	if err != nil {
+		log.Warn().Msgf("Unable to forward connection: %v", err)
+		return
+	}
+	defer tc.Close()
+
+	f.track(c)
+	f.track(tc)
+	defer f.untrack(c)
+	defer f.untrack(tc)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(tc, c)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(c, tc)
+		done <- struct{}{}
+	}()
+
+	// either side closing ends the relay
+	<-done
+}
+
+func (f *forwarder) Close() error {
+	err := f.ln.Close()
+
+	f.m.Lock()
+	for c := range f.conns {
+		c.Close()
+	}
+	f.m.Unlock()
+
+	return err
+}
+
+// tunnel is a connection to the remote desktop of a frontend
+// through the authenticated worker service of the worker
+type tunnel struct {
+	stream pb.Worker_TunnelClient
+	cancel context.CancelFunc
+	buf    []byte
+}
+
+// tunnelTo returns a dialer of tunnels to the
+// frontend with the given port of a lab on a worker
+func tunnelTo(client pb.WorkerClient, tag string, port uint32) dialFunc {
+	return func() (io.ReadWriteCloser, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.Tunnel(ctx)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		if err := stream.Send(&pb.TunnelData{Tag: tag, Port: port}); err != nil {
+			cancel()
+			return nil, err
+		}
+
+		return &tunnel{stream: stream, cancel: cancel}, nil
+	}
+}
+
+func (t *tunnel) Read(p []byte) (int, error) {
+	for len(t.buf) == 0 {
+		msg, err := t.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		t.buf = msg.Data
+	}
+
+	n := copy(p, t.buf)
+	t.buf = t.buf[n:]
+	return n, nil
+}
+
+func (t *tunnel) Write(p []byte) (int, error) {
+	if err := t.stream.Send(&pb.TunnelData{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (t *tunnel) Close() error {
+	t.cancel()
+	return nil
+}
+
+// tunnelStream is one side of a tunnel, implemented by
+// the client and the server streams of the worker service
+type tunnelStream interface {
+	Send(*pb.TunnelData) error
+	Recv() (*pb.TunnelData, error)
+}
+
+// relayTunnel relays between a tunnel and a connection on the
+// worker until either side is closed, data of the connection
+// is sent in chunks of at most tunnelChunkSize
+func relayTunnel(stream tunnelStream, c net.Conn) error {
+	done := make(chan error, 2)
+	go func() {
+		buf := make([]byte, tunnelChunkSize)
+		for {
+			n, err := c.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&pb.TunnelData{Data: buf[:n]}); err != nil {
+					done <- err
+					return
+				}
+			}
+			if err != nil {
+				done <- nil
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				done <- nil
+				return
+			}
+			if _, err := c.Write(msg.Data); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	// either side closing ends the relay
+	err := <-done
+	c.Close()
+	return err
+}
+
+func hostPort(host string, port uint32) string {
+	return net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+}
@@ -0,0 +1,539 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/subnet"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// workers are considered failed after missing this amount of heartbeats
+	missedHeartbeats = 3
+)
+
+var (
+	ErrNoWorkers     = errors.New("no worker with free capacity is available")
+	ErrUnknownWorker = errors.New("unknown worker")
+)
+
+// PoolConfig configures the coordinator of the daemon
+// which worker agents register at
+type PoolConfig struct {
+	Listen            string        `yaml:"listen,omitempty"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat-interval,omitempty"`
+	Keys              Keys          `yaml:",inline"`
+	TLS               TLSConfig     `yaml:"tls,omitempty"`
+}
+
+// Node is a registered worker as seen by the coordinator,
+// Labs includes the labs which are being created on it
+type Node struct {
+	Name     string
+	Address  string
+	RDPHost  string
+	Capacity int32
+	Labs     int32
+	Draining bool
+	LastSeen time.Time
+}
+
+type node struct {
+	Node
+	client   pb.WorkerClient
+	conn     io.Closer
+	labs     map[*remoteLab]struct{}
+	creating int32
+}
+
+func (n *node) info() Node {
+	info := n.Node
+	info.Labs = int32(len(n.labs)) + n.creating
+	return info
+}
+
+// Scheduler picks the worker which the next lab is placed on, the
+// given nodes are alive, not draining and have free capacity
+type Scheduler interface {
+	Pick([]Node) (string, error)
+}
+
+// LeastLoaded picks the worker which uses the smallest share of its capacity
+type LeastLoaded struct{}
+
+func (LeastLoaded) Pick(nodes []Node) (string, error) {
+	if len(nodes) == 0 {
+		return "", ErrNoWorkers
+	}
+
+	load := func(n Node) float64 {
+		return float64(n.Labs) / float64(n.Capacity)
+	}
+
+	best := nodes[0]
+	for _, n := range nodes[1:] {
+		if load(n) < load(best) {
+			best = n
+		}
+	}
+
+	return best.Name, nil
+}
+
+// Pool keeps track of the workers registered at the coordinator and
+// places labs on them, labs of failed workers are moved to other workers
+type Pool struct {
+	conf      PoolConfig
+	scheduler Scheduler
+	// hostIP is the address guacamole reaches the forwarders on
+	hostIP string
+	routes *routes
+	dial   func(address string) (pb.WorkerClient, io.Closer, error)
+	srv    *grpc.Server
+	m      sync.Mutex
+	nodes  map[string]*node
+	stop   chan struct{}
+	pb.UnimplementedCoordinatorServer
+}
+
+func NewPool(conf PoolConfig) (*Pool, error) {
+	if conf.Listen == "" {
+		conf.Listen = defaultCoordinatorListen
+	}
+
+	if conf.HeartbeatInterval == 0 {
+		conf.HeartbeatInterval = defaultHeartbeatInterval
+	}
+
+	if conf.Keys.SignKey == "" {
+		return nil, &MissingConfigErr{"Worker signing key"}
+	}
+
+	hostIP, err := docker.NewHost().GetDockerHostIP()
+	if err != nil {
+		return nil, err
+	}
+
+	p := newPool(conf, LeastLoaded{}, hostIP, newRoutes())
+	p.dial = func(address string) (pb.WorkerClient, io.Closer, error) {
+		conn, err := dial(address, conf.Keys, conf.TLS)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pb.NewWorkerClient(conn), conn, nil
+	}
+
+	return p, nil
+}
+
+func newPool(conf PoolConfig, scheduler Scheduler, hostIP string, routes *routes) *Pool {
+	return &Pool{
+		conf:      conf,
+		scheduler: scheduler,
+		hostIP:    hostIP,
+		routes:    routes,
+		nodes:     map[string]*node{},
+		stop:      make(chan struct{}),
+	}
+}
+
+// Run serves the coordinator service and monitors the heartbeats of workers
+func (p *Pool) Run() error {
+	lis, err := net.Listen("tcp", p.conf.Listen)
+	if err != nil {
+		return err
+	}
+
+	opts, err := serverOpts(p.conf.Keys, p.conf.TLS)
+	if err != nil {
+		return err
+	}
+	p.srv = grpc.NewServer(opts...)
+	pb.RegisterCoordinatorServer(p.srv, p)
+
+	go p.monitor()
+
+	log.Info().Str("listen", p.conf.Listen).Msg("Worker coordinator has been started")
+
+	return p.srv.Serve(lis)
+}
+
+func (p *Pool) Close() error {
+	close(p.stop)
+	if p.srv != nil {
+		p.srv.Stop()
+	}
+
+	return nil
+}
+
+// Nodes returns the registered workers ordered by name
+func (p *Pool) Nodes() []Node {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	var nodes []Node
+	for _, n := range p.nodes {
+		nodes = append(nodes, n.info())
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes
+}
+
+// PrepareImages pulls the images and imports the OVAs on every worker
+// which is not draining, the progress names the worker. The first
+// error is returned after all workers are done.
+func (p *Pool) PrepareImages(ctx context.Context, images, ovas []string, progress func(worker string, s *pb.PrepareImagesStatus)) error {
+	p.m.Lock()
+	var nodes []*node
+	for _, n := range p.nodes {
+		if !n.Draining {
+			nodes = append(nodes, n)
+		}
+	}
+	p.m.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(nodes))
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+			errs[i] = prepareOn(ctx, n, images, ovas, progress)
+		}(i, n)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareOn(ctx context.Context, n *node, images, ovas []string, progress func(string, *pb.PrepareImagesStatus)) error {
+	stream, err := n.client.PrepareImages(ctx, &pb.PrepareImagesRequest{Images: images, Ovas: ovas})
+	if err != nil {
+		return fmt.Errorf("worker %s: %v", n.Name, translateErr(err))
+	}
+
+	for {
+		s, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("worker %s: %v", n.Name, translateErr(err))
+		}
+		progress(n.Name, s)
+	}
+}
+
+func (p *Pool) monitor() {
+	ticker := time.NewTicker(p.conf.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.failStale(time.Now().Add(-missedHeartbeats * p.conf.HeartbeatInterval))
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// failStale fails the workers which have not been seen since the given time
+func (p *Pool) failStale(since time.Time) {
+	var stale []*node
+	p.m.Lock()
+	for name, n := range p.nodes {
+		if n.LastSeen.Before(since) {
+			delete(p.nodes, name)
+			stale = append(stale, n)
+		}
+	}
+	p.m.Unlock()
+
+	for _, n := range stale {
+		log.Warn().Str("worker", n.Name).Msg("Worker missed its heartbeats")
+		p.fail(n)
+	}
+}
+
+// fail recreates the labs of a worker which
+// is no longer registered on other workers
+func (p *Pool) fail(n *node) {
+	p.m.Lock()
+	var labs []*remoteLab
+	for rl := range n.labs {
+		labs = append(labs, rl)
+	}
+	n.labs = map[*remoteLab]struct{}{}
+	p.m.Unlock()
+
+	if n.conn != nil {
+		n.conn.Close()
+	}
+
+	for _, rl := range labs {
+		go rl.recreate()
+	}
+}
+
+func (p *Pool) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	if req.Name == "" || req.Address == "" || req.RdpHost == "" || req.Capacity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "name, address, rdp host and capacity are required")
+	}
+
+	client, conn, err := p.dial(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &node{
+		Node: Node{
+			Name:     req.Name,
+			Address:  req.Address,
+			RDPHost:  req.RdpHost,
+			Capacity: req.Capacity,
+			LastSeen: time.Now(),
+		},
+		client: client,
+		conn:   conn,
+		labs:   map[*remoteLab]struct{}{},
+	}
+
+	p.m.Lock()
+	prev, ok := p.nodes[req.Name]
+	p.nodes[req.Name] = n
+	p.m.Unlock()
+
+	// a worker registering again has lost its labs
+	if ok {
+		p.fail(prev)
+	}
+
+	log.Info().
+		Str("worker", req.Name).
+		Str("address", req.Address).
+		Int32("capacity", req.Capacity).
+		Msg("Worker registered")
+
+	return &pb.RegisterResponse{
+		HeartbeatIntervalSeconds: int64(p.conf.HeartbeatInterval / time.Second),
+	}, nil
+}
+
+func (p *Pool) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	n, ok := p.nodes[req.Name]
+	if !ok {
+		return &pb.HeartbeatResponse{Unknown: true}, nil
+	}
+	n.LastSeen = time.Now()
+
+	return &pb.HeartbeatResponse{}, nil
+}
+
+// Drain stops placing labs on the worker, its labs
+// are kept until they are closed by their events
+func (p *Pool) Drain(ctx context.Context, req *pb.WorkerRequest) (*pb.Empty, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	n, ok := p.nodes[req.Name]
+	if !ok {
+		return nil, status.Error(codes.NotFound, ErrUnknownWorker.Error())
+	}
+	n.Draining = true
+
+	log.Info().Str("worker", req.Name).Msg("Worker is draining")
+
+	return &pb.Empty{}, nil
+}
+
+// Deregister removes the worker, labs which are
+// left on it are recreated on other workers
+func (p *Pool) Deregister(ctx context.Context, req *pb.WorkerRequest) (*pb.Empty, error) {
+	p.m.Lock()
+	n, ok := p.nodes[req.Name]
+	delete(p.nodes, req.Name)
+	p.m.Unlock()
+
+	if !ok {
+		return nil, status.Error(codes.NotFound, ErrUnknownWorker.Error())
+	}
+
+	log.Info().Str("worker", req.Name).Msg("Worker deregistered")
+	p.fail(n)
+
+	return &pb.Empty{}, nil
+}
+
+// place creates a lab on the worker picked by the scheduler
+func (p *Pool) place(ctx context.Context, req *pb.CreateLabRequest) (*node, *pb.LabInfo, error) {
+	p.m.Lock()
+	var candidates []Node
+	for _, n := range p.nodes {
+		info := n.info()
+		if info.Draining || info.Labs >= info.Capacity {
+			continue
+		}
+		candidates = append(candidates, info)
+	}
+
+	name, err := p.scheduler.Pick(candidates)
+	if err != nil {
+		p.m.Unlock()
+		return nil, nil, err
+	}
+
+	n, ok := p.nodes[name]
+	if !ok {
+		p.m.Unlock()
+		return nil, nil, ErrUnknownWorker
+	}
+	n.creating += 1
+	p.m.Unlock()
+
+	info, err := n.client.CreateLab(ctx, req)
+
+	p.m.Lock()
+	n.creating -= 1
+	p.m.Unlock()
+
+	if err != nil {
+		return nil, nil, translateErr(err)
+	}
+
+	log.Info().Str("worker", n.Name).Str("lab", info.Tag).Msg("Placed lab on worker")
+
+	return n, info, nil
+}
+
+// attach adds the lab to the labs of the worker, which fails
+// when the worker has been removed in the meantime
+func (p *Pool) attach(n *node, rl *remoteLab) bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.nodes[n.Name] != n {
+		return false
+	}
+	n.labs[rl] = struct{}{}
+
+	return true
+}
+
+func (p *Pool) detach(n *node, rl *remoteLab) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	delete(n.labs, rl)
+}
+
+// route makes the subnet of a VPN lab reachable through its worker
+func (p *Pool) route(req *pb.CreateLabRequest, n *node, info *pb.LabInfo) error {
+	if req.IsVPN == docker.NoVPN || info.LabSubnet == "" {
+		return nil
+	}
+
+	return p.routes.add(info.LabSubnet, n.RDPHost)
+}
+
+func (p *Pool) unroute(req *pb.CreateLabRequest, info *pb.LabInfo) {
+	if req.IsVPN == docker.NoVPN || info.LabSubnet == "" {
+		return
+	}
+
+	if err := p.routes.remove(info.LabSubnet); err != nil {
+		log.Warn().Msgf("Unable to remove route of lab subnet %s: %v", info.LabSubnet, err)
+	}
+}
+
+// Creator places the labs of the lab host on workers, labs are
+// created by the lab host itself when no worker is available
+// and when the lab host runs labs on a Kubernetes cluster
+func (p *Pool) Creator(lh *lab.LabHost) lab.Creator {
+	if lh.Cluster != nil {
+		return lh
+	}
+
+	return &poolCreator{
+		pool:  p,
+		local: lh,
+	}
+}
+
+type poolCreator struct {
+	pool  *Pool
+	local *lab.LabHost
+}
+
+func (c *poolCreator) NewLab(ctx context.Context, isVPN int32) (lab.Lab, error) {
+	conf, err := json.Marshal(c.local.Conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// subnets of labs on workers are allocated by the daemon, such
+	// that they neither overlap each other nor labs on the daemon host
+	cidr, err := docker.Subnets.Allocate(subnet.Lab, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.CreateLabRequest{
+		Config: conf,
+		IsVPN:  isVPN,
+		Subnet: cidr,
+	}
+
+	n, info, err := c.pool.place(ctx, req)
+	if err != nil {
+		docker.Subnets.Release(cidr)
+		if err == ErrNoWorkers {
+			return c.local.NewLab(ctx, isVPN)
+		}
+		return nil, err
+	}
+
+	rl, err := newRemoteLab(c.pool, n, req, info)
+	if err != nil {
+		docker.Subnets.Release(cidr)
+		return nil, err
+	}
+
+	return rl, nil
+}
+
+func (c *poolCreator) UpdateExercises(exercises []store.Exercise) {
+	c.local.UpdateExercises(exercises)
+}
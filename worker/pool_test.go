@@ -0,0 +1,467 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"google.golang.org/grpc"
+)
+
+// fakeWorker creates labs with a single frontend, the frontend
+// is a listener answering with the name of the worker
+type fakeWorker struct {
+	pb.WorkerClient
+	name   string
+	subnet string
+	ln     net.Listener
+
+	m        sync.Mutex
+	count    int
+	configs  []lab.Config
+	subnets  []string
+	captures []string
+	labs     map[string]*pb.LabInfo
+}
+
+func newFakeWorker(t *testing.T, name, subnet string) *fakeWorker {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Write([]byte(name))
+			c.Close()
+		}
+	}()
+
+	return &fakeWorker{
+		name:   name,
+		subnet: subnet,
+		ln:     ln,
+		labs:   map[string]*pb.LabInfo{},
+	}
+}
+
+func (w *fakeWorker) CreateLab(ctx context.Context, req *pb.CreateLabRequest, opts ...grpc.CallOption) (*pb.LabInfo, error) {
+	var conf lab.Config
+	if err := json.Unmarshal(req.Config, &conf); err != nil {
+		return nil, err
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.count += 1
+	w.configs = append(w.configs, conf)
+	w.subnets = append(w.subnets, req.Subnet)
+	info := &pb.LabInfo{
+		Tag:       fmt.Sprintf("%s-%d", w.name, w.count),
+		LabSubnet: w.subnet,
+		Frontends: []*pb.LabInfo_Frontend{{
+			Port:     uint32(w.ln.Addr().(*net.TCPAddr).Port),
+			Protocol: store.RDPProtocol,
+		}},
+	}
+	for _, e := range conf.Exercises {
+		for _, f := range e.Flags() {
+			value := f.StaticFlag
+			if value == "" {
+				value = "HKN{" + w.name + "}"
+			}
+			info.Challenges = append(info.Challenges, &pb.LabInfo_Challenge{Tag: string(f.Tag), Value: value})
+		}
+	}
+	w.labs[info.Tag] = info
+
+	return info, nil
+}
+
+func (w *fakeWorker) LabAction(ctx context.Context, req *pb.LabActionRequest, opts ...grpc.CallOption) (*pb.LabInfo, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	info, ok := w.labs[req.Tag]
+	if !ok {
+		return nil, ErrUnknownLab
+	}
+	switch req.Action {
+	case pb.LabActionRequest_CLOSE:
+		delete(w.labs, req.Tag)
+	case pb.LabActionRequest_START_CAPTURE:
+		w.captures = append(w.captures, req.Tag)
+	}
+
+	return info, nil
+}
+
+// fakeTunnel relays a tunnel to the frontend of a fake worker
+type fakeTunnel struct {
+	grpc.ClientStream
+	c net.Conn
+}
+
+func (w *fakeWorker) Tunnel(ctx context.Context, opts ...grpc.CallOption) (pb.Worker_TunnelClient, error) {
+	c, err := net.Dial("tcp", w.ln.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		c.Close()
+	}()
+
+	return &fakeTunnel{c: c}, nil
+}
+
+func (t *fakeTunnel) Send(msg *pb.TunnelData) error {
+	_, err := t.c.Write(msg.Data)
+	return err
+}
+
+func (t *fakeTunnel) Recv() (*pb.TunnelData, error) {
+	buf := make([]byte, 1024)
+	n, err := t.c.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TunnelData{Data: buf[:n]}, nil
+}
+
+// fakePrepareStream reports every image as up to date
+type fakePrepareStream struct {
+	grpc.ClientStream
+	statuses []*pb.PrepareImagesStatus
+}
+
+func (w *fakeWorker) PrepareImages(ctx context.Context, req *pb.PrepareImagesRequest, opts ...grpc.CallOption) (pb.Worker_PrepareImagesClient, error) {
+	stream := &fakePrepareStream{}
+	for _, img := range req.Images {
+		stream.statuses = append(stream.statuses, &pb.PrepareImagesStatus{Image: img, Status: docker.ImageUpToDate})
+	}
+	for _, ova := range req.Ovas {
+		stream.statuses = append(stream.statuses, &pb.PrepareImagesStatus{Image: ova, Ova: true, Status: docker.ImageUpToDate})
+	}
+	return stream, nil
+}
+
+func (s *fakePrepareStream) Recv() (*pb.PrepareImagesStatus, error) {
+	if len(s.statuses) == 0 {
+		return nil, io.EOF
+	}
+	status := s.statuses[0]
+	s.statuses = s.statuses[1:]
+	return status, nil
+}
+
+func (w *fakeWorker) amountOfLabs() int {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return len(w.labs)
+}
+
+func testPool(t *testing.T, workers ...*fakeWorker) (*Pool, *[]string) {
+	var cmds []string
+	var m sync.Mutex
+	routes := newRoutes()
+	routes.sudo = false
+	routes.execFunc = func(cmd string, args ...string) ([]byte, error) {
+		m.Lock()
+		cmds = append(cmds, cmd+" "+strings.Join(args, " "))
+		m.Unlock()
+		return nil, nil
+	}
+
+	p := newPool(PoolConfig{HeartbeatInterval: time.Second}, LeastLoaded{}, "127.0.0.1", routes)
+	p.dial = func(address string) (pb.WorkerClient, io.Closer, error) {
+		for _, w := range workers {
+			if w.name == address {
+				return w, nil, nil
+			}
+		}
+		return nil, nil, ErrUnknownWorker
+	}
+
+	for _, w := range workers {
+		_, err := p.Register(context.Background(), &pb.RegisterRequest{
+			Name:     w.name,
+			Address:  w.name,
+			RdpHost:  "127.0.0.1",
+			Capacity: 2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error when registering worker: %v", err)
+		}
+	}
+
+	return p, &cmds
+}
+
+func readFrontend(t *testing.T, port uint) string {
+	c, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatalf("unable to connect to frontend: %v", err)
+	}
+	defer c.Close()
+
+	b, err := ioutil.ReadAll(c)
+	if err != nil {
+		t.Fatalf("unable to read from frontend: %v", err)
+	}
+
+	return string(b)
+}
+
+func TestLeastLoaded(t *testing.T) {
+	if _, err := (LeastLoaded{}).Pick(nil); err != ErrNoWorkers {
+		t.Fatalf("expected no workers error, got: %v", err)
+	}
+
+	name, err := LeastLoaded{}.Pick([]Node{
+		{Name: "a", Capacity: 10, Labs: 5},
+		{Name: "b", Capacity: 4, Labs: 1},
+		{Name: "c", Capacity: 2, Labs: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "b" {
+		t.Fatalf("expected least loaded worker b, got: %s", name)
+	}
+}
+
+func TestPoolPlacement(t *testing.T) {
+	a, b := newFakeWorker(t, "a", "10.1.0.0/24"), newFakeWorker(t, "b", "10.1.0.0/24")
+	p, cmds := testPool(t, a, b)
+	creator := p.Creator(&lab.LabHost{})
+
+	var labs []lab.Lab
+	for i := 0; i < 4; i++ {
+		l, err := creator.NewLab(context.Background(), docker.NoVPN)
+		if err != nil {
+			t.Fatalf("unexpected error when creating lab: %v", err)
+		}
+		labs = append(labs, l)
+	}
+
+	if a.amountOfLabs() != 2 || b.amountOfLabs() != 2 {
+		t.Fatalf("expected labs to be spread over workers, got %d and %d", a.amountOfLabs(), b.amountOfLabs())
+	}
+	if len(*cmds) != 0 {
+		t.Fatalf("expected no routes for labs without vpn, got: %v", *cmds)
+	}
+	subnets := map[string]bool{}
+	for _, s := range append(a.subnets, b.subnets...) {
+		if s == "" || subnets[s] {
+			t.Fatalf("expected distinct subnets allocated by the daemon, got: %v and %v", a.subnets, b.subnets)
+		}
+		subnets[s] = true
+	}
+
+	// both workers are full
+	if _, _, err := p.place(context.Background(), &pb.CreateLabRequest{}); err != ErrNoWorkers {
+		t.Fatalf("expected no workers error, got: %v", err)
+	}
+
+	conns := labs[0].FrontendConns()
+	if len(conns) != 1 {
+		t.Fatalf("expected a single frontend, got: %v", conns)
+	}
+	if name := readFrontend(t, conns[0].Port); name != "a" && name != "b" {
+		t.Fatalf("unexpected frontend behind forwarder: %s", name)
+	}
+
+	for _, l := range labs {
+		if err := l.Close(); err != nil {
+			t.Fatalf("unexpected error when closing lab: %v", err)
+		}
+	}
+	if a.amountOfLabs() != 0 || b.amountOfLabs() != 0 {
+		t.Fatalf("expected labs to be closed on workers")
+	}
+	for _, al := range docker.Subnets.Allocations() {
+		if subnets[al.Subnet] {
+			t.Fatalf("expected subnet %s to be released with its lab", al.Subnet)
+		}
+	}
+
+	if _, err := p.Drain(context.Background(), &pb.WorkerRequest{Name: "a"}); err != nil {
+		t.Fatalf("unexpected error when draining worker: %v", err)
+	}
+	if _, err := creator.NewLab(context.Background(), docker.NoVPN); err != nil {
+		t.Fatalf("unexpected error when creating lab: %v", err)
+	}
+	if a.amountOfLabs() != 0 {
+		t.Fatalf("expected no labs to be placed on draining worker")
+	}
+}
+
+func TestPoolFailover(t *testing.T) {
+	a := newFakeWorker(t, "a", "10.1.0.0/24")
+	p, cmds := testPool(t, a)
+
+	creator := p.Creator(&lab.LabHost{
+		Conf: lab.Config{
+			Capture:  &capture.Config{Directory: "/captures/event"},
+			Terminal: &lab.TerminalConfig{Image: "kali"},
+			Exercises: []store.Exercise{{
+				Tag: "sql",
+				Instance: []store.ExerciseInstanceConfig{{
+					Image: "aau/sql",
+					Flags: []store.ChildrenChalConfig{{Tag: "sql", EnvVar: "APP_FLAG"}},
+				}},
+			}},
+		},
+	})
+	l, err := creator.NewLab(context.Background(), docker.OnlyVPN)
+	if err != nil {
+		t.Fatalf("unexpected error when creating lab: %v", err)
+	}
+
+	if err := l.Environment().StartCapture(); err != nil {
+		t.Fatalf("unexpected error when starting capture: %v", err)
+	}
+	if len(a.captures) != 1 {
+		t.Fatalf("expected capture to be started on worker a")
+	}
+
+	port := l.FrontendConns()[0].Port
+	if name := readFrontend(t, port); name != "a" {
+		t.Fatalf("expected frontend of worker a, got: %s", name)
+	}
+
+	b := newFakeWorker(t, "b", "10.2.0.0/24")
+	p.dial = func(string) (pb.WorkerClient, io.Closer, error) { return b, nil, nil }
+	if _, err := p.Register(context.Background(), &pb.RegisterRequest{Name: "b", Address: "b", RdpHost: "127.0.0.1", Capacity: 2}); err != nil {
+		t.Fatalf("unexpected error when registering worker: %v", err)
+	}
+
+	// only worker a misses its heartbeats
+	time.Sleep(time.Millisecond)
+	since := time.Now()
+	p.Heartbeat(context.Background(), &pb.HeartbeatRequest{Name: "b"})
+	p.failStale(since)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for b.amountOfLabs() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected lab to be recreated on worker b")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// wait for the forwarder to be retargeted
+	for l.Environment().LabSubnet() != b.subnet {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected lab to use the subnet of worker b")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if l.FrontendConns()[0].Port != port {
+		t.Fatalf("expected port of frontend to be kept")
+	}
+	if name := readFrontend(t, port); name != "b" {
+		t.Fatalf("expected frontend of worker b, got: %s", name)
+	}
+
+	chals := l.Environment().Challenges()
+	if len(chals) != 1 || chals[0].Value != "HKN{a}" {
+		t.Fatalf("expected flag of the lab to be kept, got: %v", chals)
+	}
+	if b.subnets[0] != a.subnets[0] {
+		t.Fatalf("expected recreated lab to keep its subnet %s, got: %s", a.subnets[0], b.subnets[0])
+	}
+	if conf := b.configs[0]; conf.Capture == nil || conf.Terminal == nil {
+		t.Fatalf("expected capture and terminal to be passed to the worker, got: %+v", conf)
+	}
+	if len(b.captures) != 1 {
+		t.Fatalf("expected capture to be started again on worker b")
+	}
+	envs := b.configs[0].Exercises[0].Instance[0].Envs
+	if len(envs) != 1 || envs[0].EnvVar != "APP_FLAG" || envs[0].Value != "HKN{a}" {
+		t.Fatalf("expected flag to be passed to the recreated instance, got: %v", envs)
+	}
+
+	if resp, _ := p.Heartbeat(context.Background(), &pb.HeartbeatRequest{Name: "a"}); !resp.Unknown {
+		t.Fatalf("expected failed worker to be unknown")
+	}
+
+	expected := []string{
+		"ip route replace 10.1.0.0/24 via 127.0.0.1",
+		"ip route del 10.1.0.0/24",
+		"ip route replace 10.2.0.0/24 via 127.0.0.1",
+	}
+	if strings.Join(*cmds, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected routes: %v", *cmds)
+	}
+
+	l.Close()
+}
+
+func TestPoolPrepareImages(t *testing.T) {
+	a, b := newFakeWorker(t, "a", "10.1.0.0/24"), newFakeWorker(t, "b", "10.2.0.0/24")
+	p, _ := testPool(t, a, b)
+	if _, err := p.Drain(context.Background(), &pb.WorkerRequest{Name: "b"}); err != nil {
+		t.Fatalf("unexpected error when draining worker: %v", err)
+	}
+
+	var m sync.Mutex
+	var prepared []string
+	err := p.PrepareImages(context.Background(), []string{"aau/sql"}, []string{"kali.ova"}, func(worker string, s *pb.PrepareImagesStatus) {
+		m.Lock()
+		defer m.Unlock()
+		prepared = append(prepared, worker+":"+s.Image)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error when preparing images: %v", err)
+	}
+
+	// draining workers get no new labs to prepare for
+	expected := []string{"a:aau/sql", "a:kali.ova"}
+	if strings.Join(prepared, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected images to be prepared on worker a, got: %v", prepared)
+	}
+}
+
+func TestRoutesOverlap(t *testing.T) {
+	r := newRoutes()
+	r.sudo = false
+	r.execFunc = func(string, ...string) ([]byte, error) { return nil, nil }
+
+	if err := r.add("10.1.0.0/24", "192.168.0.2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.add("10.1.0.0/16", "192.168.0.3"); err != ErrSubnetInUse {
+		t.Fatalf("expected overlapping subnet to be rejected, got: %v", err)
+	}
+	if err := r.remove("10.1.0.0/24"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.add("10.1.0.0/16", "192.168.0.3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
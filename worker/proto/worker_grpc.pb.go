@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CoordinatorClient is the client API for Coordinator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoordinatorClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	Drain(ctx context.Context, in *WorkerRequest, opts ...grpc.CallOption) (*Empty, error)
+	Deregister(ctx context.Context, in *WorkerRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type coordinatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoordinatorClient(cc grpc.ClientConnInterface) CoordinatorClient {
+	return &coordinatorClient{cc}
+}
+
+func (c *coordinatorClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/worker.Coordinator/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/worker.Coordinator/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) Drain(ctx context.Context, in *WorkerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Coordinator/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatorClient) Deregister(ctx context.Context, in *WorkerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/worker.Coordinator/Deregister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoordinatorServer is the server API for Coordinator service.
+// All implementations must embed UnimplementedCoordinatorServer
+// for forward compatibility
+type CoordinatorServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	Drain(context.Context, *WorkerRequest) (*Empty, error)
+	Deregister(context.Context, *WorkerRequest) (*Empty, error)
+	mustEmbedUnimplementedCoordinatorServer()
+}
+
+// UnimplementedCoordinatorServer must be embedded to have forward compatible implementations.
+type UnimplementedCoordinatorServer struct {
+}
+
+func (UnimplementedCoordinatorServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedCoordinatorServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedCoordinatorServer) Drain(context.Context, *WorkerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedCoordinatorServer) Deregister(context.Context, *WorkerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deregister not implemented")
+}
+func (UnimplementedCoordinatorServer) mustEmbedUnimplementedCoordinatorServer() {}
+
+// UnsafeCoordinatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoordinatorServer will
+// result in compilation errors.
+type UnsafeCoordinatorServer interface {
+	mustEmbedUnimplementedCoordinatorServer()
+}
+
+func RegisterCoordinatorServer(s grpc.ServiceRegistrar, srv CoordinatorServer) {
+	s.RegisterService(&Coordinator_ServiceDesc, srv)
+}
+
+func _Coordinator_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Coordinator/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Coordinator/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Coordinator/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).Drain(ctx, req.(*WorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coordinator_Deregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatorServer).Deregister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Coordinator/Deregister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatorServer).Deregister(ctx, req.(*WorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Coordinator_ServiceDesc is the grpc.ServiceDesc for Coordinator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Coordinator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "worker.Coordinator",
+	HandlerType: (*CoordinatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _Coordinator_Register_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Coordinator_Heartbeat_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Coordinator_Drain_Handler,
+		},
+		{
+			MethodName: "Deregister",
+			Handler:    _Coordinator_Deregister_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "worker.proto",
+}
+
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkerClient interface {
+	CreateLab(ctx context.Context, in *CreateLabRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	GetLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	LabAction(ctx context.Context, in *LabActionRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	ExerciseAction(ctx context.Context, in *ExerciseActionRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	AddChallenges(ctx context.Context, in *AddChallengesRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	SnapshotAction(ctx context.Context, in *SnapshotActionRequest, opts ...grpc.CallOption) (*LabInfo, error)
+	Tunnel(ctx context.Context, opts ...grpc.CallOption) (Worker_TunnelClient, error)
+	Terminal(ctx context.Context, opts ...grpc.CallOption) (Worker_TerminalClient, error)
+	DownloadCapture(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (Worker_DownloadCaptureClient, error)
+	PrepareImages(ctx context.Context, in *PrepareImagesRequest, opts ...grpc.CallOption) (Worker_PrepareImagesClient, error)
+}
+
+type workerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerClient(cc grpc.ClientConnInterface) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) CreateLab(ctx context.Context, in *CreateLabRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/CreateLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) GetLab(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/GetLab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) LabAction(ctx context.Context, in *LabActionRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/LabAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ExerciseAction(ctx context.Context, in *ExerciseActionRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/ExerciseAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) AddChallenges(ctx context.Context, in *AddChallengesRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/AddChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SnapshotAction(ctx context.Context, in *SnapshotActionRequest, opts ...grpc.CallOption) (*LabInfo, error) {
+	out := new(LabInfo)
+	err := c.cc.Invoke(ctx, "/worker.Worker/SnapshotAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) Tunnel(ctx context.Context, opts ...grpc.CallOption) (Worker_TunnelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[0], "/worker.Worker/Tunnel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerTunnelClient{stream}
+	return x, nil
+}
+
+type Worker_TunnelClient interface {
+	Send(*TunnelData) error
+	Recv() (*TunnelData, error)
+	grpc.ClientStream
+}
+
+type workerTunnelClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerTunnelClient) Send(m *TunnelData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerTunnelClient) Recv() (*TunnelData, error) {
+	m := new(TunnelData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) Terminal(ctx context.Context, opts ...grpc.CallOption) (Worker_TerminalClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[1], "/worker.Worker/Terminal", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerTerminalClient{stream}
+	return x, nil
+}
+
+type Worker_TerminalClient interface {
+	Send(*TerminalData) error
+	Recv() (*TerminalData, error)
+	grpc.ClientStream
+}
+
+type workerTerminalClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerTerminalClient) Send(m *TerminalData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerTerminalClient) Recv() (*TerminalData, error) {
+	m := new(TerminalData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) DownloadCapture(ctx context.Context, in *LabRequest, opts ...grpc.CallOption) (Worker_DownloadCaptureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[2], "/worker.Worker/DownloadCapture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerDownloadCaptureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_DownloadCaptureClient interface {
+	Recv() (*CaptureChunk, error)
+	grpc.ClientStream
+}
+
+type workerDownloadCaptureClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerDownloadCaptureClient) Recv() (*CaptureChunk, error) {
+	m := new(CaptureChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) PrepareImages(ctx context.Context, in *PrepareImagesRequest, opts ...grpc.CallOption) (Worker_PrepareImagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[3], "/worker.Worker/PrepareImages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerPrepareImagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_PrepareImagesClient interface {
+	Recv() (*PrepareImagesStatus, error)
+	grpc.ClientStream
+}
+
+type workerPrepareImagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerPrepareImagesClient) Recv() (*PrepareImagesStatus, error) {
+	m := new(PrepareImagesStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WorkerServer is the server API for Worker service.
+// All implementations must embed UnimplementedWorkerServer
+// for forward compatibility
+type WorkerServer interface {
+	CreateLab(context.Context, *CreateLabRequest) (*LabInfo, error)
+	GetLab(context.Context, *LabRequest) (*LabInfo, error)
+	LabAction(context.Context, *LabActionRequest) (*LabInfo, error)
+	ExerciseAction(context.Context, *ExerciseActionRequest) (*LabInfo, error)
+	AddChallenges(context.Context, *AddChallengesRequest) (*LabInfo, error)
+	SnapshotAction(context.Context, *SnapshotActionRequest) (*LabInfo, error)
+	Tunnel(Worker_TunnelServer) error
+	Terminal(Worker_TerminalServer) error
+	DownloadCapture(*LabRequest, Worker_DownloadCaptureServer) error
+	PrepareImages(*PrepareImagesRequest, Worker_PrepareImagesServer) error
+	mustEmbedUnimplementedWorkerServer()
+}
+
+// UnimplementedWorkerServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (UnimplementedWorkerServer) CreateLab(context.Context, *CreateLabRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLab not implemented")
+}
+func (UnimplementedWorkerServer) GetLab(context.Context, *LabRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLab not implemented")
+}
+func (UnimplementedWorkerServer) LabAction(context.Context, *LabActionRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabAction not implemented")
+}
+func (UnimplementedWorkerServer) ExerciseAction(context.Context, *ExerciseActionRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExerciseAction not implemented")
+}
+func (UnimplementedWorkerServer) AddChallenges(context.Context, *AddChallengesRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChallenges not implemented")
+}
+func (UnimplementedWorkerServer) SnapshotAction(context.Context, *SnapshotActionRequest) (*LabInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotAction not implemented")
+}
+func (UnimplementedWorkerServer) Tunnel(Worker_TunnelServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+}
+func (UnimplementedWorkerServer) Terminal(Worker_TerminalServer) error {
+	return status.Errorf(codes.Unimplemented, "method Terminal not implemented")
+}
+func (UnimplementedWorkerServer) DownloadCapture(*LabRequest, Worker_DownloadCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadCapture not implemented")
+}
+func (UnimplementedWorkerServer) PrepareImages(*PrepareImagesRequest, Worker_PrepareImagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method PrepareImages not implemented")
+}
+func (UnimplementedWorkerServer) mustEmbedUnimplementedWorkerServer() {}
+
+// UnsafeWorkerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerServer will
+// result in compilation errors.
+type UnsafeWorkerServer interface {
+	mustEmbedUnimplementedWorkerServer()
+}
+
+func RegisterWorkerServer(s grpc.ServiceRegistrar, srv WorkerServer) {
+	s.RegisterService(&Worker_ServiceDesc, srv)
+}
+
+func _Worker_CreateLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CreateLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/CreateLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CreateLab(ctx, req.(*CreateLabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_GetLab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).GetLab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/GetLab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).GetLab(ctx, req.(*LabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_LabAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).LabAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/LabAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).LabAction(ctx, req.(*LabActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ExerciseAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExerciseActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ExerciseAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/ExerciseAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ExerciseAction(ctx, req.(*ExerciseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_AddChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).AddChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/AddChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).AddChallenges(ctx, req.(*AddChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SnapshotAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SnapshotAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/SnapshotAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SnapshotAction(ctx, req.(*SnapshotActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_Tunnel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).Tunnel(&workerTunnelServer{stream})
+}
+
+type Worker_TunnelServer interface {
+	Send(*TunnelData) error
+	Recv() (*TunnelData, error)
+	grpc.ServerStream
+}
+
+type workerTunnelServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerTunnelServer) Send(m *TunnelData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerTunnelServer) Recv() (*TunnelData, error) {
+	m := new(TunnelData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_Terminal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).Terminal(&workerTerminalServer{stream})
+}
+
+type Worker_TerminalServer interface {
+	Send(*TerminalData) error
+	Recv() (*TerminalData, error)
+	grpc.ServerStream
+}
+
+type workerTerminalServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerTerminalServer) Send(m *TerminalData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerTerminalServer) Recv() (*TerminalData, error) {
+	m := new(TerminalData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_DownloadCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LabRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).DownloadCapture(m, &workerDownloadCaptureServer{stream})
+}
+
+type Worker_DownloadCaptureServer interface {
+	Send(*CaptureChunk) error
+	grpc.ServerStream
+}
+
+type workerDownloadCaptureServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerDownloadCaptureServer) Send(m *CaptureChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_PrepareImages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrepareImagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).PrepareImages(m, &workerPrepareImagesServer{stream})
+}
+
+type Worker_PrepareImagesServer interface {
+	Send(*PrepareImagesStatus) error
+	grpc.ServerStream
+}
+
+type workerPrepareImagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerPrepareImagesServer) Send(m *PrepareImagesStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Worker_ServiceDesc is the grpc.ServiceDesc for Worker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Worker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "worker.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLab",
+			Handler:    _Worker_CreateLab_Handler,
+		},
+		{
+			MethodName: "GetLab",
+			Handler:    _Worker_GetLab_Handler,
+		},
+		{
+			MethodName: "LabAction",
+			Handler:    _Worker_LabAction_Handler,
+		},
+		{
+			MethodName: "ExerciseAction",
+			Handler:    _Worker_ExerciseAction_Handler,
+		},
+		{
+			MethodName: "AddChallenges",
+			Handler:    _Worker_AddChallenges_Handler,
+		},
+		{
+			MethodName: "SnapshotAction",
+			Handler:    _Worker_SnapshotAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tunnel",
+			Handler:       _Worker_Tunnel_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Terminal",
+			Handler:       _Worker_Terminal_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadCapture",
+			Handler:       _Worker_DownloadCapture_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PrepareImages",
+			Handler:       _Worker_PrepareImages_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "worker.proto",
+}
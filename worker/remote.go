@@ -0,0 +1,889 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins/exercise"
+	"github.com/aau-network-security/haaukins/lab"
+	"github.com/aau-network-security/haaukins/network/capture"
+	"github.com/aau-network-security/haaukins/network/firewall"
+	"github.com/aau-network-security/haaukins/store"
+	"github.com/aau-network-security/haaukins/virtual"
+	"github.com/aau-network-security/haaukins/virtual/docker"
+	pb "github.com/aau-network-security/haaukins/worker/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// actionTimeout bounds lab actions which are not given a context
+	actionTimeout         = 10 * time.Minute
+	recreateTimeout       = 30 * time.Minute
+	recreateRetryInterval = 30 * time.Second
+)
+
+var (
+	ErrRemoteEnvironment = errors.New("the environment of a lab on a worker is controlled through the lab")
+	ErrFrontendsChanged  = errors.New("recreated lab has a different amount of frontends")
+
+	// errors of labs which callers compare against
+	labErrs = []error{
+		lab.ErrUnrepairableLab,
+		lab.ErrSnapshotLimit,
+		lab.ErrSnapshotExists,
+		lab.ErrSnapshotNotFound,
+		lab.ErrSnapshotTooLarge,
+		lab.ErrNoFrontends,
+		lab.ErrNoSnapshotSupport,
+		lab.ErrNoTerminal,
+		lab.ErrNoCapture,
+		capture.ErrNoCapture,
+		exercise.UnknownTagErr,
+		ErrDraining,
+		ErrNoCapacity,
+		ErrUnknownLab,
+	}
+)
+
+// translateErr turns the status of a failed call to a
+// worker into the error the lab on the worker returned
+func translateErr(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, e := range labErrs {
+		if st.Message() == e.Error() {
+			return e
+		}
+	}
+
+	return errors.New(st.Message())
+}
+
+// remoteLab is a lab on a worker, guacamole connects to its frontends
+// through forwarders on the daemon host, when the worker fails the lab is
+// recreated on another worker while the forwarders and flags are kept
+type remoteLab struct {
+	pool *Pool
+	// tag stays the same when the lab is recreated
+	tag string
+	req *pb.CreateLabRequest
+	env *remoteEnvironment
+
+	m          sync.RWMutex
+	node       *node
+	info       *pb.LabInfo
+	forwarders []*forwarder
+	added      []store.Exercise
+	capturing  bool
+	closed     bool
+}
+
+func newRemoteLab(p *Pool, n *node, req *pb.CreateLabRequest, info *pb.LabInfo) (*remoteLab, error) {
+	rl := &remoteLab{
+		pool: p,
+		tag:  info.Tag,
+		req:  req,
+		node: n,
+		info: info,
+	}
+	rl.env = &remoteEnvironment{lab: rl}
+
+	closeRemote := func() {
+		rl.closeForwarders()
+		closeOn(n, info.Tag)
+	}
+
+	for _, f := range info.Frontends {
+		fw, err := newForwarder(p.hostIP, tunnelTo(n.client, info.Tag, f.Port))
+		if err != nil {
+			closeRemote()
+			return nil, err
+		}
+		rl.forwarders = append(rl.forwarders, fw)
+	}
+
+	if err := p.route(req, n, info); err != nil {
+		closeRemote()
+		return nil, err
+	}
+
+	if !p.attach(n, rl) {
+		p.unroute(req, info)
+		closeRemote()
+		return nil, ErrUnknownWorker
+	}
+
+	return rl, nil
+}
+
+// closeOn closes a lab on a worker, failures are only
+// logged as the worker might be gone already
+func closeOn(n *node, tag string) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	_, err := n.client.LabAction(ctx, &pb.LabActionRequest{
+		Tag:    tag,
+		Action: pb.LabActionRequest_CLOSE,
+	})
+	if err != nil {
+		log.Warn().Str("worker", n.Name).Msgf("Unable to close lab %s: %v", tag, err)
+	}
+}
+
+func (rl *remoteLab) closeForwarders() {
+	for _, f := range rl.forwarders {
+		f.Close()
+	}
+}
+
+// target returns the worker of the lab and the tag of the lab on it
+func (rl *remoteLab) target() (pb.WorkerClient, string) {
+	rl.m.RLock()
+	defer rl.m.RUnlock()
+
+	return rl.node.client, rl.info.Tag
+}
+
+func (rl *remoteLab) update(info *pb.LabInfo) {
+	rl.m.Lock()
+	defer rl.m.Unlock()
+
+	// the lab might have been recreated during the call
+	if info.Tag == rl.info.Tag {
+		rl.info = info
+	}
+}
+
+func (rl *remoteLab) lastInfo() *pb.LabInfo {
+	rl.m.RLock()
+	defer rl.m.RUnlock()
+
+	return rl.info
+}
+
+// refresh fetches the current state of the lab from its
+// worker, the last known state is returned on failure
+func (rl *remoteLab) refresh() (*pb.LabInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	client, tag := rl.target()
+	info, err := client.GetLab(ctx, &pb.LabRequest{Tag: tag})
+	if err != nil {
+		return rl.lastInfo(), translateErr(err)
+	}
+	rl.update(info)
+
+	return info, nil
+}
+
+func (rl *remoteLab) action(ctx context.Context, action pb.LabActionRequest_Action, eventTag, teamId string) error {
+	client, tag := rl.target()
+	info, err := client.LabAction(ctx, &pb.LabActionRequest{
+		Tag:      tag,
+		Action:   action,
+		EventTag: eventTag,
+		TeamId:   teamId,
+	})
+	if err != nil {
+		return translateErr(err)
+	}
+	rl.update(info)
+
+	return nil
+}
+
+func (rl *remoteLab) Start(ctx context.Context) error {
+	return rl.action(ctx, pb.LabActionRequest_START, "", "")
+}
+
+func (rl *remoteLab) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	return rl.action(ctx, pb.LabActionRequest_STOP, "", "")
+}
+
+func (rl *remoteLab) Restart(ctx context.Context) error {
+	return rl.action(ctx, pb.LabActionRequest_RESTART, "", "")
+}
+
+func (rl *remoteLab) Suspend(ctx context.Context) error {
+	return rl.action(ctx, pb.LabActionRequest_SUSPEND, "", "")
+}
+
+func (rl *remoteLab) Resume(ctx context.Context) error {
+	return rl.action(ctx, pb.LabActionRequest_RESUME, "", "")
+}
+
+func (rl *remoteLab) ResetFrontends(ctx context.Context, eventTag, teamId string) error {
+	return rl.action(ctx, pb.LabActionRequest_RESET_FRONTENDS, eventTag, teamId)
+}
+
+func (rl *remoteLab) Repair(ctx context.Context) (lab.Health, error) {
+	err := rl.action(ctx, pb.LabActionRequest_REPAIR, "", "")
+	return rl.Health(), err
+}
+
+func (rl *remoteLab) Environment() exercise.Environment {
+	return rl.env
+}
+
+// RdpConnPorts are the ports of the forwarders on the daemon host
+func (rl *remoteLab) RdpConnPorts() []uint {
+	var ports []uint
+	for _, c := range rl.FrontendConns() {
+		ports = append(ports, c.Port)
+	}
+
+	return ports
+}
+
+func (rl *remoteLab) FrontendConns() []lab.FrontendConn {
+	rl.m.RLock()
+	defer rl.m.RUnlock()
+
+	var conns []lab.FrontendConn
+	for i, f := range rl.info.Frontends {
+		conns = append(conns, lab.FrontendConn{
+			Port:     rl.forwarders[i].Port(),
+			Protocol: f.Protocol,
+		})
+	}
+
+	return conns
+}
+
+func (rl *remoteLab) Tag() string {
+	return rl.tag
+}
+
+func (rl *remoteLab) AddChallenge(ctx context.Context, confs ...store.Exercise) error {
+	exercises, err := json.Marshal(confs)
+	if err != nil {
+		return err
+	}
+
+	client, tag := rl.target()
+	info, err := client.AddChallenges(ctx, &pb.AddChallengesRequest{
+		Tag:       tag,
+		Exercises: exercises,
+	})
+	if err != nil {
+		return translateErr(err)
+	}
+	rl.update(info)
+
+	rl.m.Lock()
+	rl.added = append(rl.added, confs...)
+	rl.m.Unlock()
+
+	return nil
+}
+
+func (rl *remoteLab) InstanceInfo() []virtual.InstanceInfo {
+	info, err := rl.refresh()
+	if err != nil {
+		log.Warn().Str("lab", rl.tag).Msgf("Unable to get instances of lab on worker: %v", err)
+	}
+
+	var instances []virtual.InstanceInfo
+	for _, i := range info.Instances {
+		instances = append(instances, virtual.InstanceInfo{
+			Image: i.Image,
+			Type:  i.Type,
+			Id:    i.Id,
+			State: virtual.State(i.State),
+		})
+	}
+
+	return instances
+}
+
+func (rl *remoteLab) Usage(ctx context.Context) virtual.Usage {
+	var u virtual.Usage
+
+	client, tag := rl.target()
+	info, err := client.GetLab(ctx, &pb.LabRequest{Tag: tag, Usage: true})
+	if err != nil {
+		log.Warn().Str("lab", rl.tag).Msgf("Unable to get usage of lab on worker: %v", translateErr(err))
+		return u
+	}
+	rl.update(info)
+
+	if err := json.Unmarshal(info.Usage, &u); err != nil {
+		log.Warn().Str("lab", rl.tag).Msgf("Unable to decode usage of lab on worker: %v", err)
+	}
+
+	return u
+}
+
+// Health reports the ports of the forwarders instead of
+// the ports of the frontends on the worker
+func (rl *remoteLab) Health() lab.Health {
+	h := lab.Health{
+		Tag:       rl.tag,
+		CheckedAt: time.Now(),
+	}
+
+	info, err := rl.refresh()
+	if err != nil {
+		log.Warn().Str("lab", rl.tag).Msgf("Unable to get health of lab on worker: %v", err)
+		return h
+	}
+
+	if err := json.Unmarshal(info.Health, &h); err != nil {
+		log.Warn().Str("lab", rl.tag).Msgf("Unable to decode health of lab on worker: %v", err)
+	}
+	h.Tag = rl.tag
+
+	rl.m.RLock()
+	ports := map[uint]uint{}
+	for i, f := range rl.info.Frontends {
+		ports[uint(f.Port)] = rl.forwarders[i].Port()
+	}
+	rl.m.RUnlock()
+
+	for i, f := range h.Frontends {
+		if p, ok := ports[f.Port]; ok {
+			h.Frontends[i].Port = p
+		}
+	}
+
+	return h
+}
+
+func (rl *remoteLab) snapshotAction(ctx context.Context, req *pb.SnapshotActionRequest) error {
+	client, tag := rl.target()
+	req.Tag = tag
+
+	info, err := client.SnapshotAction(ctx, req)
+	if err != nil {
+		return translateErr(err)
+	}
+	rl.update(info)
+
+	return nil
+}
+
+func (rl *remoteLab) TakeSnapshot(ctx context.Context, name string) (lab.Snapshot, error) {
+	err := rl.snapshotAction(ctx, &pb.SnapshotActionRequest{
+		Name:   name,
+		Action: pb.SnapshotActionRequest_TAKE,
+	})
+	if err != nil {
+		return lab.Snapshot{}, err
+	}
+
+	for _, s := range rl.snapshots(rl.lastInfo()) {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+
+	return lab.Snapshot{}, lab.ErrSnapshotNotFound
+}
+
+func (rl *remoteLab) RestoreSnapshot(ctx context.Context, name, eventTag, teamId string) error {
+	return rl.snapshotAction(ctx, &pb.SnapshotActionRequest{
+		Name:     name,
+		Action:   pb.SnapshotActionRequest_RESTORE,
+		EventTag: eventTag,
+		TeamId:   teamId,
+	})
+}
+
+func (rl *remoteLab) DeleteSnapshot(ctx context.Context, name string) error {
+	return rl.snapshotAction(ctx, &pb.SnapshotActionRequest{
+		Name:   name,
+		Action: pb.SnapshotActionRequest_DELETE,
+	})
+}
+
+func (rl *remoteLab) snapshots(info *pb.LabInfo) []lab.Snapshot {
+	var snapshots []lab.Snapshot
+	for _, s := range info.Snapshots {
+		snapshots = append(snapshots, lab.Snapshot{
+			Name:      s.Name,
+			CreatedAt: time.Unix(s.CreatedAt, 0),
+			Size:      s.Size,
+		})
+	}
+
+	return snapshots
+}
+
+func (rl *remoteLab) Snapshots() []lab.Snapshot {
+	return rl.snapshots(rl.lastInfo())
+}
+
+// Terminal starts a shell in the attacker container of the
+// lab, which is relayed through the worker service
+func (rl *remoteLab) Terminal(ctx context.Context) (docker.Shell, error) {
+	client, tag := rl.target()
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := client.Terminal(ctx)
+	if err != nil {
+		cancel()
+		return nil, translateErr(err)
+	}
+	if err := stream.Send(&pb.TerminalData{Tag: tag}); err != nil {
+		cancel()
+		return nil, translateErr(err)
+	}
+	// the worker acknowledges the started shell
+	if _, err := stream.Recv(); err != nil {
+		cancel()
+		return nil, translateErr(err)
+	}
+
+	return &remoteShell{stream: stream, cancel: cancel}, nil
+}
+
+// remoteShell is a shell in the attacker container of a lab on a worker
+type remoteShell struct {
+	stream pb.Worker_TerminalClient
+	cancel context.CancelFunc
+	buf    []byte
+}
+
+func (rs *remoteShell) Read(p []byte) (int, error) {
+	for len(rs.buf) == 0 {
+		msg, err := rs.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		rs.buf = msg.Data
+	}
+
+	n := copy(p, rs.buf)
+	rs.buf = rs.buf[n:]
+	return n, nil
+}
+
+func (rs *remoteShell) Write(p []byte) (int, error) {
+	if err := rs.stream.Send(&pb.TerminalData{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (rs *remoteShell) Resize(rows, cols uint16) error {
+	return rs.stream.Send(&pb.TerminalData{Rows: uint32(rows), Cols: uint32(cols)})
+}
+
+func (rs *remoteShell) Close() error {
+	rs.cancel()
+	return nil
+}
+
+// Capture writes the captured traffic of the lab, which
+// is streamed from the worker as a gzipped tarball
+func (rl *remoteLab) Capture(ctx context.Context, w io.Writer) error {
+	client, tag := rl.target()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.DownloadCapture(ctx, &pb.LabRequest{Tag: tag})
+	if err != nil {
+		return translateErr(err)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return translateErr(err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func (rl *remoteLab) Close() error {
+	rl.m.Lock()
+	if rl.closed {
+		rl.m.Unlock()
+		return nil
+	}
+	rl.closed = true
+	n, info := rl.node, rl.info
+	rl.m.Unlock()
+
+	rl.pool.detach(n, rl)
+	rl.pool.unroute(rl.req, info)
+	closeOn(n, info.Tag)
+	rl.closeForwarders()
+	docker.Subnets.Release(rl.req.Subnet)
+
+	return nil
+}
+
+func (rl *remoteLab) isClosed() bool {
+	rl.m.RLock()
+	defer rl.m.RUnlock()
+
+	return rl.closed
+}
+
+func (rl *remoteLab) isCapturing() bool {
+	rl.m.RLock()
+	defer rl.m.RUnlock()
+
+	return rl.capturing
+}
+
+// recreate creates the lab again on another worker after its
+// worker has failed, it retries until there is a worker for it
+func (rl *remoteLab) recreate() {
+	for !rl.isClosed() {
+		err := rl.tryRecreate()
+		if err == nil {
+			return
+		}
+
+		log.Error().Str("lab", rl.tag).Msgf("Error while recreating lab of failed worker, retrying in %s: %v", recreateRetryInterval, err)
+		select {
+		case <-time.After(recreateRetryInterval):
+		case <-rl.pool.stop:
+			return
+		}
+	}
+}
+
+func (rl *remoteLab) tryRecreate() error {
+	req, err := rl.recreateRequest()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), recreateTimeout)
+	defer cancel()
+
+	n, info, err := rl.pool.place(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if len(info.Frontends) != len(rl.forwarders) {
+		closeOn(n, info.Tag)
+		return ErrFrontendsChanged
+	}
+
+	if _, err := n.client.LabAction(ctx, &pb.LabActionRequest{Tag: info.Tag, Action: pb.LabActionRequest_START}); err != nil {
+		closeOn(n, info.Tag)
+		return translateErr(err)
+	}
+	// the capture continues in a new directory on the other worker
+	if rl.isCapturing() {
+		if _, err := n.client.LabAction(ctx, &pb.LabActionRequest{Tag: info.Tag, Action: pb.LabActionRequest_START_CAPTURE}); err != nil {
+			log.Warn().Str("lab", rl.tag).Msgf("Unable to capture traffic of recreated lab: %v", err)
+		}
+	}
+
+	prev := rl.lastInfo()
+	rl.pool.unroute(rl.req, prev)
+	if err := rl.pool.route(rl.req, n, info); err != nil {
+		closeOn(n, info.Tag)
+		return err
+	}
+
+	rl.m.Lock()
+	if rl.closed {
+		rl.m.Unlock()
+		rl.pool.unroute(rl.req, info)
+		closeOn(n, info.Tag)
+		return nil
+	}
+	rl.node = n
+	rl.info = info
+	for i, f := range info.Frontends {
+		rl.forwarders[i].Retarget(tunnelTo(n.client, info.Tag, f.Port))
+	}
+	rl.m.Unlock()
+
+	if !rl.pool.attach(n, rl) {
+		return ErrUnknownWorker
+	}
+
+	log.Info().
+		Str("lab", rl.tag).
+		Str("worker", n.Name).
+		Msg("Recreated lab of failed worker")
+
+	return nil
+}
+
+// recreateRequest is the request the lab was created with including
+// the challenges added later on, flags are pinned to the values teams
+// have been given such that flags found before the failure stay valid
+func (rl *remoteLab) recreateRequest() (*pb.CreateLabRequest, error) {
+	var conf lab.Config
+	if err := json.Unmarshal(rl.req.Config, &conf); err != nil {
+		return nil, err
+	}
+
+	rl.m.RLock()
+	conf.Exercises = append(conf.Exercises, rl.added...)
+	pinFlags(&conf, rl.info.Challenges)
+	rl.m.RUnlock()
+
+	raw, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateLabRequest{
+		Config: raw,
+		IsVPN:  rl.req.IsVPN,
+		Subnet: rl.req.Subnet,
+	}, nil
+}
+
+// pinFlags turns the dynamic flags of the exercises into static
+// flags with the given values, which are still passed to the
+// instances by the environment variables of the flags
+func pinFlags(conf *lab.Config, challenges []*pb.LabInfo_Challenge) {
+	values := map[string]string{}
+	for _, c := range challenges {
+		values[c.Tag] = c.Value
+	}
+
+	for i := range conf.Exercises {
+		for j := range conf.Exercises[i].Instance {
+			inst := &conf.Exercises[i].Instance[j]
+			for k := range inst.Flags {
+				f := &inst.Flags[k]
+				v, ok := values[string(f.Tag)]
+				if !ok || f.StaticFlag != "" {
+					continue
+				}
+
+				f.StaticFlag = v
+				if f.EnvVar != "" {
+					inst.Envs = append(inst.Envs, store.EnvVarConfig{EnvVar: f.EnvVar, Value: v})
+				}
+			}
+		}
+	}
+}
+
+// remoteEnvironment is the environment of a lab on a worker, it serves
+// the calls which the daemon makes on environments of assigned labs
+type remoteEnvironment struct {
+	lab *remoteLab
+}
+
+func (re *remoteEnvironment) exerciseAction(ctx context.Context, tag string, action pb.ExerciseActionRequest_Action) error {
+	client, labTag := re.lab.target()
+	info, err := client.ExerciseAction(ctx, &pb.ExerciseActionRequest{
+		Tag:         labTag,
+		ExerciseTag: tag,
+		Action:      action,
+	})
+	if err != nil {
+		return translateErr(err)
+	}
+	re.lab.update(info)
+
+	return nil
+}
+
+func (re *remoteEnvironment) ResetByTag(ctx context.Context, tag string) error {
+	return re.exerciseAction(ctx, tag, pb.ExerciseActionRequest_RESET)
+}
+
+func (re *remoteEnvironment) StartByTag(ctx context.Context, tag string) error {
+	return re.exerciseAction(ctx, tag, pb.ExerciseActionRequest_START)
+}
+
+func (re *remoteEnvironment) StopByTag(tag string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	return re.exerciseAction(ctx, tag, pb.ExerciseActionRequest_STOP)
+}
+
+func (re *remoteEnvironment) LabSubnet() string {
+	return re.lab.lastInfo().LabSubnet
+}
+
+func (re *remoteEnvironment) LabSubnet6() string {
+	return re.lab.lastInfo().LabSubnet6
+}
+
+func (re *remoteEnvironment) LabDNS() string {
+	return re.lab.lastInfo().LabDNS
+}
+
+func (re *remoteEnvironment) DNSRecords() []*exercise.DNSRecord {
+	var records []*exercise.DNSRecord
+	for _, r := range re.lab.lastInfo().Records {
+		records = append(records, &exercise.DNSRecord{Record: map[string]string{r.Ip: r.Name}, Network: r.Network})
+	}
+
+	return records
+}
+
+func (re *remoteEnvironment) Challenges() []store.Challenge {
+	var challenges []store.Challenge
+	for _, c := range re.lab.lastInfo().Challenges {
+		challenges = append(challenges, store.Challenge{
+			Name:  c.Name,
+			Tag:   store.Tag(c.Tag),
+			Value: c.Value,
+		})
+	}
+
+	return challenges
+}
+
+func (re *remoteEnvironment) InstanceInfo() []virtual.InstanceInfo {
+	return re.lab.InstanceInfo()
+}
+
+// Usage includes the frontends, as the worker
+// only reports the usage of the whole lab
+func (re *remoteEnvironment) Usage(ctx context.Context) virtual.Usage {
+	return re.lab.Usage(ctx)
+}
+
+func (re *remoteEnvironment) Health() exercise.Health {
+	return re.lab.Health().Environment
+}
+
+// NetworkInterface is empty, as the lab is not
+// attached to an interface of the daemon host
+func (re *remoteEnvironment) NetworkInterface() string {
+	return ""
+}
+
+func (re *remoteEnvironment) Network() docker.Network {
+	return nil
+}
+
+// InstanceAddrs is empty, the worker limits
+// the terminal of the lab to its exercises itself
+func (re *remoteEnvironment) InstanceAddrs([]store.Tag) []string {
+	return nil
+}
+
+// LabRoutes is empty, the worker adds the
+// routes into segments to the instances itself
+func (re *remoteEnvironment) LabRoutes() []docker.Route {
+	return nil
+}
+
+// Connections are not available, as guacamole cannot
+// reach the exercises within the lab network on the worker
+func (re *remoteEnvironment) Connections() []exercise.Connection {
+	return nil
+}
+
+// Services are not forwarded, as the daemon cannot
+// reach the lab network on the worker
+func (re *remoteEnvironment) Services() []exercise.Service {
+	return nil
+}
+
+func (re *remoteEnvironment) SetDisabledExercises([]store.Tag) {}
+
+// SetCapture is ignored, as the network of the lab
+// is on the worker host
+func (re *remoteEnvironment) SetCapture(capture.Config) {}
+
+func (re *remoteEnvironment) CaptureConfig() *capture.Config {
+	return nil
+}
+
+// StartCapture starts the capture on the worker, which
+// captures the lab when it was created with a capture
+func (re *remoteEnvironment) StartCapture() error {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	if err := re.lab.action(ctx, pb.LabActionRequest_START_CAPTURE, "", ""); err != nil {
+		return err
+	}
+
+	re.lab.m.Lock()
+	re.lab.capturing = true
+	re.lab.m.Unlock()
+
+	return nil
+}
+
+// EnableIPv6 is ignored, the worker enables IPv6
+// from the config of the lab it receives
+func (re *remoteEnvironment) EnableIPv6() {}
+
+// SetLabSubnet is ignored, the subnet of the lab
+// is passed to the worker with the request
+func (re *remoteEnvironment) SetLabSubnet(string) {}
+
+// InstanceEgress is empty, labs of events restricting
+// their egress are not placed on workers
+func (re *remoteEnvironment) InstanceEgress() []firewall.InstanceRule {
+	return nil
+}
+
+func (re *remoteEnvironment) SetDNSRecords(context.Context, []store.RecordConfig) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Create(context.Context, int32) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Add(context.Context, ...store.Exercise) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Reset(context.Context) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Start(context.Context) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Stop() error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Suspend(context.Context) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Resume(context.Context) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Checkpoint(context.Context) (store.Checkpoint, error) {
+	return nil, ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Restore(context.Context, store.Checkpoint) error {
+	return ErrRemoteEnvironment
+}
+
+func (re *remoteEnvironment) Close() error {
+	return ErrRemoteEnvironment
+}
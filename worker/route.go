@@ -0,0 +1,105 @@
+// Copyright (c) 2018-2019 Aalborg University
+// Use of this source code is governed by a GPLv3
+// license that can be found in the LICENSE file.
+
+package worker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrSubnetInUse = errors.New("lab subnet overlaps with the subnet of a lab on another worker")
+)
+
+type execFunc func(cmd string, args ...string) ([]byte, error)
+
+// routes makes the subnets of labs on workers reachable from
+// the daemon host through the worker, e.g. for the VPN of teams
+type routes struct {
+	m        sync.Mutex
+	sudo     bool
+	execFunc execFunc
+	// gateways of the routed subnets
+	gateways map[string]string
+}
+
+func newRoutes() *routes {
+	return &routes{
+		sudo:     true,
+		execFunc: shellExec,
+		gateways: map[string]string{},
+	}
+}
+
+func overlaps(a, b string) bool {
+	_, na, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+	_, nb, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+
+	return na.Contains(nb.IP) || nb.Contains(na.IP)
+}
+
+// add routes the subnet through the gateway, subnets overlapping
+// with a subnet routed through another gateway are rejected
+func (r *routes) add(subnet, gateway string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for s, gw := range r.gateways {
+		if gw != gateway && overlaps(s, subnet) {
+			return ErrSubnetInUse
+		}
+	}
+
+	if _, err := r.exec("ip", "route", "replace", subnet, "via", gateway); err != nil {
+		return err
+	}
+	r.gateways[subnet] = gateway
+
+	return nil
+}
+
+func (r *routes) remove(subnet string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if _, ok := r.gateways[subnet]; !ok {
+		return nil
+	}
+	delete(r.gateways, subnet)
+
+	_, err := r.exec("ip", "route", "del", subnet)
+	return err
+}
+
+func (r *routes) exec(cmd string, args ...string) ([]byte, error) {
+	if r.sudo {
+		args = append([]string{cmd}, args...)
+		cmd = "sudo"
+	}
+
+	log.Debug().Msgf("exec %s %v", cmd, args)
+	out, err := r.execFunc(cmd, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", err, bytes.TrimSpace(out))
+	}
+
+	return out, nil
+}
+
+func shellExec(cmd string, args ...string) ([]byte, error) {
+	return exec.Command(cmd, args...).CombinedOutput()
+}